@@ -43,6 +43,7 @@ var (
 	localPath  = flag.String("local_path", "", "Directory where relative imports are resolved")
 	outputPath = flag.String("output", "", "Output path (indexpack directory or .kzip filename)")
 	extraFiles = flag.String("extra_files", "", "Additional files to include in each compilation (CSV)")
+	configs    = flag.String("configs", "", "Additional build configurations to extract, as goos_goarch[+tag...] (CSV)")
 	byDir      = flag.Bool("bydir", false, "Import by directory rather than import path")
 	keepGoing  = flag.Bool("continue", false, "Continue past errors")
 	verbose    = flag.Bool("v", false, "Enable verbose logging")
@@ -101,6 +102,15 @@ func main() {
 	if *extraFiles != "" {
 		ext.ExtraFiles = strings.Split(*extraFiles, ",")
 	}
+	if *configs != "" {
+		for _, s := range strings.Split(*configs, ",") {
+			cfg, err := golang.ParseConfig(s)
+			if err != nil {
+				log.Fatalf("Invalid --configs: %v", err)
+			}
+			ext.Configs = append(ext.Configs, cfg)
+		}
+	}
 
 	locate := ext.Locate
 	if *byDir {
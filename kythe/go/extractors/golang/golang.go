@@ -35,10 +35,14 @@
 package golang
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"go/build"
+	"io/ioutil"
+	"log"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -51,6 +55,7 @@ import (
 	"bitbucket.org/creachadair/stringset"
 
 	apb "kythe.io/kythe/proto/analysis_go_proto"
+	fcpb "kythe.io/kythe/proto/filecontext_go_proto"
 	gopb "kythe.io/kythe/proto/go_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
 )
@@ -87,6 +92,13 @@ type Extractor struct {
 	// Extra file paths to include in each compilation record.
 	ExtraFiles []string
 
+	// Additional build configurations in which to extract each package.  If
+	// any are set, the sources of a compilation are those selected by the
+	// BuildContext or by any of these configurations, and each source input
+	// is annotated with the configurations that include it.  Dependencies are
+	// resolved in the BuildContext.
+	Configs []Config
+
 	// A function to generate a vname from a package's import path.  If nil,
 	// the extractor will use govname.ForPackage.
 	PackageVName func(corpus string, bp *build.Package) *spb.VName
@@ -98,6 +110,103 @@ type Extractor struct {
 
 	pmap map[string]*build.Package // Map of import path to build package
 	fmap map[string]string         // Map of file path to content digest
+
+	// Contents of files generated during extraction, keyed by the path at
+	// which they are recorded as required inputs.
+	generated map[string][]byte
+}
+
+// A Config is a build configuration, consisting of a target operating system
+// and architecture together with a set of build tags.
+type Config struct {
+	GOOS, GOARCH string
+	BuildTags    []string
+}
+
+// String renders c as "goos_goarch" followed by "+tag" for each of its build
+// tags, e.g., "linux_amd64+netgo". This is the name of the configuration as
+// recorded in the compilation; see ParseConfig.
+func (c Config) String() string {
+	return strings.Join(append([]string{c.GOOS + "_" + c.GOARCH}, c.BuildTags...), "+")
+}
+
+// ParseConfig parses a configuration in the format produced by Config.String.
+func ParseConfig(s string) (Config, error) {
+	parts := strings.Split(s, "+")
+	i := strings.Index(parts[0], "_")
+	if i <= 0 || i == len(parts[0])-1 {
+		return Config{}, fmt.Errorf("invalid configuration %q: want goos_goarch[+tag...]", s)
+	}
+	c := Config{GOOS: parts[0][:i], GOARCH: parts[0][i+1:]}
+	for _, tag := range parts[1:] {
+		if tag == "" {
+			return Config{}, fmt.Errorf("invalid configuration %q: empty build tag", s)
+		}
+		c.BuildTags = append(c.BuildTags, tag)
+	}
+	return c, nil
+}
+
+// cgoTypesFile is the name of the file in which cgo writes the Go declarations
+// for the C names used by a package.
+const cgoTypesFile = "_cgo_gotypes.go"
+
+// cgoTypes runs cgo over the cgo source files of bp, and returns the Go
+// declarations it generates for the C names they use.
+func (e *Extractor) cgoTypes(bp *build.Package) ([]byte, error) {
+	dir, err := ioutil.TempDir("", "cgo")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{"tool", "cgo", "-objdir", dir, "-importpath", bp.ImportPath, "--"}
+	args = append(args, bp.CgoCPPFLAGS...)
+	args = append(args, bp.CgoCFLAGS...)
+	args = append(args, bp.CgoFiles...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = bp.Dir
+	cmd.Env = append(os.Environ(),
+		"GOOS="+e.BuildContext.GOOS,
+		"GOARCH="+e.BuildContext.GOARCH,
+		"CGO_ENABLED=1",
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("%v: %s", err, bytes.TrimSpace(out))
+	}
+	return ioutil.ReadFile(filepath.Join(dir, cgoTypesFile))
+}
+
+// embedFiles returns the paths of the files in dir matched by the given
+// //go:embed patterns, in lexicographic order. A pattern naming a directory
+// matches the files beneath it, except those whose names begin with "." or "_"
+// unless the pattern has the prefix "all:". Invalid patterns are ignored; the
+// compiler will report them.
+func embedFiles(dir string, patterns []string) []string {
+	files := stringset.New()
+	for _, pattern := range patterns {
+		all := strings.HasPrefix(pattern, "all:")
+		matches, _ := filepath.Glob(filepath.Join(dir, strings.TrimPrefix(pattern, "all:")))
+		for _, match := range matches {
+			filepath.Walk(match, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return nil // skip files we cannot read
+				}
+				name := info.Name()
+				if path != match && !all && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+					if info.IsDir() {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+				if info.Mode().IsRegular() {
+					files.Add(path)
+				}
+				return nil
+			})
+		}
+	}
+	return files.Elements()
 }
 
 // addPackage imports the specified package, if it has not already been
@@ -124,6 +233,9 @@ func (e *Extractor) mapPackage(importPath string, bp *build.Package) {
 
 // readFile reads the contents of path as resolved through the extracted settings.
 func (e *Extractor) readFile(ctx context.Context, path string) ([]byte, error) {
+	if data, ok := e.generated[path]; ok {
+		return data, nil
+	}
 	data, err := vfs.ReadFile(ctx, path)
 	if err != nil {
 		// If there's an alternative installation path, and this is a path that
@@ -282,27 +394,39 @@ func (p *Package) Extract() error {
 		Argument: []string{"go", "build"},
 	}
 	bc := p.ext.BuildContext
-	if info, err := ptypes.MarshalAny(&gopb.GoDetails{
+	details := &gopb.GoDetails{
 		Gopath:     bc.GOPATH,
 		Goos:       bc.GOOS,
 		Goarch:     bc.GOARCH,
 		Compiler:   bc.Compiler,
 		BuildTags:  bc.BuildTags,
 		CgoEnabled: bc.CgoEnabled,
-	}); err == nil {
-		cu.Details = append(cu.Details, info)
 	}
 
 	// Add required inputs from this package (source files of various kinds).
 	bp := p.BuildPackage
 	srcBase := filepath.Join(bp.SrcRoot, bp.ImportPath)
 	p.addSource(cu, bp.Root, srcBase, bp.GoFiles)
-	p.addFiles(cu, bp.Root, srcBase, bp.CgoFiles)
+	p.addSource(cu, bp.Root, srcBase, bp.CgoFiles)
 	p.addFiles(cu, bp.Root, srcBase, bp.CFiles)
 	p.addFiles(cu, bp.Root, srcBase, bp.CXXFiles)
 	p.addFiles(cu, bp.Root, srcBase, bp.HFiles)
 	p.addSource(cu, bp.Root, srcBase, bp.TestGoFiles)
 
+	// Add the files that may be embedded by //go:embed directives, so the
+	// indexer can link the directives to them.
+	p.addFiles(cu, bp.Root, "", embedFiles(bp.Dir, embedPatterns(bp)))
+
+	// If the package uses cgo, record the Go declarations cgo generates for
+	// the C names it refers to, so the indexer can resolve them.  This is not
+	// fatal: Without them, references to C names are simply not linked.
+	if bc.CgoEnabled && len(bp.CgoFiles) != 0 {
+		if data, err := p.ext.cgoTypes(bp); err != nil {
+			log.Printf("WARNING: Unable to run cgo for %q: %v", bp.ImportPath, err)
+		} else {
+			details.CgoTypes = p.addGenerated(cu, bp.Root, filepath.Join(srcBase, cgoTypesFile), data)
+		}
+	}
 	// Add extra inputs that may be specified by the extractor.
 	p.addFiles(cu, filepath.Dir(bp.SrcRoot), "", p.ext.ExtraFiles)
 
@@ -317,6 +441,12 @@ func (p *Package) Extract() error {
 	// the source requirements for tools like the oracle.
 	missing := p.addDeps(cu, bp.Imports, bp.Dir)
 	missing = append(missing, p.addDeps(cu, bp.TestImports, bp.Dir)...)
+	if len(p.ext.Configs) != 0 {
+		missing = append(missing, p.addConfigs(cu, details, srcBase)...)
+	}
+	if info, err := ptypes.MarshalAny(details); err == nil {
+		cu.Details = append(cu.Details, info)
+	}
 
 	// Add command-line arguments.
 	// TODO(fromberger): Figure out whether we should emit separate
@@ -399,12 +529,11 @@ func (p *Package) EachUnit(ctx context.Context, f func(*kindex.Compilation) erro
 			if !strings.Contains(ri.Info.Digest, "/") {
 				continue // skip those that are already complete
 			}
-			rc, err := vfs.Open(ctx, ri.Info.Digest)
+			data, err := p.ext.readFile(ctx, ri.Info.Digest)
 			if err != nil {
 				return fmt.Errorf("opening input: %v", err)
 			}
-			fd, err := kindex.FileData(ri.Info.Path, rc)
-			rc.Close()
+			fd, err := kindex.FileData(ri.Info.Path, bytes.NewReader(data))
 			if err != nil {
 				return fmt.Errorf("reading input: %v", err)
 			}
@@ -423,6 +552,73 @@ func (p *Package) EachUnit(ctx context.Context, f func(*kindex.Compilation) erro
 	return nil
 }
 
+// addConfigs adds to cu the source files of p selected by each of the
+// extractor's build configurations and the dependencies they import, returning
+// the import paths of any that are missing.  The configurations, beginning
+// with that of the BuildContext, are recorded in details, and each source input
+// is given a ContextDependentVersion detail with a row for each configuration
+// that includes it.
+func (p *Package) addConfigs(cu *apb.CompilationUnit, details *gopb.GoDetails, srcBase string) []string {
+	bc := p.ext.BuildContext
+	bp := p.BuildPackage
+	configs := append([]Config{{
+		GOOS:      bc.GOOS,
+		GOARCH:    bc.GOARCH,
+		BuildTags: bc.BuildTags,
+	}}, p.ext.Configs...)
+
+	sources := stringset.New(cu.SourceFile...)
+	contexts := make(map[string][]string) // source path → configuration names
+	var missing []string
+	for i, cfg := range configs {
+		cbp := bp
+		if i > 0 {
+			cbc := bc
+			cbc.GOOS, cbc.GOARCH, cbc.BuildTags = cfg.GOOS, cfg.GOARCH, cfg.BuildTags
+			var err error
+			cbp, err = cbc.ImportDir(bp.Dir, 0)
+			if err != nil {
+				log.Printf("WARNING: Skipping configuration %s for %q: %v", cfg, bp.ImportPath, err)
+				continue
+			}
+			missing = append(missing, p.addDeps(cu, cbp.Imports, bp.Dir)...)
+			missing = append(missing, p.addDeps(cu, cbp.TestImports, bp.Dir)...)
+		}
+		cname := cfg.String()
+		details.Configuration = append(details.Configuration, &gopb.GoDetails_Configuration{
+			Context:   cname,
+			Goos:      cfg.GOOS,
+			Goarch:    cfg.GOARCH,
+			BuildTags: cfg.BuildTags,
+		})
+		for _, names := range [][]string{cbp.GoFiles, cbp.CgoFiles, cbp.TestGoFiles} {
+			for _, name := range names {
+				path := strings.TrimPrefix(filepath.Join(srcBase, name), bp.Root+"/")
+				if !sources.Contains(path) {
+					sources.Add(path)
+					p.addSource(cu, bp.Root, srcBase, []string{name})
+				}
+				contexts[path] = append(contexts[path], cname)
+			}
+		}
+	}
+
+	for _, ri := range cu.RequiredInput {
+		names := contexts[ri.Info.Path]
+		if len(names) == 0 {
+			continue
+		}
+		version := new(fcpb.ContextDependentVersion)
+		for _, name := range names {
+			version.Row = append(version.Row, &fcpb.ContextDependentVersion_Row{SourceContext: name})
+		}
+		if info, err := ptypes.MarshalAny(version); err == nil {
+			ri.Details = append(ri.Details, info)
+		}
+	}
+	return missing
+}
+
 // addFiles adds a required input to cu for each file whose basename or path is
 // given in names.  If base != "", it is prejoined to each name.
 // The path of the input will have root/ trimmed from the beginning.
@@ -457,6 +653,18 @@ func (p *Package) addSource(cu *apb.CompilationUnit, root, base string, names []
 	}
 }
 
+// addGenerated acts as addFiles for a file generated during extraction, whose
+// contents are given by data and which is recorded at the specified path. It
+// returns the path of the required input added for the file.
+func (p *Package) addGenerated(cu *apb.CompilationUnit, root, path string, data []byte) string {
+	if p.ext.generated == nil {
+		p.ext.generated = make(map[string][]byte)
+	}
+	p.ext.generated[path] = data
+	p.addFiles(cu, root, "", []string{path})
+	return strings.TrimPrefix(path, root+"/")
+}
+
 // addInput acts as addFiles for the output of a package.
 func (p *Package) addInput(cu *apb.CompilationUnit, bp *build.Package) {
 	obj := bp.PkgObj
@@ -485,6 +693,8 @@ func (p *Package) addDeps(cu *apb.CompilationUnit, importPaths []string, localPa
 	for _, ip := range importPaths {
 		if ip == "unsafe" {
 			// package unsafe is intrinsic; nothing to do
+		} else if ip == "C" {
+			// package C is synthesized by cgo; see cgoTypes
 		} else if dep, err := p.ext.addPackage(ip, localPath); err != nil {
 			missing = append(missing, ip)
 		} else {
@@ -0,0 +1,31 @@
+//go:build go1.22
+// +build go1.22
+
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+import "go/types"
+
+// aliasObject returns the type name denoted by typ if it is an alias type.
+// Alias types are only represented explicitly by go/types from Go 1.22.
+func aliasObject(typ types.Type) *types.TypeName {
+	if t, ok := typ.(*types.Alias); ok {
+		return t.Obj()
+	}
+	return nil
+}
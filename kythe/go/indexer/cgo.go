@@ -0,0 +1,233 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"kythe.io/kythe/go/util/schema/nodes"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// cgoLanguage is the language of the vnames assigned to C entities, which are
+// indexed by the C++ indexer.
+const cgoLanguage = "c++"
+
+// The C++ indexer gives the declarations of C entities vnames that depend on a
+// hash of their definitions and on the files that contain them, which are not
+// known from the declarations cgo generates. The only C++ nodes whose vnames
+// can be derived from a name alone are builtin types ("int#builtin") and the
+// nominal types that stand for a struct, union, or enum by its tag
+// ("point#c#t"), so only references to those are linked across languages.
+// References to other C entities (functions, variables, constants, struct
+// fields, and typedefs) are resolved for type checking, but are not linked to
+// any node.
+
+// Prefixes of the names cgo assigns to the Go declarations it generates for
+// C entities, e.g., C.int is declared as _Ctype_int.
+const (
+	cgoTypePrefix   = "_Ctype_"
+	cgoFuncPrefix   = "_Cfunc_"
+	cgoVarPrefix    = "_Cvar_"
+	cgoIConstPrefix = "_Ciconst_"
+	cgoFConstPrefix = "_Cfconst_"
+	cgoSConstPrefix = "_Csconst_"
+)
+
+// cgoBuiltins maps the names cgo uses for C builtin types to their C
+// spellings, as used by the C++ indexer for builtin type nodes.
+var cgoBuiltins = map[string]string{
+	"char":      "char",
+	"schar":     "signed char",
+	"uchar":     "unsigned char",
+	"short":     "short",
+	"ushort":    "unsigned short",
+	"int":       "int",
+	"uint":      "unsigned int",
+	"long":      "long",
+	"ulong":     "unsigned long",
+	"longlong":  "long long",
+	"ulonglong": "unsigned long long",
+	"float":     "float",
+	"double":    "double",
+	"void":      "void",
+}
+
+// A cgoPackage is the "C" pseudo-package of a package that uses cgo, populated
+// from the Go declarations cgo generates for the C names the package uses.
+type cgoPackage struct {
+	pkg    *types.Package
+	vnames map[types.Object]*spb.VName // C entities; nil if not linked
+	nodes  []cgoNode                   // nodes for the linked C entities
+}
+
+// A cgoNode records the node kind of the vname assigned to a C entity.
+type cgoNode struct {
+	vname *spb.VName
+	kind  string
+}
+
+// newCgoPackage type-checks file, which contains the Go declarations generated
+// by cgo for a package with the given path, and returns a "C" package whose
+// members are the C entities declared there.
+func newCgoPackage(path string, fset *token.FileSet, file *ast.File, imp types.Importer) *cgoPackage {
+	// The generated file also contains support code that refers to packages
+	// (e.g., runtime/cgo) that the compilation may not include. Such errors do
+	// not affect the declarations we need, so they are discarded.
+	config := &types.Config{
+		Importer: imp,
+		Error:    func(error) {},
+	}
+	gen, _ := config.Check(path, fset, []*ast.File{file}, nil)
+
+	c := &cgoPackage{
+		pkg:    types.NewPackage("C", "C"),
+		vnames: make(map[types.Object]*spb.VName),
+	}
+	scope := gen.Scope()
+	for _, name := range scope.Names() {
+		obj := scope.Lookup(name)
+		var cobj types.Object
+		switch {
+		case strings.HasPrefix(name, cgoTypePrefix):
+			cname := strings.TrimPrefix(name, cgoTypePrefix)
+			if strings.HasPrefix(cname, "_") {
+				continue // internal to cgo, e.g., _Ctype__GoString_
+			}
+			cobj = types.NewTypeName(obj.Pos(), c.pkg, cname, obj.Type())
+			if named, ok := obj.Type().(*types.Named); ok && named.Obj() == obj {
+				c.addFields(cname, named)
+			}
+
+		case strings.HasPrefix(name, cgoFuncPrefix):
+			sig, ok := obj.Type().(*types.Signature)
+			if !ok {
+				continue
+			}
+			cobj = types.NewFunc(obj.Pos(), c.pkg, strings.TrimPrefix(name, cgoFuncPrefix), sig)
+
+		case strings.HasPrefix(name, cgoVarPrefix):
+			// C variables are declared as pointers to their storage, and the
+			// references are rewritten to indirect through them.
+			ptr, ok := obj.Type().(*types.Pointer)
+			if !ok {
+				continue
+			}
+			cobj = types.NewVar(obj.Pos(), c.pkg, strings.TrimPrefix(name, cgoVarPrefix), ptr.Elem())
+
+		default:
+			k, ok := obj.(*types.Const)
+			if !ok {
+				continue
+			}
+			for _, prefix := range []string{cgoIConstPrefix, cgoFConstPrefix, cgoSConstPrefix} {
+				if strings.HasPrefix(name, prefix) {
+					cobj = types.NewConst(obj.Pos(), c.pkg, strings.TrimPrefix(name, prefix), k.Type(), k.Val())
+					break
+				}
+			}
+			if cobj == nil {
+				continue
+			}
+		}
+		c.pkg.Scope().Insert(cobj)
+		c.add(cobj, obj)
+	}
+	c.pkg.MarkComplete()
+	return c
+}
+
+// addFields records the fields of the C struct or union type with the given
+// cgo name. Fields are not linked to C++ nodes.
+func (c *cgoPackage) addFields(cname string, named *types.Named) {
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		c.vnames[st.Field(i)] = nil
+	}
+}
+
+// add records the C entity denoted by obj, whose declaration generated by cgo
+// is gen. Builtin and nominal types are given the vnames the C++ indexer
+// assigns them, which belong to no corpus; other entities are not linked.
+func (c *cgoPackage) add(obj, gen types.Object) {
+	var vname *spb.VName
+	if sig, kind := cgoSignature(obj); sig != "" {
+		vname = &spb.VName{Language: cgoLanguage, Signature: sig}
+		c.nodes = append(c.nodes, cgoNode{vname: vname, kind: kind})
+	}
+	c.vnames[obj] = vname
+	c.vnames[gen] = vname
+}
+
+// vname returns the vname of the C entity denoted by obj, if any, and reports
+// whether obj denotes a C entity. The vname is nil if the entity is not linked
+// to a C++ node. It is safe to call vname on a nil *cgoPackage.
+func (c *cgoPackage) vname(obj types.Object) (*spb.VName, bool) {
+	if c == nil {
+		return nil, false
+	}
+	vname, ok := c.vnames[obj]
+	return vname, ok
+}
+
+// unlinked reports whether obj denotes a C entity that is not linked to a C++
+// node. It is safe to call unlinked on a nil *cgoPackage.
+func (c *cgoPackage) unlinked(obj types.Object) bool {
+	vname, ok := c.vname(obj)
+	return ok && vname == nil
+}
+
+// isUnexportedError reports whether err is a type-checker complaint about a
+// reference to a C name, which are not exported in the Go sense but are
+// permitted by cgo. It is safe to call isUnexportedError on a nil *cgoPackage.
+func (c *cgoPackage) isUnexportedError(err error) bool {
+	te, ok := err.(types.Error)
+	return ok && c != nil && strings.HasSuffix(te.Msg, "not exported by package C")
+}
+
+// cgoSignature returns the signature and node kind the C++ indexer gives the
+// C type denoted by obj: A struct, union, or enum type is its tag followed by
+// "#c#t", "#u#t", or "#n#t" respectively; a builtin type is its C spelling
+// followed by "#builtin". It returns "" if obj denotes any other C entity.
+func cgoSignature(obj types.Object) (sig, kind string) {
+	name := obj.Name()
+	if _, ok := obj.(*types.TypeName); !ok {
+		return "", ""
+	} else if b, ok := cgoBuiltins[name]; ok {
+		return b + "#builtin", nodes.TBuiltin
+	} else if tag := strings.TrimPrefix(name, "struct_"); tag != name {
+		return tag + "#c#t", nodes.TNominal
+	} else if tag := strings.TrimPrefix(name, "union_"); tag != name {
+		return tag + "#u#t", nodes.TNominal
+	} else if tag := strings.TrimPrefix(name, "enum_"); tag != name {
+		return tag + "#n#t", nodes.TNominal
+	}
+	return "", ""
+}
+
+// isCgoPackage reports whether obj is the name of the "C" package.
+func isCgoPackage(obj types.Object) bool {
+	pkg, ok := obj.(*types.PkgName)
+	return ok && pkg.Imported().Path() == "C"
+}
@@ -15,7 +15,8 @@
  */
 
 // Program go_indexer implements a Kythe indexer for the Go language.  Input is
-// read from one or more .kzip, .kindex, or index pack paths.
+// read from one or more .kzip, .kindex, or index pack paths, or received from
+// an analysis driver by a CompilationAnalyzer service.
 package main
 
 import (
@@ -31,12 +32,14 @@ import (
 	"strings"
 
 	"kythe.io/kythe/go/indexer"
+	"kythe.io/kythe/go/platform/analysis/claim"
 	"kythe.io/kythe/go/platform/delimited"
 	"kythe.io/kythe/go/platform/kindex"
 	"kythe.io/kythe/go/platform/kzip"
 	"kythe.io/kythe/go/util/metadata"
 
 	apb "kythe.io/kythe/proto/analysis_go_proto"
+	gopb "kythe.io/kythe/proto/go_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
 )
 
@@ -48,14 +51,23 @@ var (
 	docBase     = flag.String("docbase", "http://godoc.org", "If set, use as the base URL for godoc links")
 	verbose     = flag.Bool("verbose", false, "Emit verbose log information")
 	contOnErr   = flag.Bool("continue", false, "Log errors encountered during analysis but do not exit unsuccessfully")
+	doDynamic   = flag.Bool("dynamic", false, "Emit possible callees for calls through interface methods")
+	summaryPath = flag.String("summary", "", "If set, write a method set summary of each package to this path (see go_satisfies)")
+	parallelism = flag.Int("parallelism", 1, "Index up to this many compilations concurrently")
+	reportPath  = flag.String("report", "", "If set, write a JSON report of the outcome of each compilation to this path")
+	listenAddr  = flag.String("listen", "", "If set, serve a CompilationAnalyzer at this address instead of reading input paths")
+	claimsPath  = flag.String("claims", "", "If set, read claim assignments from this path (see claim_kzips)")
 
-	writeEntry func(context.Context, *spb.Entry) error
-	docURL     *url.URL
+	writeEntry   func(context.Context, *spb.Entry) error
+	writeSummary func(*gopb.MethodSetSummary) error
+	docURL       *url.URL
+	claims       *claim.Table
 )
 
 func init() {
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Usage: %s [options] <path>...
+       %s [options] --listen <address>
 
 Generate Kythe graph data for the compilations stored in .kzip, .kindex, or
 index packs named by the path arguments. Output is written to stdout.
@@ -68,8 +80,30 @@ By default, the output is a delimited stream of wire-format Kythe Entry
 protobuf messages. With the --json flag, output is instead a stream of
 undelimited JSON messages.
 
+If --summary is set, a delimited stream of MethodSetSummary messages describing
+the method sets of each indexed package is also written to the named file. The
+summaries of all the packages in a corpus may be joined by go_satisfies to find
+satisfaction relationships between packages that do not import one another.
+
+If --parallelism is greater than 1, that many compilations are indexed
+concurrently. The output of each compilation is then buffered, with duplicate
+entries removed, and written only once the compilation has been successfully
+indexed. If --report is set, a JSON report of the outcome of each compilation
+is written to the named file.
+
+If --listen is set, no paths are read. Instead, a CompilationAnalyzer gRPC
+service is served at the given address, and each compilation it receives is
+indexed using the inputs fetched from the file data service named by the
+request. The entries are returned as the values of the analysis outputs.
+
+If --claims is set, it names a delimited stream of ClaimAssignment messages, as
+written by claim_kzips, assigning each source file shared by several
+compilations to one of them. Facts about a shared file, such as its text, are
+then emitted only when indexing the compilation that claims it. Assignments
+attached to a compilation by an analysis driver are honored in any case.
+
 Options:
-`, filepath.Base(os.Args[0]))
+`, filepath.Base(os.Args[0]), filepath.Base(os.Args[0]))
 
 		flag.PrintDefaults()
 	}
@@ -78,6 +112,27 @@ Options:
 func main() {
 	flag.Parse()
 
+	if *docBase != "" {
+		u, err := url.Parse(*docBase)
+		if err != nil {
+			log.Fatalf("Invalid doc base URL: %v", err)
+		}
+		docURL = u
+	}
+	if *claimsPath != "" {
+		t, err := readClaims(*claimsPath)
+		if err != nil {
+			log.Fatalf("Reading claims: %v", err)
+		}
+		claims = t
+	}
+	if *listenAddr != "" {
+		if flag.NArg() != 0 {
+			log.Fatal("Input paths may not be specified with --listen")
+		}
+		log.Fatal(serve(*listenAddr))
+	}
+
 	if flag.NArg() == 0 {
 		log.Fatal("No input paths were specified to index")
 	}
@@ -92,24 +147,38 @@ func main() {
 			return rw.PutProto(entry)
 		}
 	}
-	if *docBase != "" {
-		u, err := url.Parse(*docBase)
+	if *summaryPath != "" {
+		f, err := os.Create(*summaryPath)
 		if err != nil {
-			log.Fatalf("Invalid doc base URL: %v", err)
+			log.Fatalf("Creating summary output: %v", err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Fatalf("Closing summary output: %v", err)
+			}
+		}()
+		rw := delimited.NewWriter(f)
+		writeSummary = func(sum *gopb.MethodSetSummary) error {
+			return rw.PutProto(sum)
 		}
-		docURL = u
 	}
 
 	ctx := context.Background()
+	run := newRunner(*parallelism)
+	if *reportPath != "" {
+		defer func() {
+			if err := run.writeReport(*reportPath); err != nil {
+				log.Fatalf("Writing report: %v", err)
+			}
+		}()
+	}
 	for _, path := range flag.Args() {
-		if err := visitPath(ctx, path, func(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher) error {
-			err := indexGo(ctx, unit, f)
-			if err != nil && *contOnErr {
-				log.Printf("Continuing after error: %v", err)
-				return nil
+		if err := visitPath(ctx, path, run); err != nil {
+			if *reportPath != "" {
+				if err := run.writeReport(*reportPath); err != nil {
+					log.Printf("Writing report: %v", err)
+				}
 			}
-			return err
-		}); err != nil {
 			log.Fatalf("Error indexing %q: %v", path, err)
 		}
 	}
@@ -135,51 +204,92 @@ func checkMetadata(ri *apb.CompilationUnit_FileInput, f indexer.Fetcher) (*index
 	}, nil
 }
 
-// indexGo is a visitFunc that invokes the Kythe Go indexer on unit.
-func indexGo(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher) error {
-	pi, err := indexer.Resolve(unit, f, &indexer.ResolveOptions{
+// readClaims reads a table of claim assignments from the file at path.
+func readClaims(path string) (*claim.Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return claim.ReadTable(f)
+}
+
+// indexGo invokes the Kythe Go indexer on unit, writing its entries to sink
+// and, if summarize != nil, its method set summary to summarize. If unit was
+// extracted for several build configurations, each is indexed in turn, and
+// their summaries are merged (see indexer.MergeSummaries). Only anchors are
+// distinct per configuration; the facts of other nodes are written by each
+// configuration in turn, so the last one wins. The assignments read from
+// --claims, if any, are attached to unit beforehand.
+func indexGo(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher, sink indexer.Sink, summarize func(*gopb.MethodSetSummary) error) error {
+	pis, err := indexer.ResolveAll(claims.Attach(unit), f, &indexer.ResolveOptions{
 		Info:       indexer.XRefTypeInfo(),
 		CheckRules: checkMetadata,
 	})
 	if err != nil {
 		return err
 	}
-	if *verbose {
-		log.Printf("Finished resolving compilation: %s", pi.String())
+	var sums []*gopb.MethodSetSummary
+	for _, pi := range pis {
+		if *verbose {
+			log.Printf("Finished resolving compilation: %s", pi.String())
+		}
+		if err := pi.Emit(ctx, sink, &indexer.EmitOptions{
+			EmitStandardLibs: *doLibNodes,
+			EmitMarkedSource: *doCodeFacts,
+			EmitLinkages:     *metaSuffix != "",
+			DocBase:          docURL,
+			EmitDynamicCalls: *doDynamic,
+		}); err != nil {
+			return err
+		}
+		if summarize != nil {
+			sums = append(sums, pi.MethodSummary())
+		}
 	}
-	return pi.Emit(ctx, writeEntry, &indexer.EmitOptions{
-		EmitStandardLibs: *doLibNodes,
-		EmitMarkedSource: *doCodeFacts,
-		EmitLinkages:     *metaSuffix != "",
-		DocBase:          docURL,
-	})
+	if summarize != nil {
+		if err := summarize(indexer.MergeSummaries(sums)); err != nil {
+			return fmt.Errorf("writing summary: %v", err)
+		}
+	}
+	return nil
 }
 
 type visitFunc func(context.Context, *apb.CompilationUnit, indexer.Fetcher) error
 
-// visitPath invokes visit for each compilation denoted by path, which is
-// either a .kindex file (with a single compilation) or an index pack.
-func visitPath(ctx context.Context, path string, visit visitFunc) error {
+// visitPath indexes each compilation denoted by path, which is either a
+// .kindex file (with a single compilation) or a .kzip file, using run. It
+// returns once all the compilations have been indexed.
+func visitPath(ctx context.Context, path string, run *runner) error {
 	f, err := os.Open(path)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
+
+	visit := run.visit(path)
 	switch ext := filepath.Ext(path); ext {
 	case ".kindex":
 		idx, err := kindex.New(f)
 		if err != nil {
 			return fmt.Errorf("reading .kindex: %v", err)
 		}
-		return visit(ctx, idx.Proto, idx)
+		err = visit(ctx, idx.Proto, idx)
 	case ".kzip":
-		return kzip.Scan(f, func(r *kzip.Reader, unit *kzip.Unit) error {
+		err = kzip.Scan(f, func(r *kzip.Reader, unit *kzip.Unit) error {
 			return visit(ctx, unit.Proto, kzipFetcher{r})
 		})
 
 	default:
 		return fmt.Errorf("unknown file extension %q", ext)
 	}
+
+	// Concurrent compilations read their inputs from f, so it must remain
+	// open until they are finished.
+	if werr := run.wait(); err == nil {
+		err = werr
+	}
+	return err
 }
 
 type kzipFetcher struct{ r *kzip.Reader }
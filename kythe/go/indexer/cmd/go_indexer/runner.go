@@ -0,0 +1,222 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"sync"
+	"time"
+
+	"kythe.io/kythe/go/indexer"
+
+	"github.com/golang/protobuf/proto"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	gopb "kythe.io/kythe/proto/go_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// A runner indexes the compilations delivered by visitPath, either one at a
+// time or, if its parallelism is greater than 1, concurrently. In the latter
+// case the output of each compilation is buffered and written as a unit once
+// indexing succeeds, so that the output of different compilations does not
+// interleave and failed compilations write nothing.
+type runner struct {
+	sem chan struct{} // limits concurrent compilations; nil if serial
+	wg  sync.WaitGroup
+
+	mu      sync.Mutex    // protects the fields below and the outputs
+	err     error         // the first error, unless --continue is set
+	results []*unitResult // in the order compilations were visited
+}
+
+// newRunner returns a runner that indexes up to parallelism compilations
+// concurrently.
+func newRunner(parallelism int) *runner {
+	r := &runner{results: []*unitResult{}}
+	if parallelism > 1 {
+		r.sem = make(chan struct{}, parallelism)
+	}
+	return r
+}
+
+// A unitResult records the outcome of indexing a single compilation.
+type unitResult struct {
+	Input      string     `json:"input"` // the path of the file containing the compilation
+	VName      *spb.VName `json:"vname"` // the vname of the compilation
+	Success    bool       `json:"success"`
+	Error      string     `json:"error,omitempty"`
+	Entries    int        `json:"entries"`              // the number of entries written
+	Duplicates int        `json:"duplicates,omitempty"` // the number of duplicate entries discarded
+	Millis     int64      `json:"duration_ms"`          // the time spent indexing
+}
+
+// visit returns a visitFunc that indexes the compilations found in the given
+// input path. When indexing concurrently, the visitFunc returns as soon as the
+// compilation has been scheduled; call wait to collect the outcome. Once a
+// compilation has failed, no further compilations are scheduled, and they do
+// not appear in the report.
+func (r *runner) visit(input string) visitFunc {
+	return func(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher) error {
+		if r.sem != nil {
+			r.sem <- struct{}{} // wait for a free worker before scheduling
+		}
+		res := &unitResult{Input: input, VName: unit.VName}
+		r.mu.Lock()
+		err := r.err
+		if err == nil {
+			r.results = append(r.results, res)
+		}
+		r.mu.Unlock()
+		if err != nil {
+			if r.sem != nil {
+				<-r.sem
+			}
+			return err // stop scheduling after a failure
+		}
+
+		if r.sem == nil {
+			start := time.Now()
+			err := indexGo(ctx, unit, f, func(ctx context.Context, entry *spb.Entry) error {
+				res.Entries++
+				return writeEntry(ctx, entry)
+			}, writeSummary)
+			res.Millis = time.Since(start).Nanoseconds() / 1e6
+			return r.finish(res, err)
+		}
+
+		r.wg.Add(1)
+		go func() {
+			defer func() { <-r.sem; r.wg.Done() }()
+			start := time.Now()
+			buf := &unitBuffer{seen: make(map[string]bool)}
+			var summarize func(*gopb.MethodSetSummary) error
+			if writeSummary != nil {
+				summarize = buf.writeSummary
+			}
+			err := indexGo(ctx, unit, f, buf.writeEntry, summarize)
+			if err == nil {
+				r.mu.Lock()
+				err = buf.flush(ctx)
+				r.mu.Unlock()
+				res.Entries, res.Duplicates = len(buf.entries), buf.dups
+			}
+			res.Millis = time.Since(start).Nanoseconds() / 1e6
+			r.finish(res, err)
+		}()
+		return nil
+	}
+}
+
+// finish records the outcome err of the compilation described by res. Unless
+// --continue is set, the first error is retained and returned by wait.
+func (r *runner) finish(res *unitResult, err error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res.Success = err == nil
+	if err == nil {
+		return nil
+	}
+	res.Error = err.Error()
+	if *contOnErr {
+		log.Printf("Continuing after error: %v", err)
+		return nil
+	}
+	if r.err == nil {
+		r.err = err
+	}
+	return err
+}
+
+// wait blocks until all scheduled compilations have been indexed, and returns
+// the first error encountered, if any.
+func (r *runner) wait() error {
+	r.wg.Wait()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// writeReport writes a JSON report of the outcome of each compilation to the
+// file at path.
+func (r *runner) writeReport(path string) error {
+	r.wg.Wait()
+	report := struct {
+		Units     []*unitResult `json:"units"`
+		Succeeded int           `json:"succeeded"`
+		Failed    int           `json:"failed"`
+	}{Units: r.results}
+	for _, res := range r.results {
+		if res.Success {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+	bits, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bits, 0644)
+}
+
+// A unitBuffer accumulates the output of a single compilation, discarding
+// duplicate entries, so that it can be written all at once.
+type unitBuffer struct {
+	entries   []*spb.Entry
+	summaries []*gopb.MethodSetSummary
+	seen      map[string]bool // the encodings of the entries
+	dups      int             // the number of duplicates discarded
+}
+
+func (b *unitBuffer) writeEntry(_ context.Context, entry *spb.Entry) error {
+	bits, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if b.seen[string(bits)] {
+		b.dups++
+		return nil
+	}
+	b.seen[string(bits)] = true
+	b.entries = append(b.entries, entry)
+	return nil
+}
+
+func (b *unitBuffer) writeSummary(sum *gopb.MethodSetSummary) error {
+	b.summaries = append(b.summaries, sum)
+	return nil
+}
+
+// flush writes the buffered output to the output streams. The caller must
+// hold the runner's lock.
+func (b *unitBuffer) flush(ctx context.Context) error {
+	for _, entry := range b.entries {
+		if err := writeEntry(ctx, entry); err != nil {
+			return err
+		}
+	}
+	for _, sum := range b.summaries {
+		if err := writeSummary(sum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"kythe.io/kythe/go/indexer"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	gopb "kythe.io/kythe/proto/go_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+type memFetcher map[string]string // :: digest → content
+
+func (m memFetcher) Fetch(_, digest string) ([]byte, error) {
+	if s, ok := m[digest]; ok {
+		return []byte(s), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+// testUnit returns a compilation of a package with a single source file, and a
+// fetcher for its contents. If ok is false, the contents are missing, and
+// indexing the compilation fails.
+func testUnit(name string, ok bool) (*apb.CompilationUnit, indexer.Fetcher) {
+	path := name + "/" + name + ".go"
+	unit := &apb.CompilationUnit{
+		VName: &spb.VName{Language: "go", Corpus: "test", Path: name, Signature: "package"},
+		RequiredInput: []*apb.CompilationUnit_FileInput{{
+			VName: &spb.VName{Corpus: "test", Path: path},
+			Info:  &apb.FileInfo{Path: path, Digest: name},
+		}},
+		SourceFile: []string{path},
+	}
+	if !ok {
+		return unit, memFetcher{}
+	}
+	return unit, memFetcher{name: "package " + name + "\n\nvar x, y int\n"}
+}
+
+// captureEntries replaces writeEntry with a function counting the entries
+// written to it, and returns a function that restores the original.
+func captureEntries(n *int) func() {
+	orig := writeEntry
+	writeEntry = func(context.Context, *spb.Entry) error {
+		*n++
+		return nil
+	}
+	return func() { writeEntry = orig }
+}
+
+func TestRunner(t *testing.T) {
+	ctx := context.Background()
+	for _, parallelism := range []int{1, 3} {
+		var written int
+		restore := captureEntries(&written)
+		run := newRunner(parallelism)
+		visit := run.visit("input.kzip")
+		for i := 0; i < 6; i++ {
+			unit, f := testUnit(fmt.Sprintf("p%d", i), true)
+			if err := visit(ctx, unit, f); err != nil {
+				t.Errorf("Parallelism %d: visit failed: %v", parallelism, err)
+			}
+		}
+		if err := run.wait(); err != nil {
+			t.Errorf("Parallelism %d: wait failed: %v", parallelism, err)
+		}
+		restore()
+
+		var entries int
+		for _, res := range run.results {
+			if !res.Success || res.Error != "" || res.Entries == 0 || res.Input != "input.kzip" {
+				t.Errorf("Parallelism %d: unexpected result %+v", parallelism, res)
+			}
+			entries += res.Entries
+		}
+		if len(run.results) != 6 {
+			t.Errorf("Parallelism %d: got %d results, want 6", parallelism, len(run.results))
+		}
+		if entries != written {
+			t.Errorf("Parallelism %d: results report %d entries, but %d were written", parallelism, entries, written)
+		}
+	}
+}
+
+func TestRunnerFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestRunnerFailure")
+	if err != nil {
+		t.Fatalf("Creating temp directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var written int
+	defer captureEntries(&written)()
+
+	// Visit compilations as a kzip.Scan would, stopping at the first error.
+	ctx := context.Background()
+	run := newRunner(3)
+	visit := run.visit("input.kzip")
+	for i := 0; i < 20; i++ {
+		unit, f := testUnit(fmt.Sprintf("p%d", i), i != 1)
+		if visit(ctx, unit, f) != nil {
+			break
+		}
+	}
+	if err := run.wait(); err == nil {
+		t.Error("wait: got success, want an error for the failed compilation")
+	}
+	n := len(run.results)
+	if unit, f := testUnit("after", true); visit(ctx, unit, f) == nil {
+		t.Error("visit after a failure: got success, want the failure")
+	} else if len(run.results) != n {
+		t.Error("visit after a failure added a result")
+	}
+
+	path := filepath.Join(dir, "report.json")
+	if err := run.writeReport(path); err != nil {
+		t.Fatalf("writeReport failed: %v", err)
+	}
+	bits, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Reading report: %v", err)
+	}
+	var report struct {
+		Units []struct {
+			VName   *spb.VName `json:"vname"`
+			Success bool       `json:"success"`
+			Error   string     `json:"error"`
+			Entries int        `json:"entries"`
+		} `json:"units"`
+		Succeeded int `json:"succeeded"`
+		Failed    int `json:"failed"`
+	}
+	if err := json.Unmarshal(bits, &report); err != nil {
+		t.Fatalf("Decoding report: %v\n%s", err, bits)
+	}
+
+	// Every reported compilation was indexed, and either succeeded or failed
+	// with an error. Only successful compilations wrote their output.
+	var entries int
+	for _, u := range report.Units {
+		if u.Success == (u.Error != "") {
+			t.Errorf("Report for %q: success %v with error %q", u.VName.GetPath(), u.Success, u.Error)
+		}
+		if u.Success {
+			entries += u.Entries
+		} else if u.VName.GetPath() != "p1" {
+			t.Errorf("Report for %q: got failure %q, want success", u.VName.GetPath(), u.Error)
+		}
+	}
+	if report.Failed != 1 || report.Succeeded+report.Failed != len(report.Units) {
+		t.Errorf("Report: got %d succeeded and %d failed of %d, want 1 failed", report.Succeeded, report.Failed, len(report.Units))
+	}
+	if entries != written {
+		t.Errorf("Report lists %d entries, but %d were written", entries, written)
+	}
+}
+
+func TestUnitBuffer(t *testing.T) {
+	var written int
+	defer captureEntries(&written)()
+	orig := writeSummary
+	defer func() { writeSummary = orig }()
+	var summaries int
+	writeSummary = func(*gopb.MethodSetSummary) error {
+		summaries++
+		return nil
+	}
+
+	ctx := context.Background()
+	buf := &unitBuffer{seen: make(map[string]bool)}
+	a := &spb.Entry{Source: &spb.VName{Signature: "a"}, FactName: "/kythe/node/kind", FactValue: []byte("record")}
+	b := &spb.Entry{Source: &spb.VName{Signature: "b"}, FactName: "/kythe/node/kind", FactValue: []byte("record")}
+	for _, e := range []*spb.Entry{a, b, a, a} {
+		if err := buf.writeEntry(ctx, e); err != nil {
+			t.Fatalf("writeEntry failed: %v", err)
+		}
+	}
+	if err := buf.writeSummary(&gopb.MethodSetSummary{}); err != nil {
+		t.Fatalf("writeSummary failed: %v", err)
+	}
+	if written != 0 || summaries != 0 {
+		t.Errorf("Output written before flush: %d entries, %d summaries", written, summaries)
+	}
+	if err := buf.flush(ctx); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+	if written != 2 || buf.dups != 2 || summaries != 1 {
+		t.Errorf("flush: wrote %d entries (%d duplicates) and %d summaries, want 2 (2) and 1", written, buf.dups, summaries)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+
+	"kythe.io/kythe/go/platform/analysis"
+	"kythe.io/kythe/go/platform/analysis/remote"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	aspb "kythe.io/kythe/proto/analysis_service_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// goAnalyzer is an analysis.CompilationAnalyzer that indexes Go compilations,
+// fetching their inputs from the file data service named by each request.
+type goAnalyzer struct{}
+
+// Analyze implements the analysis.CompilationAnalyzer interface. Each entry is
+// sent as the wire-format value of an AnalysisOutput.
+func (goAnalyzer) Analyze(ctx context.Context, req *apb.AnalysisRequest, f analysis.OutputFunc) error {
+	if req.FileDataService == "" {
+		return status.Error(codes.InvalidArgument, "no file data service was specified")
+	}
+	conn, err := grpc.DialContext(ctx, req.FileDataService, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	fetcher := remote.NewFetcher(ctx, aspb.NewFileDataServiceClient(conn))
+	return indexGo(ctx, req.Compilation, fetcher, func(ctx context.Context, entry *spb.Entry) error {
+		bits, err := proto.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		return f(ctx, &apb.AnalysisOutput{Value: bits})
+	}, nil)
+}
+
+// serve runs a CompilationAnalyzer service for Go compilations at the given
+// address until it fails.
+func serve(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s := grpc.NewServer()
+	aspb.RegisterCompilationAnalyzerServer(s, remote.NewAnalyzerServer(goAnalyzer{}))
+	log.Printf("Serving CompilationAnalyzer at %s", lis.Addr())
+	return s.Serve(lis)
+}
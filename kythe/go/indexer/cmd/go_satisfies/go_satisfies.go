@@ -0,0 +1,112 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Program go_satisfies joins the method set summaries written by the Go
+// indexer for the packages of a corpus, and emits the satisfies and overrides
+// edges between types in packages that do not import one another.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"kythe.io/kythe/go/indexer"
+	"kythe.io/kythe/go/platform/delimited"
+
+	gopb "kythe.io/kythe/proto/go_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+var doJSON = flag.Bool("json", false, "Write output as JSON")
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage: %s [options] <path>...
+
+Read the method set summaries written by go_indexer --summary to the files
+named by the path arguments, and write the satisfies edges between types whose
+packages were not visible to one another when they were indexed, along with
+the corresponding overrides edges. Output is written to stdout.
+
+By default, the output is a delimited stream of wire-format Kythe Entry
+protobuf messages. With the --json flag, output is instead a stream of
+undelimited JSON messages.
+
+Options:
+`, filepath.Base(os.Args[0]))
+
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		log.Fatal("No summary paths were specified")
+	}
+	var sink indexer.Sink
+	if *doJSON {
+		enc := json.NewEncoder(os.Stdout)
+		sink = func(_ context.Context, entry *spb.Entry) error {
+			return enc.Encode(entry)
+		}
+	} else {
+		rw := delimited.NewWriter(os.Stdout)
+		sink = func(_ context.Context, entry *spb.Entry) error {
+			return rw.PutProto(entry)
+		}
+	}
+
+	var sums []*gopb.MethodSetSummary
+	for _, path := range flag.Args() {
+		s, err := readSummaries(path)
+		if err != nil {
+			log.Fatalf("Error reading summaries from %q: %v", path, err)
+		}
+		sums = append(sums, s...)
+	}
+	if err := indexer.JoinSummaries(context.Background(), sums, sink); err != nil {
+		log.Fatalf("Error joining summaries: %v", err)
+	}
+}
+
+// readSummaries reads a delimited stream of summaries from the file at path.
+func readSummaries(path string) ([]*gopb.MethodSetSummary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var sums []*gopb.MethodSetSummary
+	rd := delimited.NewReader(f)
+	for {
+		sum := new(gopb.MethodSetSummary)
+		if err := rd.NextProto(sum); err == io.EOF {
+			return sums, nil
+		} else if err != nil {
+			return nil, err
+		}
+		sums = append(sums, sum)
+	}
+}
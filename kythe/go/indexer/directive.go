@@ -0,0 +1,305 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+import (
+	"go/ast"
+	"go/build"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"kythe.io/kythe/go/extractors/govname"
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
+
+	"github.com/golang/protobuf/proto"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// A directiveArg is a single argument of a //go: directive, along with the
+// byte offsets in the source file of the text it was parsed from.
+type directiveArg struct {
+	text       string // the argument, unquoted if necessary
+	start, end int    // the span of the argument in the source
+}
+
+// visitDirectives handles the //go: directives in the comments of file. The
+// directives that name other entities (files, packages, or symbols) are linked
+// to those entities by anchors spanning their arguments.
+func (e *emitter) visitDirectives(file *ast.File) {
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			if !strings.HasPrefix(c.Text, "//go:") {
+				continue
+			}
+			offset := e.pi.FileSet.Position(c.Slash).Offset
+			name, args := parseDirective(c.Text, offset)
+			switch name {
+			case "go:embed":
+				e.emitEmbed(file, args)
+			case "go:generate":
+				e.emitGenerate(file, args)
+			case "go:linkname":
+				e.emitLinkname(file, args)
+			}
+		}
+	}
+}
+
+// emitEmbed emits references from the patterns of a //go:embed directive to
+// each of the files they match among the inputs of the compilation.
+func (e *emitter) emitEmbed(file *ast.File, args []directiveArg) {
+	dir := path.Dir(e.pi.FileVName(file).Path)
+	for _, arg := range args {
+		for _, input := range e.pi.inputPaths() {
+			rel := strings.TrimPrefix(input, dir+"/")
+			if rel == input || !embedMatch(arg.text, rel) {
+				continue
+			}
+			target := e.pi.inputs[input]
+			if e.pi.claims(target) {
+				e.writeFact(target, facts.NodeKind, nodes.File)
+			}
+			e.writeSpanRef(file, arg.start, arg.end, target, edges.Ref)
+		}
+	}
+}
+
+// emitGenerate emits a reference from a //go:generate directive to the package
+// of the generator it runs, if the generator is run from source with "go run"
+// and the package can be identified. Generators run via a command in the
+// user's path cannot be resolved, and are skipped.
+func (e *emitter) emitGenerate(file *ast.File, args []directiveArg) {
+	if len(args) < 2 || args[0].text != "go" || args[1].text != "run" {
+		return
+	}
+	for _, arg := range args[2:] {
+		if strings.HasPrefix(arg.text, "-") {
+			continue // a flag to "go run"
+		}
+		ipath := arg.text
+		if i := strings.Index(ipath, "@"); i >= 0 {
+			ipath = ipath[:i] // discard a version suffix
+		}
+		if strings.HasSuffix(ipath, ".go") || strings.Contains(ipath, "$") {
+			return // a source file or a path that requires expansion
+		}
+		if strings.HasPrefix(ipath, ".") {
+			ipath = path.Join(e.pi.ImportPath, ipath)
+		}
+		target := e.pi.importVName(ipath)
+		if e.opts.shouldEmit(target) {
+			e.writeFact(target, facts.NodeKind, nodes.Package)
+		}
+		e.writeSpanRef(file, arg.start, arg.end, target, edges.Ref)
+		return
+	}
+}
+
+// emitLinkname emits references from a //go:linkname directive to the local
+// symbol it names and, if present, to the symbol it is linked to.
+func (e *emitter) emitLinkname(file *ast.File, args []directiveArg) {
+	if len(args) == 0 {
+		return
+	}
+	if obj := e.pi.Package.Scope().Lookup(args[0].text); obj != nil {
+		e.writeSpanRef(file, args[0].start, args[0].end, e.pi.ObjectVName(obj), edges.Ref)
+	}
+	if len(args) < 2 {
+		return
+	}
+	if target := e.linknameVName(args[1].text); target != nil {
+		e.writeSpanRef(file, args[1].start, args[1].end, target, edges.Ref)
+	}
+}
+
+// linknameVName returns the vname of the symbol denoted by the target of a
+// //go:linkname directive, having the form "importpath.name", or nil if it
+// cannot be determined. If the package is available to this compilation, the
+// name is resolved in that package; otherwise a function is assumed, since
+// that is by far the most common use of the directive.
+func (e *emitter) linknameVName(target string) *spb.VName {
+	slash := strings.LastIndex(target, "/")
+	dot := strings.Index(target[slash+1:], ".")
+	if dot < 0 {
+		return nil
+	}
+	ipath, name := target[:slash+1+dot], target[slash+2+dot:]
+
+	pkg := e.pi.Dependencies[ipath]
+	if ipath == e.pi.ImportPath {
+		pkg = e.pi.Package
+	}
+	if pkg != nil {
+		if obj := lookupLinkname(pkg, name); obj != nil {
+			return e.pi.ObjectVName(obj)
+		}
+		return nil
+	}
+	if !isIdentifier(name) {
+		return nil // a method, which we cannot name without its receiver type
+	}
+	vname := e.pi.importVName(ipath)
+	vname.Signature = tagFunc + " " + name
+	return vname
+}
+
+// lookupLinkname returns the object in pkg denoted by name, which is either a
+// package-level name or a method written "T.m" or "(*T).m". It returns nil if
+// no such object exists.
+func lookupLinkname(pkg *types.Package, name string) types.Object {
+	dot := strings.LastIndex(name, ".")
+	if dot < 0 {
+		return pkg.Scope().Lookup(name)
+	}
+	recv, method := strings.Trim(name[:dot], "()*"), name[dot+1:]
+	tn, ok := pkg.Scope().Lookup(recv).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(tn.Type()), false, pkg, method)
+	if _, ok := obj.(*types.Func); !ok {
+		return nil
+	}
+	return obj
+}
+
+// isIdentifier reports whether name is a Go identifier that is not a keyword.
+func isIdentifier(name string) bool {
+	if name == "" || token.Lookup(name).IsKeyword() {
+		return false
+	}
+	for i, r := range name {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// inputPaths returns the paths of the non-source inputs of the compilation, in
+// lexicographic order.
+func (pi *PackageInfo) inputPaths() []string {
+	var paths []string
+	for p := range pi.inputs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// importVName returns a vname for the package with the given import path. If
+// the package is available to this compilation its vname is used; otherwise a
+// vname is synthesized from the import path.
+func (pi *PackageInfo) importVName(ipath string) *spb.VName {
+	if ipath == pi.ImportPath {
+		return proto.Clone(pi.VName).(*spb.VName)
+	}
+	if vname := pi.PackageVName[pi.Dependencies[ipath]]; vname != nil {
+		return proto.Clone(vname).(*spb.VName)
+	}
+	if tail := strings.TrimPrefix(ipath, pi.VName.Corpus+"/"); tail != ipath {
+		vname := proto.Clone(pi.VName).(*spb.VName)
+		vname.Path = tail
+		return vname
+	}
+	// By convention, only standard library import paths lack a dot in their
+	// first path component.
+	first := strings.SplitN(ipath, "/", 2)[0]
+	return govname.ForPackage(pi.VName.Corpus, &build.Package{
+		ImportPath: ipath,
+		Goroot:     !strings.Contains(first, "."),
+	})
+}
+
+// parseDirective splits the text of a //go: directive comment, beginning at
+// the given offset in the source, into its name and arguments. Arguments are
+// separated by spaces, and may be quoted using Go string syntax.
+func parseDirective(text string, offset int) (string, []directiveArg) {
+	var name string
+	var args []directiveArg
+	i := len("//")
+	for i < len(text) {
+		if unicode.IsSpace(rune(text[i])) {
+			i++
+			continue
+		}
+		j := i
+		if q := text[i]; q == '"' || q == '`' {
+			// Find the closing quote, skipping escapes in interpreted strings.
+			for j++; j < len(text) && text[j] != q; j++ {
+				if q == '"' && text[j] == '\\' {
+					j++
+				}
+			}
+			if j < len(text) {
+				j++
+			}
+		} else {
+			for j < len(text) && !unicode.IsSpace(rune(text[j])) {
+				j++
+			}
+		}
+		word := text[i:j]
+		if unq, err := strconv.Unquote(word); err == nil {
+			word = unq
+		}
+		if name == "" {
+			name = word
+		} else {
+			args = append(args, directiveArg{text: word, start: offset + i, end: offset + j})
+		}
+		i = j
+	}
+	return name, args
+}
+
+// embedMatch reports whether a //go:embed pattern matches the file at the
+// given path, relative to the directory of the source file containing the
+// directive. A pattern naming a directory matches all the files beneath it,
+// except those whose names begin with "." or "_" unless the pattern has the
+// prefix "all:".
+func embedMatch(pattern, rel string) bool {
+	all := strings.HasPrefix(pattern, "all:")
+	pattern = strings.TrimPrefix(pattern, "all:")
+	if ok, _ := path.Match(pattern, rel); ok {
+		return true
+	}
+	parts := strings.Split(rel, "/")
+	for i := 1; i < len(parts); i++ {
+		if ok, _ := path.Match(pattern, strings.Join(parts[:i], "/")); !ok {
+			continue
+		}
+		if all {
+			return true
+		}
+		for _, p := range parts[i:] {
+			if strings.HasPrefix(p, ".") || strings.HasPrefix(p, "_") {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"kythe.io/kythe/go/util/schema/edges"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// A docLink is the span of a doc link such as [pkg.Name] within the text of a
+// comment, excluding its brackets.
+type docLink struct {
+	start, end int
+}
+
+// docLinks returns the text of comment for use in a doc node, along with the
+// targets of the doc links it contains. Each resolved link is marked in the
+// text with brackets, and an anchor spanning it in the source is emitted with
+// a ref/doc edge to its target. All other text is escaped, so that the ith
+// unescaped bracket in the text marks the link to the ith target.
+func (e *emitter) docLinks(comment *ast.Comment) (string, []*spb.VName) {
+	file, start, _ := e.pi.Span(comment)
+	text, offset := trimComment(comment.Text)
+	start += offset
+
+	var buf strings.Builder
+	var targets []*spb.VName
+	pos := 0
+	for _, link := range findDocLinks(text) {
+		name := text[link.start:link.end]
+		target := e.resolveDocLink(file, name)
+		if target == nil {
+			continue
+		}
+		buf.WriteString(escComment.Replace(text[pos : link.start-1]))
+		buf.WriteString("[" + escComment.Replace(name) + "]")
+		pos = link.end + 1
+		targets = append(targets, target)
+		e.writeSpanRef(file, start+link.start, start+link.end, target, edges.RefDoc)
+	}
+	buf.WriteString(escComment.Replace(text[pos:]))
+	return buf.String(), targets
+}
+
+// findDocLinks returns the spans of the candidate doc links in text, following
+// the syntax of Go doc comments: A doc link is a bracketed, dot-separated name
+// optionally preceded by an import path and a "*", such as [Name], [*T.Method],
+// or [encoding/json.Marshal]. The brackets must not be adjacent to letters or
+// digits, and a bracketed name at the start of the text followed by a colon is
+// a link definition, not a doc link.
+func findDocLinks(text string) []docLink {
+	var links []docLink
+	for i := 0; i < len(text); i++ {
+		if text[i] != '[' {
+			continue
+		}
+		end := strings.IndexByte(text[i+1:], ']')
+		if end < 0 {
+			break
+		}
+		start, end := i+1, i+1+end
+		i = end
+		if !isDocLinkName(text[start:end]) {
+			continue
+		}
+		if r, _ := utf8.DecodeLastRuneInString(text[:start-1]); isWordRune(r) {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(text[end+1:]); isWordRune(r) || r == '[' || r == '(' {
+			continue
+		} else if r == ':' && start == 1 {
+			continue // a link definition, e.g., "[Name]: http://..."
+		}
+		links = append(links, docLink{start: start, end: end})
+	}
+	return links
+}
+
+// isDocLinkName reports whether name has the syntax of a doc link target.
+func isDocLinkName(name string) bool {
+	name = strings.TrimPrefix(name, "*")
+	slash := strings.LastIndex(name, "/")
+	if slash >= 0 {
+		// The import path may contain characters that identifiers cannot, but
+		// may not contain spaces or brackets.
+		if strings.ContainsAny(name[:slash], " \t[]") {
+			return false
+		}
+		name = name[slash+1:]
+	}
+	if name == "" {
+		return false
+	}
+	for _, part := range strings.Split(name, ".") {
+		if !isIdentifier(part) && (slash < 0 || part == "") {
+			return false
+		}
+	}
+	return true
+}
+
+// isWordRune reports whether r is a letter, digit, or underscore.
+func isWordRune(r rune) bool { return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+// resolveDocLink returns the vname of the target of a doc link with the given
+// name in file, or nil if it does not resolve. Following the conventions of Go
+// doc comments, [Name] and [Name.Member] refer to objects in the current
+// package, while [pkg], [pkg.Name], and [pkg.Name.Member] refer to objects in
+// an imported package, which may be given either by its name as imported in
+// file or by its full import path.
+func (e *emitter) resolveDocLink(file *ast.File, name string) *spb.VName {
+	name = strings.TrimPrefix(name, "*")
+
+	var pkg *types.Package
+	var parts []string
+	if slash := strings.LastIndex(name, "/"); slash >= 0 {
+		ipath, rest := name, ""
+		if dot := strings.Index(name[slash:], "."); dot >= 0 {
+			ipath, rest = name[:slash+dot], name[slash+dot+1:]
+		}
+		if pkg = e.docPackage(ipath); pkg == nil {
+			return nil
+		} else if rest == "" {
+			return e.packageVName(pkg)
+		}
+		parts = strings.Split(rest, ".")
+	} else {
+		parts = strings.Split(name, ".")
+		if e.pi.Package.Scope().Lookup(parts[0]) != nil {
+			pkg = e.pi.Package
+		} else if pkg = e.fileImport(file, parts[0]); pkg == nil {
+			return nil
+		} else if parts = parts[1:]; len(parts) == 0 {
+			return e.packageVName(pkg)
+		}
+	}
+
+	obj := pkg.Scope().Lookup(parts[0])
+	if obj == nil || (pkg != e.pi.Package && !obj.Exported()) {
+		return nil
+	}
+	switch len(parts) {
+	case 1:
+		return e.pi.ObjectVName(obj)
+	case 2:
+		if _, ok := obj.(*types.TypeName); !ok {
+			return nil
+		}
+		member, _, _ := types.LookupFieldOrMethod(types.NewPointer(obj.Type()), false, pkg, parts[1])
+		if member == nil {
+			return nil
+		}
+		return e.pi.ObjectVName(member)
+	}
+	return nil
+}
+
+// docPackage returns the package with the given import path, if it is this
+// package or one of its dependencies, or nil.
+func (e *emitter) docPackage(ipath string) *types.Package {
+	if ipath == e.pi.ImportPath {
+		return e.pi.Package
+	}
+	return e.pi.Dependencies[ipath]
+}
+
+// fileImport returns the package imported under the given name in file, or nil
+// if there is none.
+func (e *emitter) fileImport(file *ast.File, name string) *types.Package {
+	for _, spec := range file.Imports {
+		obj := e.pi.Info.Implicits[spec]
+		if spec.Name != nil {
+			obj = e.pi.Info.Defs[spec.Name]
+		}
+		if pn, ok := obj.(*types.PkgName); ok && pn.Name() == name {
+			return pn.Imported()
+		}
+	}
+	return nil
+}
+
+// packageVName returns the vname of pkg, which is either this package or one
+// of its dependencies.
+func (e *emitter) packageVName(pkg *types.Package) *spb.VName {
+	if pkg == e.pi.Package {
+		return e.pi.VName
+	}
+	return e.pi.PackageVName[pkg]
+}
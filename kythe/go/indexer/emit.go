@@ -27,6 +27,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"kythe.io/kythe/go/extractors/govname"
 	"kythe.io/kythe/go/util/metadata"
@@ -35,6 +36,7 @@ import (
 	"kythe.io/kythe/go/util/schema/nodes"
 
 	"github.com/golang/protobuf/proto"
+	"golang.org/x/tools/go/ast/astutil"
 	"golang.org/x/tools/go/types/typeutil"
 
 	cpb "kythe.io/kythe/proto/common_go_proto"
@@ -58,6 +60,12 @@ type EmitOptions struct {
 	// If set, use this as the base URL for links to godoc.  The import path is
 	// appended to the path of this URL to obtain the target URL to link to.
 	DocBase *url.URL
+
+	// If true, emit ref/call/dynamic edges from each call site of an interface
+	// method to the concrete methods it may dispatch to. The possible callees
+	// are found by class hierarchy analysis over the named types known to the
+	// compilation, including those of its dependencies.
+	EmitDynamicCalls bool
 }
 
 // shouldEmit reports whether the indexer should emit a node for the given
@@ -81,6 +89,12 @@ func (e *EmitOptions) docURL(pi *PackageInfo) string {
 // An impl records that a type A implements an interface B.
 type impl struct{ A, B types.Object }
 
+// A dynamicCall records a call site of an interface method.
+type dynamicCall struct {
+	anchor *spb.VName  // the anchor spanning the call expression
+	method *types.Func // the abstract method being called
+}
+
 // Emit generates Kythe facts and edges to represent pi, and writes them to
 // sink. In case of errors, processing continues as far as possible before the
 // first error encountered is reported.
@@ -121,12 +135,22 @@ func (pi *PackageInfo) Emit(ctx context.Context, sink Sink, opts *EmitOptions) e
 		e.emitCode(pi.VName, ms)
 	}
 
-	// Emit facts for all the source files claimed by this package.
+	// Emit nodes for the C types linked to C++ nodes, if any; see cgoPackage.
+	if pi.cgo != nil {
+		for _, node := range pi.cgo.nodes {
+			e.writeFact(node.vname, facts.NodeKind, node.kind)
+		}
+	}
+
+	// Emit facts for all the source files claimed by this package. A file
+	// shared with other compilations is described only by its claimant.
 	for file, text := range pi.SourceText {
 		vname := pi.FileVName(file)
-		e.writeFact(vname, facts.NodeKind, nodes.File)
-		e.writeFact(vname, facts.Text, text)
-		// All Go source files are encoded as UTF-8, which is the default.
+		if pi.claims(vname) {
+			e.writeFact(vname, facts.NodeKind, nodes.File)
+			e.writeFact(vname, facts.Text, text)
+			// All Go source files are encoded as UTF-8, which is the default.
+		}
 
 		e.writeEdge(vname, pi.VName, edges.ChildOf)
 	}
@@ -158,15 +182,21 @@ func (pi *PackageInfo) Emit(ctx context.Context, sink Sink, opts *EmitOptions) e
 			}
 			return true
 		}), file)
+		e.visitDirectives(file)
 	}
 
 	// Emit edges from each named type to the interface types it satisfies, for
 	// those interface types that are known to this compiltion.
 	e.emitSatisfactions()
 
-	// TODO(fromberger): Add diagnostics for type-checker errors.
+	// Emit edges from interface method call sites to their possible callees.
+	e.emitDynamicCalls()
+
+	// Emit diagnostics for type-checker errors, so that consumers can see why
+	// the package may be only partially indexed.
 	for _, err := range pi.Errors {
 		log.Printf("WARNING: Type resolution error: %v", err)
+		e.writeTypeError(err)
 	}
 	return e.firstErr
 }
@@ -179,6 +209,8 @@ type emitter struct {
 	impl     map[impl]bool                        // see checkImplements
 	rmap     map[*ast.File]map[int]metadata.Rules // see applyRules
 	anchored map[ast.Node]bool                    // see writeAnchor
+	calls    []dynamicCall                        // see emitDynamicCalls
+	names    []*types.TypeName                    // see knownTypeNames
 	firstErr error
 }
 
@@ -189,6 +221,12 @@ func (e *emitter) visitIdent(id *ast.Ident, stack stackFunc) {
 	if obj == nil {
 		// Defining identifiers are handled by their parent nodes.
 		return
+	} else if isCgoPackage(obj) {
+		// The "C" package has no node of its own; its members refer to C
+		// entities (see cgoPackage).
+		return
+	} else if e.pi.cgo.unlinked(obj) {
+		return // a C entity with no C++ node to refer to
 	}
 
 	target := e.pi.ObjectVName(obj)
@@ -202,9 +240,17 @@ func (e *emitter) visitIdent(id *ast.Ident, stack stackFunc) {
 		})
 		return
 	}
-	e.writeRef(id, target, edges.Ref)
+	kind := edges.Ref
+	if isWrite(id, obj, stack) {
+		kind = edges.RefWrites
+	}
+	anchor := e.writeRef(id, target, kind)
+	e.emitInstance(id, anchor, target, stack)
 	if call, ok := isCall(id, obj, stack); ok {
 		callAnchor := e.writeRef(call, target, edges.RefCall)
+		if fn := obj.(*types.Func); e.opts != nil && e.opts.EmitDynamicCalls && isAbstract(fn) {
+			e.calls = append(e.calls, dynamicCall{anchor: callAnchor, method: fn})
+		}
 
 		// Paint an edge to the function blamed for the call, or if there is
 		// none then to the package initializer.
@@ -251,6 +297,7 @@ func (e *emitter) visitFuncDecl(decl *ast.FuncDecl, stack stackFunc) {
 			e.writeEdge(info.vname, base, edges.ChildOf)
 		}
 	}
+	e.emitTypeParams(funcTypeParams(decl), info.vname)
 	e.emitParameters(decl.Type, sig, info)
 }
 
@@ -313,6 +360,7 @@ func (e *emitter) visitTypeSpec(spec *ast.TypeSpec, stack stackFunc) {
 	target := e.mustWriteBinding(spec.Name, "", e.nameContext(stack))
 	e.writeDef(spec, target)
 	e.writeDoc(specComment(spec, stack), target)
+	e.emitTypeParams(specTypeParams(spec), target)
 
 	// Emit type-specific structure.
 	switch t := obj.Type().Underlying().(type) {
@@ -331,6 +379,7 @@ func (e *emitter) visitTypeSpec(spec *ast.TypeSpec, stack stackFunc) {
 				target := e.writeVarBinding(id, nodes.Field, nil)
 				f := st.Fields.List[i]
 				e.writeDoc(f.Doc, target)
+				e.writeTags(f.Tag, target)
 				e.emitAnonFields(f.Type)
 			})
 
@@ -352,6 +401,7 @@ func (e *emitter) visitTypeSpec(spec *ast.TypeSpec, stack stackFunc) {
 					e.writeFact(target, facts.NodeKind, nodes.Variable)
 					e.writeFact(target, facts.Subkind, nodes.Field)
 					e.writeDoc(field.Doc, target)
+					e.writeTags(field.Tag, target)
 				}
 			}
 		}
@@ -393,6 +443,8 @@ func (e *emitter) visitImportSpec(spec *ast.ImportSpec, stack stackFunc) {
 	ipath, _ := strconv.Unquote(spec.Path.Value)
 	if vPath, ok := e.pi.Vendored[ipath]; ok {
 		ipath = vPath
+	} else if ipath == "C" {
+		return // the cgo pseudo-package; see visitIdent
 	}
 
 	pkg := e.pi.Dependencies[ipath]
@@ -487,20 +539,32 @@ func (e *emitter) visitCompositeLit(expr *ast.CompositeLit, stack stackFunc) {
 				log.Printf("ERROR: Found no field index for %v (skipping)", t.Key)
 				continue
 			}
-			e.emitPosRef(t.Value, sv.Field(f), edges.RefInit)
+			e.emitInit(t.Value, sv.Field(f))
 		default:
-			e.emitPosRef(t, sv.Field(i), edges.RefInit)
+			e.emitInit(t, sv.Field(i))
 		}
 	}
 }
 
-// emitPosRef emits an anchor spanning loc, pointing to obj.
-func (e *emitter) emitPosRef(loc ast.Node, obj types.Object, kind string) {
+// emitInit emits an anchor spanning the initializer value of a composite
+// literal, which both ref/inits and ref/writes the field it initializes.
+func (e *emitter) emitInit(value ast.Expr, field *types.Var) {
+	if e.pi.cgo.unlinked(field) {
+		return // a field of a C struct; see cgoPackage
+	}
+	anchor := e.emitPosRef(value, field, edges.RefInit)
+	e.writeEdge(anchor, e.pi.ObjectVName(field), edges.RefWrites)
+}
+
+// emitPosRef emits an anchor spanning loc, pointing to obj. The vname of the
+// anchor is returned.
+func (e *emitter) emitPosRef(loc ast.Node, obj types.Object, kind string) *spb.VName {
 	target := e.pi.ObjectVName(obj)
 	file, start, end := e.pi.Span(loc)
 	anchor := e.pi.AnchorVName(file, start, end)
 	e.writeAnchor(loc, anchor, start, end)
 	e.writeEdge(anchor, target, kind)
+	return anchor
 }
 
 // emitParameters emits parameter edges for the parameters of a function type,
@@ -531,6 +595,100 @@ func (e *emitter) emitParameters(ftype *ast.FuncType, sig *types.Signature, info
 	})
 }
 
+// typeVName returns a vname for typ if it denotes a named or basic type, a
+// type parameter, or an instantiation of a generic type; otherwise nil. No
+// nodes are emitted for instantiations; see emitType.
+func (e *emitter) typeVName(typ types.Type) *spb.VName {
+	if vname, ok := e.genericTypeVName(typ); ok {
+		return vname
+	} else if obj := aliasObject(typ); obj != nil {
+		return e.pi.ObjectVName(obj)
+	}
+	switch t := typ.(type) {
+	case *types.Named:
+		return e.pi.ObjectVName(t.Obj())
+	case *types.Basic:
+		if obj := types.Universe.Lookup(t.Name()); obj != nil {
+			return e.pi.ObjectVName(obj)
+		}
+	}
+	return nil
+}
+
+// emitType returns typeVName(typ) and, if typ is an instantiation of a generic
+// type, emits its tapp node.
+func (e *emitter) emitType(typ types.Type) *spb.VName {
+	vname := e.typeVName(typ)
+	e.emitInstanceType(typ, vname)
+	return vname
+}
+
+// typeString returns a string identifying typ for use in a signature of a
+// node in the same file as base. Unlike types.TypeString, each type with a
+// vname is identified by its signature, so that, e.g., type parameters of
+// different functions with the same name are distinguished. The signature is
+// qualified by the corpus, root, and path of the vname, unless they are those
+// of base. Other types are spelled out in terms of their components.
+func (e *emitter) typeString(typ types.Type, base *spb.VName) string {
+	if vname := e.typeVName(typ); vname != nil {
+		if vname.Corpus == base.Corpus && vname.Root == base.Root && vname.Path == base.Path {
+			return vname.Signature
+		}
+		return path.Join(vname.Corpus, vname.Root, vname.Path) + "." + vname.Signature
+	}
+	switch t := typ.(type) {
+	case *types.Pointer:
+		return "*" + e.typeString(t.Elem(), base)
+	case *types.Slice:
+		return "[]" + e.typeString(t.Elem(), base)
+	case *types.Array:
+		return "[" + strconv.FormatInt(t.Len(), 10) + "]" + e.typeString(t.Elem(), base)
+	case *types.Map:
+		return "map[" + e.typeString(t.Key(), base) + "]" + e.typeString(t.Elem(), base)
+	case *types.Chan:
+		prefix := "chan "
+		if t.Dir() == types.SendOnly {
+			prefix = "chan<- "
+		} else if t.Dir() == types.RecvOnly {
+			prefix = "<-chan "
+		}
+		return prefix + "(" + e.typeString(t.Elem(), base) + ")"
+	case *types.Signature:
+		s := "func" + e.tupleString(t.Params(), t.Variadic(), base)
+		if t.Results().Len() != 0 {
+			s += " " + e.tupleString(t.Results(), false, base)
+		}
+		return s
+	case *types.Struct:
+		fields := make([]string, t.NumFields())
+		for i := range fields {
+			f := t.Field(i)
+			fields[i] = f.Name() + " " + e.typeString(f.Type(), base)
+			if tag := t.Tag(i); tag != "" {
+				fields[i] += " " + strconv.Quote(tag)
+			}
+		}
+		return "struct{" + strings.Join(fields, "; ") + "}"
+	}
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		return e.pi.importPath(pkg)
+	})
+}
+
+// tupleString returns a parenthesized list of the typeStrings of the types of
+// the variables in tup. If variadic is true, the last is a variadic parameter.
+func (e *emitter) tupleString(tup *types.Tuple, variadic bool, base *spb.VName) string {
+	elts := make([]string, tup.Len())
+	for i := range elts {
+		if typ := tup.At(i).Type(); variadic && i == len(elts)-1 {
+			elts[i] = "..." + e.typeString(typ.(*types.Slice).Elem(), base)
+		} else {
+			elts[i] = e.typeString(typ, base)
+		}
+	}
+	return "(" + strings.Join(elts, ", ") + ")"
+}
+
 // emitAnonFields checks whether expr denotes an anonymous struct type, and if
 // so emits bindings for the fields of that struct. The resulting fields do not
 // parent to the struct, since it has no referential identity; but we do
@@ -540,6 +698,7 @@ func (e *emitter) emitAnonFields(expr ast.Expr) {
 		mapFields(st.Fields, func(i int, id *ast.Ident) {
 			target := e.writeVarBinding(id, nodes.Field, nil) // no parent
 			e.writeDoc(st.Fields.List[i].Doc, target)
+			e.writeTags(st.Fields.List[i].Tag, target)
 		})
 	}
 }
@@ -563,11 +722,14 @@ func (o overrides) seen(x, y types.Object) bool {
 	return ok
 }
 
-// emitSatisfactions visits each named type known through the compilation being
-// indexed, and emits edges connecting it to any known interfaces its method
-// set satisfies.
-func (e *emitter) emitSatisfactions() {
-	// Find the names of all defined types mentioned in this compilation.
+// knownTypeNames returns the names of all defined types mentioned in the
+// compilation being indexed, excluding generic types that have not been
+// instantiated and interfaces that may only be used as constraints, for which
+// satisfaction is not well-defined. The result is computed once and cached.
+func (e *emitter) knownTypeNames() []*types.TypeName {
+	if e.names != nil {
+		return e.names
+	}
 	var allNames []*types.TypeName
 
 	// For the current source package, use all names, even local ones.
@@ -597,6 +759,21 @@ func (e *emitter) emitSatisfactions() {
 		}
 	}
 
+	e.names = make([]*types.TypeName, 0, len(allNames))
+	for _, obj := range allNames {
+		if !isGeneric(obj.Type()) && !isConstraint(obj.Type()) {
+			e.names = append(e.names, obj)
+		}
+	}
+	return e.names
+}
+
+// emitSatisfactions visits each named type known through the compilation being
+// indexed, and emits edges connecting it to any known interfaces its method
+// set satisfies.
+func (e *emitter) emitSatisfactions() {
+	allNames := e.knownTypeNames()
+
 	// Cache the method set of each named type in this package.
 	var msets typeutil.MethodSetCache
 	// Cache the overrides we've noticed to avoid duplicate entries.
@@ -666,6 +843,54 @@ func (e *emitter) emitSatisfactions() {
 	}
 }
 
+// emitDynamicCalls emits a ref/call/dynamic edge from each recorded call site
+// of an interface method to each concrete method that may be invoked by that
+// call, namely the corresponding method of each known named type that
+// satisfies the interface (directly or via its pointer type).
+func (e *emitter) emitDynamicCalls() {
+	if len(e.calls) == 0 {
+		return
+	}
+
+	// Cache the possible callees of each abstract method, since most methods
+	// are called from more than one site.
+	callees := make(map[*types.Func][]*spb.VName)
+	for _, call := range e.calls {
+		targets, ok := callees[call.method]
+		if !ok {
+			targets = e.possibleCallees(call.method)
+			callees[call.method] = targets
+		}
+		for _, target := range targets {
+			e.writeEdge(call.anchor, target, edges.RefCallDynamic)
+		}
+	}
+}
+
+// possibleCallees returns the vnames of the concrete methods that a call to the
+// abstract method ym may dispatch to.
+func (e *emitter) possibleCallees(ym *types.Func) []*spb.VName {
+	iface, ok := ym.Type().(*types.Signature).Recv().Type().Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+	var targets []*spb.VName
+	for _, xobj := range e.knownTypeNames() {
+		x := xobj.Type()
+		if isInterface(x) {
+			continue
+		}
+		if !types.Implements(x, iface) && !types.Implements(types.NewPointer(x), iface) {
+			continue
+		}
+		xm, _, _ := types.LookupFieldOrMethod(x, true, ym.Pkg(), ym.Name())
+		if fn, ok := xm.(*types.Func); ok && !isAbstract(fn) {
+			targets = append(targets, e.pi.ObjectVName(fn))
+		}
+	}
+	return targets
+}
+
 // Add xm-(overrides)-ym for each concrete method xm with a corresponding
 // abstract method ym.
 func (e *emitter) emitOverrides(xmset, pxmset, ymset *types.MethodSet, cache overrides) {
@@ -708,6 +933,12 @@ func (e *emitter) emitCode(target *spb.VName, ms *cpb.MarkedSource) {
 
 func isInterface(typ types.Type) bool { _, ok := typ.Underlying().(*types.Interface); return ok }
 
+// isAbstract reports whether fn is a method of an interface type.
+func isAbstract(fn *types.Func) bool {
+	recv := fn.Type().(*types.Signature).Recv()
+	return recv != nil && isInterface(recv.Type())
+}
+
 func (e *emitter) check(err error) {
 	if err != nil && e.firstErr == nil {
 		e.firstErr = err
@@ -743,7 +974,16 @@ func (e *emitter) writeAnchor(node ast.Node, src *spb.VName, start, end int) {
 		return // this node already has an anchor
 	}
 	e.anchored[node] = true
-	e.check(e.sink.writeAnchor(e.ctx, src, start, end))
+	e.writeAnchorFacts(src, start, end)
+}
+
+// writeAnchorFacts emits the facts for an anchor spanning the given offsets.
+// If the package has a build configuration, it is recorded on the anchor.
+func (e *emitter) writeAnchorFacts(anchor *spb.VName, start, end int) {
+	e.check(e.sink.writeAnchor(e.ctx, anchor, start, end))
+	if e.pi.Context != "" {
+		e.writeFact(anchor, facts.BuildConfig, e.pi.Context)
+	}
 }
 
 func (e *emitter) writeDiagnostic(src *spb.VName, d diagnostic) {
@@ -757,6 +997,42 @@ func (e *emitter) writeNodeDiagnostic(src ast.Node, d diagnostic) {
 	e.writeDiagnostic(anchor, d)
 }
 
+// writeTypeError emits a diagnostic for an error reported by the type checker.
+// Errors positioned within one of the package's source files are tagged to an
+// anchor at that position; all others are tagged to the package's first file.
+func (e *emitter) writeTypeError(err error) {
+	d := diagnostic{
+		Message: err.Error(),
+		Details: fmt.Sprintf("Index data for package %q may be incomplete.", e.pi.ImportPath),
+	}
+	te, ok := err.(types.Error)
+	var file *ast.File
+	if ok && te.Pos.IsValid() {
+		d.Message = te.Msg
+		d.Details = te.Error() + "\n" + d.Details
+		file = e.pi.fileLoc[e.pi.FileSet.File(te.Pos)]
+	}
+	if file == nil {
+		if len(e.pi.Files) == 0 {
+			e.writeDiagnostic(e.pi.VName, d)
+		} else {
+			e.writeDiagnostic(e.pi.FileVName(e.pi.Files[0]), d)
+		}
+		return
+	}
+
+	// Prefer an anchor spanning the syntax at the error position, if there is
+	// one; otherwise fall back to an empty anchor at the position itself.
+	if path, _ := astutil.PathEnclosingInterval(file, te.Pos, te.Pos); len(path) != 0 && path[0].Pos() == te.Pos {
+		e.writeNodeDiagnostic(path[0], d)
+		return
+	}
+	pos := e.pi.FileSet.Position(te.Pos).Offset
+	anchor := e.pi.AnchorVName(file, pos, pos)
+	e.writeAnchorFacts(anchor, pos, pos)
+	e.writeDiagnostic(anchor, d)
+}
+
 // writeRef emits an anchor spanning origin and referring to target with an
 // edge of the given kind. The vname of the anchor is returned.
 func (e *emitter) writeRef(origin ast.Node, target *spb.VName, kind string) *spb.VName {
@@ -778,6 +1054,15 @@ func (e *emitter) writeRef(origin ast.Node, target *spb.VName, kind string) *spb
 	return anchor
 }
 
+// writeSpanRef emits an anchor spanning the given offsets of file and referring
+// to target with an edge of the given kind. Use this for references that do not
+// correspond to a node of the AST, e.g., within comments.
+func (e *emitter) writeSpanRef(file *ast.File, start, end int, target *spb.VName, kind string) {
+	anchor := e.pi.AnchorVName(file, start, end)
+	e.writeAnchorFacts(anchor, start, end)
+	e.writeEdge(anchor, target, kind)
+}
+
 // mustWriteBinding is as writeBinding, but panics if id does not resolve.  Use
 // this in cases where the object is known already to exist.
 func (e *emitter) mustWriteBinding(id *ast.Ident, kind string, parent *spb.VName) *spb.VName {
@@ -829,21 +1114,69 @@ func (e *emitter) writeBinding(id *ast.Ident, kind string, parent *spb.VName) *s
 func (e *emitter) writeDef(node ast.Node, target *spb.VName) { e.writeRef(node, target, edges.Defines) }
 
 // writeDoc adds associations between comment groups and a documented node.
+// Doc links such as [Name] or [pkg.Name] that resolve to known objects are
+// marked as links in the doc text, and anchored in the comment with ref/doc
+// edges to their targets.
 func (e *emitter) writeDoc(comments *ast.CommentGroup, target *spb.VName) {
 	if comments == nil || len(comments.List) == 0 || target == nil {
 		return
 	}
 	var lines []string
+	var links []*spb.VName
 	for _, comment := range comments.List {
-		lines = append(lines, trimComment(comment.Text))
+		line, refs := e.docLinks(comment)
+		lines = append(lines, line)
+		links = append(links, refs...)
 	}
 	docNode := proto.Clone(target).(*spb.VName)
 	docNode.Signature += " doc"
 	e.writeFact(docNode, facts.NodeKind, nodes.Doc)
 	e.writeFact(docNode, facts.Text, strings.Join(lines, "\n"))
+	for i, link := range links {
+		e.writeEdge(docNode, link, edges.ParamIndex(i))
+	}
 	e.writeEdge(docNode, target, edges.Documents)
 }
 
+// writeTags adds associations between the struct tag of a field and the field.
+// Each key:"value" pair in the tag is anchored and refers to a name node for
+// the key and the name it gives the field, e.g., json:"user_id,omitempty" gives
+// the name "user_id" under key "json". Fields sharing a tag name are thereby
+// linked across packages.
+func (e *emitter) writeTags(tag *ast.BasicLit, target *spb.VName) {
+	if tag == nil || target == nil {
+		return
+	}
+	// Anchors are only emitted if the tag has no escapes, so that offsets in
+	// its value correspond to offsets in the source.
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return
+	}
+	exact := value == tag.Value[1:len(tag.Value)-1]
+	file, start, _ := e.pi.Span(tag)
+	for _, st := range parseStructTag(value) {
+		vname := tagVName(st.key, st.name)
+		e.writeFact(vname, facts.NodeKind, nodes.Name)
+		e.writeEdge(target, vname, edges.Named)
+		if exact {
+			pos, end := start+1+st.start, start+1+st.end
+			anchor := e.pi.AnchorVName(file, pos, end)
+			e.writeAnchorFacts(anchor, pos, end)
+			e.writeEdge(anchor, vname, edges.Ref)
+		}
+	}
+}
+
+// tagVName returns the vname of the name node for a struct tag key and the name
+// associated with it. Like other name nodes, it has no corpus or path.
+func tagVName(key, name string) *spb.VName {
+	return &spb.VName{
+		Language:  govname.Language,
+		Signature: "tag " + key + ":" + name,
+	}
+}
+
 // isCall reports whether id is a call to obj.  This holds if id is in call
 // position ("id(...") or is the RHS of a selector in call position
 // ("x.id(...)"). If so, the nearest enclosing call expression is also
@@ -864,6 +1197,64 @@ func isCall(id *ast.Ident, obj types.Object, stack stackFunc) (*ast.CallExpr, bo
 	return nil, false
 }
 
+// isWrite reports whether id is a use of the variable or field obj that may
+// modify its value. This holds if id is the target of an assignment or an
+// increment or decrement ("id = ...", "x.id++"), or has its address taken
+// ("&x.id"). Fields initialized by composite literals are handled separately,
+// by visitCompositeLit.
+func isWrite(id *ast.Ident, obj types.Object, stack stackFunc) bool {
+	if _, ok := obj.(*types.Var); !ok {
+		return false
+	}
+
+	// Find the outermost expression denoting the variable, for either of the
+	// forms id or x.id, allowing for redundant parentheses.
+	var expr ast.Expr = id
+	i := 1
+	if sel, ok := stack(i).(*ast.SelectorExpr); ok && sel.Sel == id {
+		expr = sel
+		i++
+	}
+	for {
+		paren, ok := stack(i).(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren
+		i++
+	}
+
+	switch p := stack(i).(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range p.Lhs {
+			if lhs == expr {
+				return true
+			}
+		}
+	case *ast.IncDecStmt:
+		return p.X == expr
+	case *ast.UnaryExpr:
+		return p.Op == token.AND && p.X == expr
+	case *ast.RangeStmt:
+		return p.Tok == token.ASSIGN && (p.Key == expr || p.Value == expr)
+	}
+	return false
+}
+
+// isReceiver reports whether the ith stack entry is the type of a method
+// receiver, possibly indirected.
+func isReceiver(stack stackFunc, i int) bool {
+	if _, ok := stack(i).(*ast.StarExpr); ok {
+		i++
+	}
+	if _, ok := stack(i).(*ast.Field); !ok {
+		return false
+	}
+	list, _ := stack(i + 1).(*ast.FieldList)
+	decl, _ := stack(i + 2).(*ast.FuncDecl)
+	return list != nil && decl != nil && decl.Recv == list
+}
+
 // callContext returns funcInfo for the nearest enclosing parent function, not
 // including the node itself, or the enclosing package initializer if the node
 // is at the top level.
@@ -891,7 +1282,7 @@ func (e *emitter) callContext(stack stackFunc) *funcInfo {
 				// Since there is no location, attach it to the beginning of
 				// the file itself.
 				anchor := e.pi.AnchorVName(p, 0, 0)
-				e.check(e.sink.writeAnchor(e.ctx, anchor, 0, 0))
+				e.writeAnchorFacts(anchor, 0, 0)
 				e.writeEdge(anchor, vname, edges.Defines)
 			}
 			return fi
@@ -1016,16 +1407,86 @@ func fieldIndex(expr ast.Expr, sv *types.Struct) (int, bool) {
 
 var escComment = strings.NewReplacer("[", `\[`, "]", `\]`, `\`, `\\`)
 
+// A structTag is a key:"value" pair of a struct tag, along with the name it
+// associates with its field and its span within the tag.
+type structTag struct {
+	key, name  string
+	start, end int
+}
+
+// parseStructTag parses tag following the conventions of reflect.StructTag,
+// and returns the key:"value" pairs that name their field. The name is the
+// first comma-separated element of the value, or the element with the prefix
+// "name=" if there is one (as in protobuf tags). Names that are empty or "-"
+// are omitted, since by convention they do not name the field.
+func parseStructTag(tag string) []structTag {
+	var tags []structTag
+	pos := 0
+	for pos < len(tag) {
+		// Skip leading space.
+		for pos < len(tag) && tag[pos] == ' ' {
+			pos++
+		}
+		start := pos
+
+		// Scan to the colon. A space, a quote, or a control character is a
+		// syntax error.
+		for pos < len(tag) && tag[pos] > ' ' && tag[pos] != ':' && tag[pos] != '"' && tag[pos] != 0x7f {
+			pos++
+		}
+		if pos == start || pos+1 >= len(tag) || tag[pos] != ':' || tag[pos+1] != '"' {
+			break
+		}
+		key := tag[start:pos]
+
+		// Scan the quoted string to find the value.
+		pos++
+		i := pos + 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			break
+		}
+		value, err := strconv.Unquote(tag[pos : i+1])
+		if err != nil {
+			break
+		}
+		pos = i + 1
+
+		elts := strings.Split(value, ",")
+		name := elts[0]
+		for _, elt := range elts[1:] {
+			if strings.HasPrefix(elt, "name=") {
+				name = strings.TrimPrefix(elt, "name=")
+				break
+			}
+		}
+		if name != "" && name != "-" {
+			tags = append(tags, structTag{key: key, name: name, start: start, end: pos})
+		}
+	}
+	return tags
+}
+
 // trimComment removes the comment delimiters from a comment.  For single-line
 // comments, it also removes a single leading space, if present; for multi-line
-// comments it discards leading and trailing whitespace. Brackets and backslash
-// characters are escaped per http://www.kythe.io/docs/schema/#doc.
-func trimComment(text string) string {
+// comments it discards leading and trailing whitespace. The offset of the
+// remaining text within the comment is also returned. The caller is
+// responsible for escaping brackets and backslash characters per
+// http://www.kythe.io/docs/schema/#doc.
+func trimComment(text string) (string, int) {
 	if single := strings.TrimPrefix(text, "//"); single != text {
-		return escComment.Replace(strings.TrimPrefix(single, " "))
+		trimmed := strings.TrimPrefix(single, " ")
+		return trimmed, len(text) - len(trimmed)
 	}
-	trimmed := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/"))
-	return escComment.Replace(trimmed)
+	inner := strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	trimmed := strings.TrimLeftFunc(inner, unicode.IsSpace)
+	offset := len("/*") + len(inner) - len(trimmed)
+	return strings.TrimRightFunc(trimmed, unicode.IsSpace), offset
 }
 
 // specComment returns the innermost comment associated with spec, or nil.
@@ -51,6 +51,7 @@ import (
 	"strings"
 
 	"kythe.io/kythe/go/extractors/govname"
+	"kythe.io/kythe/go/platform/analysis/claim"
 	"kythe.io/kythe/go/util/metadata"
 	"kythe.io/kythe/go/util/ptypes"
 
@@ -60,6 +61,7 @@ import (
 
 	apb "kythe.io/kythe/proto/analysis_go_proto"
 	cpb "kythe.io/kythe/proto/common_go_proto"
+	fcpb "kythe.io/kythe/proto/filecontext_go_proto"
 	gopb "kythe.io/kythe/proto/go_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
 )
@@ -84,6 +86,7 @@ type PackageInfo struct {
 	SourceText   map[*ast.File]string          // The text of the source files
 	Rules        map[*ast.File]metadata.Rules  // Mapping metadata for each source file
 	Vendored     map[string]string             // Mapping from package to its vendor path
+	Context      string                        // The build configuration, if several; see ResolveAll
 
 	Info   *types.Info // If non-nil, contains type-checker results
 	Errors []error     // All errors reported by the type checker
@@ -122,6 +125,18 @@ type PackageInfo struct {
 
 	// The Go-specific details from the compilation record.
 	details *gopb.GoDetails
+
+	// The "C" package for a package that uses cgo, or nil.
+	cgo *cgoPackage
+
+	// The vnames of the required inputs that are not sources, keyed by their
+	// vname paths, e.g., files that may be embedded by //go:embed.
+	inputs map[string]*spb.VName
+
+	// Reports whether the compilation claims the file with a given vname,
+	// according to the claim assignments in its details. If nil, every file
+	// is claimed.
+	claimed func(*spb.VName) bool
 }
 
 type funcInfo struct {
@@ -139,6 +154,8 @@ type packageImporter struct {
 
 	pkgPath  string
 	vendored map[string]string // map of package paths to their vendor paths
+
+	cgo *types.Package // if non-nil, the package imported as "C"
 }
 
 // Import satisfies the types.Importer interface using the captured data from
@@ -151,6 +168,8 @@ func (pi *packageImporter) Import(importPath string) (*types.Package, error) {
 		// resolver into the dependency map.
 		pi.deps[importPath] = types.Unsafe
 		return types.Unsafe, nil
+	} else if importPath == "C" && pi.cgo != nil {
+		return pi.cgo, nil
 	}
 
 	// Fetch the required input holding the package for this import path, and
@@ -232,6 +251,47 @@ type Ruleset struct {
 // success the package corresponding to unit is located via ImportPath in the
 // Packages map of the returned value.
 func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*PackageInfo, error) {
+	return resolve(unit, f, opts, nil)
+}
+
+// ResolveAll resolves the package information for unit once for each of the
+// build configurations recorded in its details, and returns the results in the
+// order of the configurations. The Context field of each result is the name of
+// its configuration. If unit does not record multiple configurations, the
+// result is that of Resolve.
+//
+// The first result uses opts.Info, if set; the others are given new values
+// with the same fields populated.
+func ResolveAll(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) ([]*PackageInfo, error) {
+	configs := goDetails(unit).GetConfiguration()
+	if len(configs) == 0 {
+		pi, err := Resolve(unit, f, opts)
+		if err != nil {
+			return nil, err
+		}
+		return []*PackageInfo{pi}, nil
+	}
+	var pis []*PackageInfo
+	for i, config := range configs {
+		copts := opts
+		if i > 0 && opts.info() != nil {
+			copts = &ResolveOptions{
+				Info:       newInfo(opts.Info),
+				CheckRules: opts.CheckRules,
+			}
+		}
+		pi, err := resolve(unit, f, copts, config)
+		if err != nil {
+			return nil, fmt.Errorf("configuration %s: %v", config.Context, err)
+		}
+		pis = append(pis, pi)
+	}
+	return pis, nil
+}
+
+// resolve implements Resolve for the given build configuration of unit. If
+// config == nil, the configuration given by the details of unit is used.
+func resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions, config *gopb.GoDetails_Configuration) (*PackageInfo, error) {
 	sourceFiles := stringset.New(unit.SourceFile...)
 
 	imap := make(map[string]*spb.VName)     // import path → vname
@@ -240,10 +300,12 @@ func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*Packa
 	smap := make(map[string]*ast.File)      // file path → file (sources)
 	filev := make(map[*ast.File]*spb.VName) // file → vname
 	floc := make(map[*token.File]*ast.File) // file → ast
+	inputs := make(map[string]*spb.VName)   // vname path → vname (non-sources)
 	fset := token.NewFileSet()              // location info for the parser
 	details := goDetails(unit)
 	var files []*ast.File // parsed sources
 	var rules []*Ruleset  // parsed linkage rules
+	var cgoSrc []byte     // cgo-generated declarations, if any
 
 	// Classify the required inputs as either sources, which are to be parsed,
 	// or dependencies, which are to be "imported" via the type-checker's
@@ -256,6 +318,9 @@ func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*Packa
 		GOARCH:    details.GetGoarch(),
 		BuildTags: details.GetBuildTags(),
 	}
+	if config != nil {
+		bc.GOOS, bc.GOARCH, bc.BuildTags = config.Goos, config.Goarch, config.BuildTags
+	}
 	for _, ri := range unit.RequiredInput {
 		if ri.Info == nil {
 			return nil, errors.New("required input file info missing")
@@ -269,7 +334,11 @@ func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*Packa
 			if err != nil {
 				return nil, fmt.Errorf("fetching %q (%s): %v", fpath, ri.Info.Digest, err)
 			}
-			if !matchesBuildTags(fpath, data, bc) {
+			if config != nil {
+				if !inContext(ri, config.Context, fpath, data, bc) {
+					continue
+				}
+			} else if !matchesBuildTags(fpath, data, bc) {
 				log.Printf("Skipped source file %q because build tags do not match", fpath)
 				continue
 			}
@@ -297,6 +366,17 @@ func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*Packa
 			continue
 		}
 
+		// The declarations generated by cgo are not part of the package, but
+		// are used to populate the "C" package.
+		if fpath == details.GetCgoTypes() {
+			data, err := f.Fetch(fpath, ri.Info.Digest)
+			if err != nil {
+				return nil, fmt.Errorf("fetching %q (%s): %v", fpath, ri.Info.Digest, err)
+			}
+			cgoSrc = data
+			continue
+		}
+
 		// Check for mapping metadata.
 		if rs, err := opts.checkRules(ri, f); err != nil {
 			log.Printf("Error checking rules in %q: %v", fpath, err)
@@ -316,6 +396,7 @@ func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*Packa
 
 		ipath := vnameToImport(ri.VName, details.GetGoroot())
 		imap[ipath] = ri.VName
+		inputs[ri.VName.GetPath()] = ri.VName
 		fmap[ipath] = ri.Info
 	}
 	if len(files) == 0 {
@@ -349,6 +430,11 @@ func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*Packa
 		fileVName:   filev,
 		fileLoc:     floc,
 		details:     details,
+		inputs:      inputs,
+		claimed:     claim.Claimed(unit),
+	}
+	if config != nil {
+		pi.Context = config.Context
 	}
 
 	// If mapping rules were found, populate the corresponding field.
@@ -362,24 +448,43 @@ func Resolve(unit *apb.CompilationUnit, f Fetcher, opts *ResolveOptions) (*Packa
 		}
 	}
 
+	importer := &packageImporter{
+		deps:    pi.Dependencies,
+		fileSet: pi.FileSet,
+		fileMap: fmap,
+		fetcher: f,
+
+		pkgPath:  pi.ImportPath,
+		vendored: pi.Vendored,
+	}
+
+	// If the declarations generated by cgo are available, use them to resolve
+	// references to C names. They are parsed into a separate file set, since
+	// they are not part of the package's own sources.
+	if cgoSrc != nil {
+		cfset := token.NewFileSet()
+		cfile, err := parser.ParseFile(cfset, details.GetCgoTypes(), cgoSrc, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %v", details.GetCgoTypes(), err)
+		}
+		pi.cgo = newCgoPackage(pi.ImportPath, cfset, cfile, importer)
+		importer.cgo = pi.cgo.pkg
+	}
+
 	// Run the type-checker and collect any errors it generates.  Errors in the
 	// type checker are not returned directly; the caller can read them from
 	// the Errors field.
 	c := &types.Config{
-		FakeImportC:              true, // so we can handle cgo
-		DisableUnusedImportCheck: true, // this is not fatal to type-checking
-		Importer: &packageImporter{
-			deps:    pi.Dependencies,
-			fileSet: pi.FileSet,
-			fileMap: fmap,
-			fetcher: f,
-
-			pkgPath:  pi.ImportPath,
-			vendored: pi.Vendored,
+		FakeImportC:              pi.cgo == nil, // so we can handle cgo without its output
+		DisableUnusedImportCheck: true,          // this is not fatal to type-checking
+		Importer:                 importer,
+		Error: func(err error) {
+			if !pi.cgo.isUnexportedError(err) {
+				pi.Errors = append(pi.Errors, err)
+			}
 		},
-		Error: func(err error) { pi.Errors = append(pi.Errors, err) },
 	}
-	pi.Package, _ = c.Check(pi.Name, pi.FileSet, pi.Files, pi.Info)
+	pi.Package, _ = c.Check(pi.ImportPath, pi.FileSet, pi.Files, pi.Info)
 	pi.PackageVName[pi.Package] = unit.VName
 
 	// Fill in the mapping from packages to vnames.
@@ -415,7 +520,9 @@ func (pi *PackageInfo) String() string {
 func (pi *PackageInfo) Signature(obj types.Object) string {
 	if obj == nil {
 		return ""
-	} else if pi.owner == nil {
+	}
+	obj = originObject(obj)
+	if pi.owner == nil {
 		pi.owner = make(map[types.Object]types.Object)
 		pi.addOwners(pi.Package)
 		for _, pkg := range pi.Dependencies {
@@ -434,10 +541,13 @@ func (pi *PackageInfo) Signature(obj types.Object) string {
 	return sig
 }
 
-// ObjectVName returns a VName for obj relative to that of its package.
+// ObjectVName returns a VName for obj relative to that of its package.  It
+// returns nil if obj denotes a C entity that is not linked to a C++ node.
 func (pi *PackageInfo) ObjectVName(obj types.Object) *spb.VName {
 	if pkg, ok := obj.(*types.PkgName); ok {
 		return pi.PackageVName[pkg.Imported()]
+	} else if vname, ok := pi.cgo.vname(obj); ok {
+		return vname
 	}
 	sig := pi.Signature(obj)
 	pkg := obj.Pkg()
@@ -507,7 +617,7 @@ func (pi *PackageInfo) MarkedSource(obj types.Object) *cpb.MarkedSource {
 		// methods the receiver.
 		//
 		// Methods:   func (R) Name(p1, ...) (r0, ...)
-		// Functions: func Name(p0, ...) (r0, ...)
+		// Functions: func Name[T0 C0, ...](p0, ...) (r0, ...)
 		fn := &cpb.MarkedSource{
 			Kind:  cpb.MarkedSource_BOX,
 			Child: []*cpb.MarkedSource{{PreText: "func "}},
@@ -528,6 +638,9 @@ func (pi *PackageInfo) MarkedSource(obj types.Object) *cpb.MarkedSource {
 			firstParam = 1
 		}
 		fn.Child = append(fn.Child, ms)
+		if tps := funcTypeParamList(sig); tps != nil {
+			fn.Child = append(fn.Child, tps)
+		}
 
 		// If there are no parameters, the lookup will not produce anything.
 		// Ensure when this happens we still get parentheses for notational
@@ -576,6 +689,27 @@ func (pi *PackageInfo) MarkedSource(obj types.Object) *cpb.MarkedSource {
 		ms = repl
 
 	case *types.TypeName:
+		// For type parameters, include the constraint.
+		if bound, ok := typeParamConstraint(t.Type()); ok {
+			ms = &cpb.MarkedSource{
+				Kind:          cpb.MarkedSource_BOX,
+				PostChildText: " ",
+				Child: []*cpb.MarkedSource{
+					ms,
+					{Kind: cpb.MarkedSource_TYPE, PreText: typeName(bound)},
+				},
+			}
+			break
+		}
+
+		// For generic types, the type parameters follow the name directly.
+		if tps := namedTypeParamList(t.Type()); tps != nil {
+			ms = &cpb.MarkedSource{
+				Kind:  cpb.MarkedSource_BOX,
+				Child: []*cpb.MarkedSource{ms, tps},
+			}
+		}
+
 		// For named types, include the underlying type.
 		repl := &cpb.MarkedSource{
 			Kind:          cpb.MarkedSource_BOX,
@@ -608,6 +742,11 @@ func objectName(obj types.Object) string {
 
 // typeName returns a human readable name for typ.
 func typeName(typ types.Type) string {
+	if name, ok := genericTypeName(typ); ok {
+		return name
+	} else if obj := aliasObject(typ); obj != nil {
+		return obj.Name()
+	}
 	switch t := typ.(type) {
 	case *types.Named:
 		return t.Obj().Name()
@@ -666,10 +805,21 @@ func (pi *PackageInfo) FileVName(file *ast.File) *spb.VName {
 	return v
 }
 
-// AnchorVName returns a VName for the given file and offsets.
+// claims reports whether the compilation claims the file with the given
+// vname, and so should emit the facts describing the file itself.
+func (pi *PackageInfo) claims(vname *spb.VName) bool {
+	return pi.claimed == nil || pi.claimed(vname)
+}
+
+// AnchorVName returns a VName for the given file and offsets. If pi has a
+// build configuration, its name is appended to the signature, so that the
+// anchors of a file in each of its configurations are distinct.
 func (pi *PackageInfo) AnchorVName(file *ast.File, start, end int) *spb.VName {
 	vname := proto.Clone(pi.FileVName(file)).(*spb.VName)
 	vname.Signature = "#" + strconv.Itoa(start) + ":" + strconv.Itoa(end)
+	if pi.Context != "" {
+		vname.Signature += "@" + pi.Context
+	}
 	vname.Language = govname.Language
 	return vname
 }
@@ -706,6 +856,7 @@ const (
 	tagLabel  = "label"
 	tagMethod = "method"
 	tagParam  = "param"
+	tagTParam = "tparam"
 	tagType   = "type"
 	tagVar    = "var"
 )
@@ -768,6 +919,13 @@ func (pi *PackageInfo) newSignature(obj types.Object) (tag, base string) {
 		if t.Pkg() == nil {
 			return isBuiltin + tagType, t.Name()
 		}
+		if isTypeParam(t.Type()) {
+			if owner, ok := pi.owner[t]; ok {
+				_, base := pi.newSignature(owner)
+				return tagTParam, base + ":" + t.Name()
+			}
+			return tagTParam, fmt.Sprintf("[%p].%s", t, t.Name())
+		}
 
 	case *types.Label:
 		return tagLabel, fmt.Sprintf("[%p].%s", t, t.Name())
@@ -794,8 +952,9 @@ func (pi *PackageInfo) newSignature(obj types.Object) (tag, base string) {
 // addOwners updates pi.owner from the types in pkg, adding mapping from fields
 // of package-level named struct types to the owning named struct type; from
 // methods of package-level named interface types to the owning named interface
-// type; and from parameters of package-level named function or method types to
-// the owning named function or method.
+// type; from parameters of package-level named function or method types to
+// the owning named function or method; and from the type parameters of generic
+// types, functions, and methods to their declaring type, function, or method.
 //
 // This relation is used to construct signatures for these fields/methods,
 // since they may be referenced from another package and thus need
@@ -847,9 +1006,15 @@ func (pi *PackageInfo) newSignature(obj types.Object) (tag, base string) {
 // names.  They should be rare in readable code.
 func (pi *PackageInfo) addOwners(pkg *types.Package) {
 	scope := pkg.Scope()
+	addTypeParams := func(obj types.Object, tps []*types.TypeName) {
+		for _, tp := range tps {
+			pi.owner[tp] = obj
+		}
+	}
 	addFunc := func(obj *types.Func) {
-		// Inspect the receiver, parameters, and result values.
+		// Inspect the type parameters, receiver, parameters, and result values.
 		fsig := obj.Type().(*types.Signature)
+		addTypeParams(obj, sigTypeParams(fsig))
 		if recv := fsig.Recv(); recv != nil {
 			pi.owner[recv] = obj
 		}
@@ -885,6 +1050,7 @@ func (pi *PackageInfo) addOwners(pkg *types.Package) {
 			if !ok {
 				continue
 			}
+			addTypeParams(obj, namedTypeParams(named))
 			switch t := named.Underlying().(type) {
 			case *types.Struct:
 				// Inspect the fields of a struct.
@@ -1008,6 +1174,26 @@ func goDetails(unit *apb.CompilationUnit) *gopb.GoDetails {
 	return nil
 }
 
+// inContext reports whether the source input ri, whose path and content are
+// fpath and data, is included in the build configuration with the given name.
+// This is recorded by a ContextDependentVersion detail of the input, if it has
+// one; otherwise the build tags of the file are matched against bc.
+func inContext(ri *apb.CompilationUnit_FileInput, name, fpath string, data []byte, bc *build.Context) bool {
+	for _, msg := range ri.Details {
+		var version fcpb.ContextDependentVersion
+		if err := ptypes.UnmarshalAny(msg, &version); err != nil {
+			continue
+		}
+		for _, row := range version.Row {
+			if row.SourceContext == name {
+				return true
+			}
+		}
+		return false
+	}
+	return matchesBuildTags(fpath, data, bc)
+}
+
 // matchesBuildTags reports whether the file at fpath, whose content is in
 // data, would be matched by the settings in bc.
 func matchesBuildTags(fpath string, data []byte, bc *build.Context) bool {
@@ -1025,23 +1211,51 @@ func matchesBuildTags(fpath string, data []byte, bc *build.Context) bool {
 // AllTypeInfo creates a new types.Info value with empty maps for each of the
 // fields that can be filled in by the type-checker.
 func AllTypeInfo() *types.Info {
-	return &types.Info{
+	return withInstances(&types.Info{
 		Types:      make(map[ast.Expr]types.TypeAndValue),
 		Defs:       make(map[*ast.Ident]types.Object),
 		Uses:       make(map[*ast.Ident]types.Object),
 		Implicits:  make(map[ast.Node]types.Object),
 		Selections: make(map[*ast.SelectorExpr]*types.Selection),
 		Scopes:     make(map[ast.Node]*types.Scope),
+	})
+}
+
+// newInfo creates a new types.Info value with empty maps for each of the
+// fields that are non-nil in info.
+func newInfo(info *types.Info) *types.Info {
+	n := new(types.Info)
+	if info.Types != nil {
+		n.Types = make(map[ast.Expr]types.TypeAndValue)
 	}
+	if info.Defs != nil {
+		n.Defs = make(map[*ast.Ident]types.Object)
+	}
+	if info.Uses != nil {
+		n.Uses = make(map[*ast.Ident]types.Object)
+	}
+	if info.Implicits != nil {
+		n.Implicits = make(map[ast.Node]types.Object)
+	}
+	if info.Selections != nil {
+		n.Selections = make(map[*ast.SelectorExpr]*types.Selection)
+	}
+	if info.Scopes != nil {
+		n.Scopes = make(map[ast.Node]*types.Scope)
+	}
+	if hasInstances(info) {
+		withInstances(n)
+	}
+	return n
 }
 
 // XRefTypeInfo creates a new types.Info value with empty maps for each of the
 // fields needed for cross-reference indexing.
 func XRefTypeInfo() *types.Info {
-	return &types.Info{
+	return withInstances(&types.Info{
 		Types:     make(map[ast.Expr]types.TypeAndValue),
 		Defs:      make(map[*ast.Ident]types.Object),
 		Uses:      make(map[*ast.Ident]types.Object),
 		Implicits: make(map[ast.Node]types.Object),
-	}
+	})
 }
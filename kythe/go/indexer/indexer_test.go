@@ -25,15 +25,21 @@ import (
 	"go/token"
 	"io/ioutil"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 
+	"kythe.io/kythe/go/platform/analysis/claim"
 	"kythe.io/kythe/go/test/testutil"
+	"kythe.io/kythe/go/util/kytheuri"
 	"kythe.io/kythe/go/util/metadata"
 	"kythe.io/kythe/go/util/ptypes"
 
 	"github.com/golang/protobuf/proto"
 
 	apb "kythe.io/kythe/proto/analysis_go_proto"
+	clpb "kythe.io/kythe/proto/claim_go_proto"
+	fcpb "kythe.io/kythe/proto/filecontext_go_proto"
 	gopb "kythe.io/kythe/proto/go_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
 )
@@ -116,6 +122,136 @@ func TestBuildTags(t *testing.T) {
 	}
 }
 
+// multiConfigCompilation synthesizes a compilation extracted for two
+// configurations, with a file specific to each and a file common to both. The
+// specific files record their configurations; the common file is selected by
+// its build tags. Each specific file defines name and a method of T.
+func multiConfigCompilation(t *testing.T) (*apb.CompilationUnit, memFetcher) {
+	const commonFile = "// +build linux windows\n\npackage foo\n\nvar _ = name\n\ntype T int"
+	const linuxFile = "package foo\n\nconst name = \"linux\"\n\nfunc (T) Linux() {}"
+	const windowsFile = "package foo\n\nconst name = \"windows\"\n\nfunc (T) Windows() {}"
+
+	unit, commonDigest := oneFileCompilation("foo.go", "foo", commonFile)
+	fetcher := memFetcher{commonDigest: commonFile}
+	for _, src := range []struct{ path, text, context string }{
+		{"foo_linux.go", linuxFile, "linux_amd64"},
+		{"foo_windows.go", windowsFile, "windows_amd64"},
+	} {
+		u, digest := oneFileCompilation(src.path, "foo", src.text)
+		version, err := ptypes.MarshalAny(&fcpb.ContextDependentVersion{
+			Row: []*fcpb.ContextDependentVersion_Row{{SourceContext: src.context}},
+		})
+		if err != nil {
+			t.Fatalf("Marshaling context failed: %v", err)
+		}
+		u.RequiredInput[0].Details = append(u.RequiredInput[0].Details, version)
+		unit.RequiredInput = append(unit.RequiredInput, u.RequiredInput...)
+		unit.SourceFile = append(unit.SourceFile, u.SourceFile...)
+		fetcher[digest] = src.text
+	}
+	info, err := ptypes.MarshalAny(&gopb.GoDetails{
+		Goos:   "linux",
+		Goarch: "amd64",
+		Configuration: []*gopb.GoDetails_Configuration{
+			{Context: "linux_amd64", Goos: "linux", Goarch: "amd64"},
+			{Context: "windows_amd64", Goos: "windows", Goarch: "amd64"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Marshaling Go details failed: %v", err)
+	}
+	unit.Details = append(unit.Details, info)
+	return unit, fetcher
+}
+
+func TestResolveAll(t *testing.T) {
+	unit, fetcher := multiConfigCompilation(t)
+
+	pis, err := ResolveAll(unit, fetcher, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("ResolveAll failed: %v", err)
+	}
+	want := map[string][]string{
+		"linux_amd64":   {"foo.go", "foo_linux.go"},
+		"windows_amd64": {"foo.go", "foo_windows.go"},
+	}
+	if len(pis) != len(want) {
+		t.Fatalf("ResolveAll: got %d packages, want %d", len(pis), len(want))
+	}
+	for _, pi := range pis {
+		var got []string
+		for _, file := range pi.Files {
+			got = append(got, pi.FileVName(file).Path)
+		}
+		if err := testutil.DeepEqual(want[pi.Context], got); err != nil {
+			t.Errorf("Files for context %q: %v", pi.Context, err)
+		}
+		if len(pi.Errors) != 0 {
+			t.Errorf("Type errors for context %q: %v", pi.Context, pi.Errors)
+		}
+	}
+	if pis[0].Info == pis[1].Info {
+		t.Error("ResolveAll shared type information between configurations")
+	}
+}
+
+func TestEmitContexts(t *testing.T) {
+	// Verify that the anchors emitted for each configuration are distinct and
+	// record their configuration, so that the definitions of name specific to
+	// each platform can be told apart.
+	unit, fetcher := multiConfigCompilation(t)
+	pis, err := ResolveAll(unit, fetcher, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("ResolveAll failed: %v", err)
+	}
+
+	configs := make(map[string]string) // anchor ticket → build config
+	var defs, refs []string            // anchor tickets
+	var sums []*gopb.MethodSetSummary
+	for _, pi := range pis {
+		name := pi.ObjectVName(pi.Package.Scope().Lookup("name"))
+		if err := pi.Emit(context.Background(), func(_ context.Context, e *spb.Entry) error {
+			src := kytheuri.ToString(e.Source)
+			if e.FactName == "/kythe/build/config" {
+				configs[src] = string(e.FactValue)
+			} else if proto.Equal(e.Target, name) && e.EdgeKind == "/kythe/edge/defines/binding" {
+				defs = append(defs, src)
+			} else if proto.Equal(e.Target, name) && e.EdgeKind == "/kythe/edge/ref" {
+				refs = append(refs, src)
+			}
+			return nil
+		}, nil); err != nil {
+			t.Fatalf("Emit %q failed: %v", pi.Context, err)
+		}
+		sums = append(sums, pi.MethodSummary())
+	}
+
+	// Each definition of name is in the file of its configuration, and the
+	// reference from the common file has an anchor in each configuration.
+	var got []string
+	for _, anchor := range append(defs, refs...) {
+		got = append(got, mustVName(t, anchor).Path+"@"+configs[anchor])
+	}
+	want := []string{
+		"foo_linux.go@linux_amd64",
+		"foo_windows.go@windows_amd64",
+		"foo.go@linux_amd64",
+		"foo.go@windows_amd64",
+	}
+	if err := testutil.DeepEqual(want, got); err != nil {
+		t.Errorf("Anchors for name: %v", err)
+	}
+
+	// The method sets of T differ, so each variant is kept.
+	merged := MergeSummaries(sums)
+	if len(merged.Type) != 2 {
+		t.Errorf("Merged summary: got %d types, want 2:\n%s", len(merged.Type), proto.MarshalTextString(merged))
+	}
+	if merged = MergeSummaries([]*gopb.MethodSetSummary{sums[0], sums[0]}); len(merged.Type) != 1 {
+		t.Errorf("Merged identical summaries: got %d types, want 1", len(merged.Type))
+	}
+}
+
 func TestResolve(t *testing.T) { // are you function enough not to back down?
 	// Test resolution on a simple two-package system:
 	//
@@ -358,6 +494,366 @@ var z int
 	}
 }
 
+// mustVName parses a ticket into a VName, or fails the test.
+func mustVName(t *testing.T, ticket string) *spb.VName {
+	t.Helper()
+	v, err := kytheuri.ToVName(ticket)
+	if err != nil {
+		t.Fatalf("Invalid ticket %q: %v", ticket, err)
+	}
+	return v
+}
+
+func TestTypeErrors(t *testing.T) {
+	// Verify that type-checker errors are emitted as diagnostics tagged to an
+	// anchor at the location of the error.
+	const input = `package main
+
+var x int = undefinedName
+`
+	unit, digest := oneFileCompilation("main.go", "main", input)
+	pi, err := Resolve(unit, memFetcher{digest: input}, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v\nInput unit:\n%s", err, proto.MarshalTextString(unit))
+	}
+	if len(pi.Errors) == 0 {
+		t.Fatal("Resolve reported no type errors")
+	}
+
+	var messages []string
+	var tagged []*spb.VName
+	if err := pi.Emit(context.Background(), func(_ context.Context, e *spb.Entry) error {
+		if e.FactName == "/kythe/message" {
+			messages = append(messages, string(e.FactValue))
+		} else if e.EdgeKind == "/kythe/edge/tagged" {
+			tagged = append(tagged, e.Source)
+		}
+		return nil
+	}, nil); err != nil {
+		t.Fatalf("Emit unexpectedly failed: %v", err)
+	}
+
+	if len(messages) != 1 || !strings.Contains(messages[0], "undefinedName") {
+		t.Errorf("Diagnostic messages: got %q, want one mentioning undefinedName", messages)
+	}
+	if len(tagged) != 1 {
+		t.Fatalf("Tagged edges: got %d, want 1", len(tagged))
+	}
+	start := strings.Index(input, "undefinedName")
+	wantAnchor := pi.AnchorVName(pi.Files[0], start, start+len("undefinedName"))
+	if !proto.Equal(tagged[0], wantAnchor) {
+		t.Errorf("Diagnostic anchor: got %v, want %v", tagged[0], wantAnchor)
+	}
+}
+
+func TestClaims(t *testing.T) {
+	// Verify that facts about a source file are emitted only if the compilation
+	// claims it, while its edge to the package is emitted regardless.
+	const mainFile = "package p\n\nfunc main() { shared() }\n"
+	const sharedFile = "package p\n\nfunc shared() {}\n"
+	unit, mainDigest := oneFileCompilation("p/main.go", "p", mainFile)
+	shared, sharedDigest := oneFileCompilation("p/shared.go", "p", sharedFile)
+	unit.RequiredInput = append(unit.RequiredInput, shared.RequiredInput...)
+	unit.SourceFile = append(unit.SourceFile, shared.SourceFile...)
+
+	other := &spb.VName{Language: "go", Corpus: "test", Path: "q", Signature: "package"}
+	unit = claim.NewTable(&clpb.ClaimAssignment{
+		CompilationVName: unit.VName,
+		DependencyVName:  unit.RequiredInput[0].VName,
+	}, &clpb.ClaimAssignment{
+		CompilationVName: other,
+		DependencyVName:  unit.RequiredInput[1].VName,
+	}).Attach(unit)
+
+	fetcher := memFetcher{mainDigest: mainFile, sharedDigest: sharedFile}
+	pi, err := Resolve(unit, fetcher, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v\nInput unit:\n%s", err, proto.MarshalTextString(unit))
+	}
+
+	var text, childOf []string // file paths
+	if err := pi.Emit(context.Background(), func(_ context.Context, e *spb.Entry) error {
+		if e.Source.Signature != "" {
+			return nil // not a file
+		}
+		if e.FactName == "/kythe/text" {
+			text = append(text, e.Source.Path)
+		} else if e.EdgeKind == "/kythe/edge/childof" {
+			childOf = append(childOf, e.Source.Path)
+		}
+		return nil
+	}, nil); err != nil {
+		t.Fatalf("Emit unexpectedly failed: %v", err)
+	}
+	sort.Strings(childOf)
+
+	if got, want := strings.Join(text, " "), "p/main.go"; got != want {
+		t.Errorf("Files with text: got %q, want %q", got, want)
+	}
+	if got, want := strings.Join(childOf, " "), "p/main.go p/shared.go"; got != want {
+		t.Errorf("Files with childof edges: got %q, want %q", got, want)
+	}
+}
+
+func TestCgo(t *testing.T) {
+	// Verify that references to C names are resolved using the declarations
+	// generated by cgo, and that references to C types are linked to the
+	// vnames the C++ indexer gives them.
+	const input = `package main
+
+// struct point { int x, y; };
+// extern int counter;
+// #define LIMIT 10
+// int add(int a, int b);
+import "C"
+
+func main() {
+	var p C.struct_point
+	p.x = C.add(C.counter, C.LIMIT)
+}
+`
+	const cgoTypes = `package main
+
+import "unsafe"
+
+type _Ctype_int int32
+
+type _Ctype_struct_point struct {
+	x _Ctype_int
+	y _Ctype_int
+}
+
+var _Cvar_counter *_Ctype_int = (*_Ctype_int)(unsafe.Pointer(nil))
+
+const _Ciconst_LIMIT = 0xa
+
+func _Cfunc_add(p0 _Ctype_int, p1 _Ctype_int) (r1 _Ctype_int) { return }
+`
+	unit, digest := oneFileCompilation("main.go", "main", input)
+	cgoDigest := hexDigest([]byte(cgoTypes))
+	unit.RequiredInput = append(unit.RequiredInput, &apb.CompilationUnit_FileInput{
+		VName: &spb.VName{Corpus: "test", Path: "_cgo_gotypes.go"},
+		Info:  &apb.FileInfo{Path: "_cgo_gotypes.go", Digest: cgoDigest},
+	})
+	info, err := ptypes.MarshalAny(&gopb.GoDetails{
+		CgoEnabled: true,
+		CgoTypes:   "_cgo_gotypes.go",
+	})
+	if err != nil {
+		t.Fatalf("Marshaling Go details failed: %v", err)
+	}
+	unit.Details = append(unit.Details, info)
+
+	fetcher := memFetcher{digest: input, cgoDigest: cgoTypes}
+	pi, err := Resolve(unit, fetcher, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v\nInput unit:\n%s", err, proto.MarshalTextString(unit))
+	}
+	if len(pi.Errors) != 0 {
+		t.Errorf("Resolve reported type errors: %v", pi.Errors)
+	}
+	if n := len(pi.Files); n != 1 {
+		t.Errorf("Wrong number of source files: got %d, want 1", n)
+	}
+
+	refs := make(map[string]bool)
+	kinds := make(map[string]string) // ticket → node kind
+	if err := pi.Emit(context.Background(), func(_ context.Context, e *spb.Entry) error {
+		if e.FactName == "/kythe/message" {
+			t.Errorf("Unexpected diagnostic: %q", e.FactValue)
+		} else if e.FactName == "/kythe/node/kind" && e.Source.Language == "c++" {
+			kinds[kytheuri.ToString(e.Source)] = string(e.FactValue)
+		} else if strings.HasPrefix(e.EdgeKind, "/kythe/edge/ref") && e.Target.Language == "c++" {
+			refs[kytheuri.ToString(e.Target)] = true
+		}
+		return nil
+	}, nil); err != nil {
+		t.Fatalf("Emit unexpectedly failed: %v", err)
+	}
+
+	// Only nominal and builtin types are linked; the other C entities have no
+	// nodes that can be named from their declarations.
+	point := kytheuri.ToString(&spb.VName{Language: "c++", Signature: "point#c#t"})
+	wantKinds := map[string]string{
+		point: "tnominal",
+		kytheuri.ToString(&spb.VName{Language: "c++", Signature: "int#builtin"}): "tbuiltin",
+	}
+	if err := testutil.DeepEqual(wantKinds, kinds); err != nil {
+		t.Errorf("C++ nodes: %v", err)
+	}
+	if err := testutil.DeepEqual(map[string]bool{point: true}, refs); err != nil {
+		t.Errorf("References to C++ nodes: %v", err)
+	}
+}
+
+func TestDirectives(t *testing.T) {
+	// Verify that //go: directives are linked to the files, packages, and
+	// symbols they name.
+	const input = `package dir
+
+import _ "embed"
+
+//go:embed data/*.txt
+var data string
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=T
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+`
+	unit, digest := oneFileCompilation("dir/main.go", "dir", input)
+	unit.RequiredInput = append(unit.RequiredInput, &apb.CompilationUnit_FileInput{
+		VName: &spb.VName{Corpus: "test", Path: "dir/data/hello.txt"},
+		Info:  &apb.FileInfo{Path: "dir/data/hello.txt", Digest: "unused"},
+	}, &apb.CompilationUnit_FileInput{
+		VName: &spb.VName{Corpus: "test", Path: "dir/other/hello.txt"},
+		Info:  &apb.FileInfo{Path: "dir/other/hello.txt", Digest: "unused"},
+	})
+	pi, err := Resolve(unit, memFetcher{digest: input}, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v\nInput unit:\n%s", err, proto.MarshalTextString(unit))
+	}
+
+	// Map the text spanned by each anchor to the targets it refers to.
+	spans := make(map[string]string) // anchor signature → text
+	refs := make(map[string][]*spb.VName)
+	if err := pi.Emit(context.Background(), func(_ context.Context, e *spb.Entry) error {
+		if e.FactName == "/kythe/loc/start" {
+			var start, end int
+			fmt.Sscanf(e.Source.Signature, "#%d:%d", &start, &end)
+			spans[e.Source.Signature] = input[start:end]
+		} else if e.EdgeKind == "/kythe/edge/ref" {
+			refs[e.Source.Signature] = append(refs[e.Source.Signature], e.Target)
+		}
+		return nil
+	}, nil); err != nil {
+		t.Fatalf("Emit unexpectedly failed: %v", err)
+	}
+	got := make(map[string][]*spb.VName)
+	for sig, targets := range refs {
+		got[spans[sig]] = append(got[spans[sig]], targets...)
+	}
+
+	tests := []struct {
+		text string
+		want *spb.VName
+	}{
+		{"data/*.txt", &spb.VName{Corpus: "test", Path: "dir/data/hello.txt"}},
+		{"golang.org/x/tools/cmd/stringer", &spb.VName{
+			Corpus: "golang.org/x/tools", Path: "cmd/stringer", Language: "go", Signature: "package",
+		}},
+		{"runtime.nanotime", &spb.VName{
+			Corpus: "golang.org", Path: "runtime", Language: "go", Signature: "func nanotime",
+		}},
+		{"nanotime", pi.ObjectVName(pi.Package.Scope().Lookup("nanotime"))},
+	}
+	for _, test := range tests {
+		targets := got[test.text]
+		if len(targets) != 1 {
+			t.Errorf("References from %q: got %v, want 1", test.text, targets)
+			continue
+		}
+		if !proto.Equal(targets[0], test.want) {
+			t.Errorf("Reference from %q: got %v, want %v", test.text, targets[0], test.want)
+		}
+	}
+}
+
+func TestFindDocLinks(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"no links here", nil},
+		{"see [Name] and [pkg.Name.Method].", []string{"Name", "pkg.Name.Method"}},
+		{"a [*T] or [encoding/json.Marshal]", []string{"*T", "encoding/json.Marshal"}},
+		{"[Name]: http://example.com", nil},
+		{"x[Name] [Name]y [Name](url) [Name][1]", nil},
+		{"[not a link], [1], [], [a.], [.b]", nil},
+	}
+	for _, test := range tests {
+		var got []string
+		for _, link := range findDocLinks(test.input) {
+			got = append(got, test.input[link.start:link.end])
+		}
+		if err := testutil.DeepEqual(test.want, got); err != nil {
+			t.Errorf("findDocLinks(%q): %v", test.input, err)
+		}
+	}
+}
+
+func TestJoinSummaries(t *testing.T) {
+	// Verify that summaries of packages that do not import one another are
+	// joined to relate their types and interfaces.
+	sources := []struct{ path, pkg, input string }{
+		{"a/a.go", "a", `package a
+
+type Reader interface{ Read(p []byte) (int, error) }
+
+type closer interface{ close() }
+
+type T struct{}
+
+func (T) close() {}
+`},
+		{"b/b.go", "b", `package b
+
+type File struct{}
+
+func (File) Read(buf []byte) (n int, err error) { return }
+
+type Buffer struct{}
+
+func (*Buffer) Read([]byte) (int, error) { return 0, nil }
+
+type Other struct{}
+
+func (Other) Read() {}
+func (Other) close() {}
+
+type Source interface{ Read([]byte) (int, error) }
+`},
+	}
+	var sums []*gopb.MethodSetSummary
+	for _, src := range sources {
+		unit, digest := oneFileCompilation(src.path, src.pkg, src.input)
+		pi, err := Resolve(unit, memFetcher{digest: src.input}, &ResolveOptions{Info: XRefTypeInfo()})
+		if err != nil {
+			t.Fatalf("Resolve %q failed: %v", src.pkg, err)
+		}
+		sums = append(sums, pi.MethodSummary())
+	}
+
+	join := func() []string {
+		var got []string
+		if err := JoinSummaries(context.Background(), sums, func(_ context.Context, e *spb.Entry) error {
+			got = append(got, fmt.Sprintf("%s %s %s", e.Source.Signature, e.EdgeKind, e.Target.Signature))
+			return nil
+		}); err != nil {
+			t.Fatalf("JoinSummaries failed: %v", err)
+		}
+		return got
+	}
+	want := []string{
+		"type Buffer /kythe/edge/satisfies type Reader",
+		"method Buffer.Read /kythe/edge/overrides method Reader.Read",
+		"type File /kythe/edge/satisfies type Reader",
+		"method File.Read /kythe/edge/overrides method Reader.Read",
+		"type Source /kythe/edge/satisfies type Reader",
+		"type Reader /kythe/edge/satisfies type Source",
+	}
+	if err := testutil.DeepEqual(want, join()); err != nil {
+		t.Errorf("JoinSummaries: %v", err)
+	}
+
+	// If either package depends on the other, the indexer has already related
+	// their types.
+	sums[1].Dependency = []string{sums[0].ImportPath}
+	if got := join(); len(got) != 0 {
+		t.Errorf("JoinSummaries with dependency: got %q, want none", got)
+	}
+}
+
 func TestRules(t *testing.T) {
 	const input = "package main\n"
 	unit, digest := oneFileCompilation("main.go", "main", input)
@@ -0,0 +1,230 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+import (
+	"context"
+	"go/token"
+	"go/types"
+	"sort"
+
+	"kythe.io/kythe/go/util/schema/edges"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/golang/protobuf/proto"
+
+	gopb "kythe.io/kythe/proto/go_go_proto"
+)
+
+// MethodSummary returns a summary of the method sets of the package-level named
+// types declared by the package. Types without methods, and the types excluded
+// from satisfaction by the indexer (see knownTypeNames), are omitted.
+//
+// The indexer emits satisfies edges only between types visible to a single
+// compilation; JoinSummaries uses the summaries of many packages to find the
+// remaining relationships.
+func (pi *PackageInfo) MethodSummary() *gopb.MethodSetSummary {
+	sum := &gopb.MethodSetSummary{ImportPath: pi.ImportPath}
+	for ipath := range pi.Dependencies {
+		sum.Dependency = append(sum.Dependency, ipath)
+	}
+	sort.Strings(sum.Dependency)
+
+	scope := pi.Package.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok || named.Obj() != obj || isGeneric(named) || isConstraint(named) {
+			continue // an alias, or not a candidate for satisfaction
+		}
+
+		st := &gopb.MethodSetSummary_Type{
+			Vname:     pi.ObjectVName(obj),
+			Interface: isInterface(named),
+		}
+		mset := types.NewMethodSet(named)
+		for i := 0; i < mset.Len(); i++ {
+			st.Method = append(st.Method, pi.summaryMethod(mset.At(i).Obj()))
+		}
+		if !st.Interface {
+			pmset := types.NewMethodSet(types.NewPointer(named))
+			for i := 0; i < pmset.Len(); i++ {
+				if m := pmset.At(i).Obj(); mset.Lookup(m.Pkg(), m.Name()) == nil {
+					st.PointerMethod = append(st.PointerMethod, pi.summaryMethod(m))
+				}
+			}
+		}
+		if len(st.Method)+len(st.PointerMethod) != 0 {
+			sum.Type = append(sum.Type, st)
+		}
+	}
+	return sum
+}
+
+// MergeSummaries combines the summaries of a single package resolved in each
+// of several build configurations (see ResolveAll) into one summary. Its
+// dependencies are those of any configuration. A type whose method sets are
+// the same in every configuration is listed once; otherwise each distinct
+// variant is listed, so that JoinSummaries considers each separately.
+func MergeSummaries(sums []*gopb.MethodSetSummary) *gopb.MethodSetSummary {
+	if len(sums) == 1 {
+		return sums[0]
+	}
+	merged := new(gopb.MethodSetSummary)
+	deps := stringset.New()
+	for _, sum := range sums {
+		merged.ImportPath = sum.ImportPath
+		deps.Add(sum.Dependency...)
+	next:
+		for _, st := range sum.Type {
+			for _, old := range merged.Type {
+				if proto.Equal(old, st) {
+					continue next
+				}
+			}
+			merged.Type = append(merged.Type, st)
+		}
+	}
+	merged.Dependency = deps.Elements()
+	return merged
+}
+
+// summaryMethod returns the summary of a method in a method set.
+func (pi *PackageInfo) summaryMethod(obj types.Object) *gopb.MethodSetSummary_Method {
+	m := &gopb.MethodSetSummary_Method{
+		Name:  obj.Name(),
+		Type:  methodType(obj.Type().(*types.Signature)),
+		Vname: pi.ObjectVName(obj),
+	}
+	if !obj.Exported() && obj.Pkg() != nil {
+		m.Package = obj.Pkg().Path()
+	}
+	return m
+}
+
+// methodType returns a string denoting the type of a method with signature
+// sig, omitting the receiver and parameter names, with named types qualified
+// by their full import paths. Two methods have identical types if and only if
+// their strings are equal.
+func methodType(sig *types.Signature) string {
+	unnamed := func(tup *types.Tuple) *types.Tuple {
+		vars := make([]*types.Var, tup.Len())
+		for i := range vars {
+			vars[i] = types.NewVar(token.NoPos, nil, "", tup.At(i).Type())
+		}
+		return types.NewTuple(vars...)
+	}
+	return types.TypeString(types.NewSignature(nil, unnamed(sig.Params()), unnamed(sig.Results()), sig.Variadic()),
+		func(pkg *types.Package) string { return pkg.Path() })
+}
+
+// methodKey returns a key identifying a method by its name, package (if
+// unexported), and type, such that a type whose method set contains a method
+// with the same key as each of the methods of an interface satisfies it.
+func methodKey(m *gopb.MethodSetSummary_Method) string {
+	return m.Package + "." + m.Name + " " + m.Type
+}
+
+// A summaryType is a type from a MethodSetSummary along with the methods of
+// both T and *T, keyed by methodKey.
+type summaryType struct {
+	pkg     string
+	typ     *gopb.MethodSetSummary_Type
+	methods map[string]*gopb.MethodSetSummary_Method
+}
+
+// JoinSummaries writes to sink the satisfies edges between the types described
+// by sums that the indexer could not have found, together with the overrides
+// edges from the methods of each concrete type to the interface methods it
+// implements. Types in packages where either package depends on the other
+// were both visible to the indexer, and are not considered.
+func JoinSummaries(ctx context.Context, sums []*gopb.MethodSetSummary, sink Sink) error {
+	deps := make(map[string]map[string]bool)
+	for _, sum := range sums {
+		if deps[sum.ImportPath] == nil {
+			deps[sum.ImportPath] = make(map[string]bool)
+		}
+		for _, dep := range sum.Dependency {
+			deps[sum.ImportPath][dep] = true
+		}
+	}
+	related := func(p, q string) bool { return p == q || deps[p][q] || deps[q][p] }
+
+	// Index the types by the keys of their methods, so that the candidates
+	// for satisfying an interface are those having one of its methods.
+	var ifaces []*summaryType
+	byMethod := make(map[string][]*summaryType)
+	for _, sum := range sums {
+		for _, typ := range sum.Type {
+			st := &summaryType{
+				pkg:     sum.ImportPath,
+				typ:     typ,
+				methods: make(map[string]*gopb.MethodSetSummary_Method),
+			}
+			for _, ms := range [][]*gopb.MethodSetSummary_Method{typ.Method, typ.PointerMethod} {
+				for _, m := range ms {
+					key := methodKey(m)
+					st.methods[key] = m
+					byMethod[key] = append(byMethod[key], st)
+				}
+			}
+			if typ.Interface {
+				ifaces = append(ifaces, st)
+			}
+		}
+	}
+
+	seen := make(map[string]bool) // overrides already written
+	for _, y := range ifaces {
+		for _, x := range byMethod[methodKey(y.typ.Method[0])] {
+			if related(x.pkg, y.pkg) || !satisfies(x, y) {
+				continue
+			}
+			if err := sink.writeEdge(ctx, x.typ.Vname, y.typ.Vname, edges.Satisfies); err != nil {
+				return err
+			}
+			if x.typ.Interface {
+				continue
+			}
+			for _, ym := range y.typ.Method {
+				xm := x.methods[methodKey(ym)]
+				key := proto.CompactTextString(xm.Vname) + "\x00" + proto.CompactTextString(ym.Vname)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				if err := sink.writeEdge(ctx, xm.Vname, ym.Vname, edges.Overrides); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// satisfies reports whether the methods of x include those of the interface y.
+func satisfies(x, y *summaryType) bool {
+	for _, ym := range y.typ.Method {
+		if x.methods[methodKey(ym)] == nil {
+			return false
+		}
+	}
+	return true
+}
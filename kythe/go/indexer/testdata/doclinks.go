@@ -0,0 +1,31 @@
+// Package doclinks tests that links in doc comments refer to their targets.
+package doclinks
+
+//- @Reader defines/binding Reader
+type Reader interface {
+	//- @Read defines/binding Read
+	Read() int
+}
+
+//- @File defines/binding File
+type File struct {
+	//- @Name defines/binding Name
+	Name string
+}
+
+//- @+5"Reader" ref/doc Reader
+//- @+4"File.Name" ref/doc Name
+//- @+4"*File" ref/doc File
+//- !{ @+4"Missing" ref/doc _ }
+
+// Open returns a [Reader] for the file called [File.Name].
+// The result may be converted to a [*File].
+// Unknown names like [Missing] and [not a link] are left as text.
+func Open(f *File) Reader { return nil }
+
+//- OpenDoc documents Open
+//- OpenDoc.text "Open returns a [Reader] for the file called [File.Name].\nThe result may be converted to a [*File].\nUnknown names like \\[Missing\\] and \\[not a link\\] are left as text."
+//- OpenDoc param.0 Reader
+//- OpenDoc param.1 Name
+//- OpenDoc param.2 File
+//- @Open defines/binding Open
@@ -0,0 +1,38 @@
+// Package dynamic tests that calls through interface methods are linked to the
+// concrete methods they may dispatch to.
+package dynamic
+
+type Reader interface {
+	//- @Read defines/binding AbstractRead
+	Read() int
+}
+
+type file struct{}
+
+//- @Read defines/binding FileRead
+func (file) Read() int { return 0 }
+
+type buffer struct{}
+
+//- @Read defines/binding BufferRead
+func (*buffer) Read() int { return 1 }
+
+type other struct{}
+
+//- @Read defines/binding OtherRead
+func (other) Read() string { return "" }
+
+func readAll(r Reader) int {
+	//- ReadCall=@"r.Read()" ref/call AbstractRead
+	//- ReadCall ref/call/dynamic FileRead
+	//- ReadCall ref/call/dynamic BufferRead
+	//- !{ ReadCall ref/call/dynamic OtherRead }
+	//- !{ ReadCall ref/call/dynamic AbstractRead }
+	return r.Read()
+}
+
+func direct(f file) int {
+	//- DirectCall=@"f.Read()" ref/call FileRead
+	//- !{ DirectCall ref/call/dynamic _ }
+	return f.Read()
+}
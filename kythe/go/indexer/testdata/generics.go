@@ -0,0 +1,103 @@
+// Package generics tests type parameters and instantiations.
+package generics
+
+//- @Stringer defines/binding Stringer
+type Stringer interface {
+	String() string
+}
+
+//- @List defines/binding List
+//- List.node/kind record
+//- @T defines/binding TVar
+//- TVar.node/kind tvar
+//- TVar childof List
+//- List tparam.0 TVar
+//- TVar bounded/upper vname("builtin-type any",_,_,_,"go")
+type List[T any] struct {
+	//- @T ref TVar
+	items []T
+}
+
+//- @Push defines/binding Push
+//- Push childof List
+//- @#0T defines/binding PushT
+//- PushT.node/kind tvar
+//- Push tparam.0 PushT
+//- @#1T ref PushT
+func (l *List[T]) Push(v T) {
+	l.items = append(l.items, v)
+}
+
+//- @Map defines/binding Map
+//- @K defines/binding K
+//- @V defines/binding V
+//- Map tparam.0 K
+//- Map tparam.1 V
+//- K bounded/upper Stringer
+//- V bounded/upper vname("builtin-type comparable",_,_,_,"go")
+func Map[K Stringer, V comparable](keys []K, val V) map[string]V {
+	m := make(map[string]V)
+	for _, k := range keys {
+		m[k.String()] = val
+	}
+	return m
+}
+
+func use() {
+	//- @"List[int]" ref IntList
+	//- IntList.node/kind tapp
+	//- IntList param.0 List
+	//- IntList param.1 vname("builtin-type int",_,_,_,"go")
+	var l List[int]
+
+	//- @Push ref Push
+	l.Push(1)
+
+	//- @Map ref Map
+	//- @Map ref/implicit MapInst
+	//- MapInst.node/kind tapp
+	//- MapInst param.0 Map
+	//- MapInst param.1 Stringer
+	Map([]Stringer{}, 0)
+}
+
+//- @Pair defines/binding Pair
+type Pair[K comparable, V any] struct {
+	key K
+	val V
+}
+
+// A and B instantiate Pair with their own type parameters, which have the same
+// names; each application is a distinct tapp node.
+
+//- @A defines/binding A
+//- @#0K defines/binding AK
+//- @#0V defines/binding AV
+//- @#0"Pair[K, V]" ref APair
+//- APair.node/kind tapp
+//- APair param.0 Pair
+//- APair param.1 AK
+//- APair param.2 AV
+func A[K comparable, V any]() Pair[K, V] { return Pair[K, V]{} }
+
+//- @B defines/binding B
+//- @#0K defines/binding BK
+//- @#0V defines/binding BV
+//- @#0"Pair[K, V]" ref BPair
+//- BPair.node/kind tapp
+//- BPair param.0 Pair
+//- BPair param.1 BK
+//- BPair param.2 BV
+func B[K comparable, V any]() Pair[K, V] { return Pair[K, V]{} }
+
+func nested() {
+	//- @"List[List[string]]" ref Outer
+	//- Outer.node/kind tapp
+	//- Outer param.0 List
+	//- Outer param.1 Inner
+	//- @"List[string]" ref Inner
+	//- Inner.node/kind tapp
+	//- Inner param.1 vname("builtin-type string",_,_,_,"go")
+	var ll List[List[string]]
+	_ = ll
+}
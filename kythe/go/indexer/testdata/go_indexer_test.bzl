@@ -151,6 +151,10 @@ def _go_entries(ctx):
     if ctx.attr.metadata_suffix:
         iargs += ["-meta", ctx.attr.metadata_suffix]
 
+    # If the test wants possible callees of interface methods, enable them.
+    if ctx.attr.has_dynamic_calls:
+        iargs.append("-dynamic")
+
     iargs += [kzip.path, "| gzip >" + output.path]
 
     cmds = ["set -e", "set -o pipefail", " ".join(iargs), ""]
@@ -178,6 +182,9 @@ go_entries = rule(
         # The suffix used to recognize linkage metadata files, if non-empty.
         "metadata_suffix": attr.string(default = ""),
 
+        # Whether to emit possible callees for interface method calls.
+        "has_dynamic_calls": attr.bool(default = False),
+
         # The location of the Go indexer binary.
         "_indexer": attr.label(
             default = Label("//kythe/go/indexer/cmd/go_indexer"),
@@ -257,6 +264,7 @@ def _go_indexer(
         importpath = None,
         data = None,
         has_marked_source = False,
+        has_dynamic_calls = False,
         allow_duplicates = False,
         metadata_suffix = ""):
     if len(deps) > 0:
@@ -282,6 +290,7 @@ def _go_indexer(
         name = entries,
         kzip = ":" + kzip,
         has_marked_source = has_marked_source,
+        has_dynamic_calls = has_dynamic_calls,
         metadata_suffix = metadata_suffix,
     )
     return entries
@@ -298,6 +307,7 @@ def go_indexer_test(
         log_entries = False,
         data = None,
         has_marked_source = False,
+        has_dynamic_calls = False,
         allow_duplicates = False,
         metadata_suffix = ""):
     entries = _go_indexer(
@@ -307,6 +317,7 @@ def go_indexer_test(
         data = data,
         importpath = import_path,
         has_marked_source = has_marked_source,
+        has_dynamic_calls = has_dynamic_calls,
         metadata_suffix = metadata_suffix,
     )
     go_verifier_test(
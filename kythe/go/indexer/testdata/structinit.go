@@ -18,30 +18,39 @@ type Inky struct {
 }
 
 func msPacMan() {
-	// Verify that named initializers ref/init their fields, and that the names
-	// ref the fields.
+	// Verify that named initializers ref/init and ref/writes their fields, and
+	// that the names only ref the fields.
 	a := &Inky{
 		//- @Pinky ref Pinky
+		//- !{ @Pinky ref/writes Pinky }
 		//- @"\"pink\"" ref/init Pinky
+		//- @"\"pink\"" ref/writes Pinky
 		Pinky: "pink",
 		//- @Blinky ref Blinky
+		//- !{ @Blinky ref/writes Blinky }
 		//- @"[]byte{255, 0, 0}" ref/init Blinky
+		//- @"[]byte{255, 0, 0}" ref/writes Blinky
 		Blinky: []byte{255, 0, 0},
 		//- @Sue ref Sue
+		//- !{ @Sue ref/writes Sue }
 		//- @"0x84077e" ref/init Sue
+		//- @"0x84077e" ref/writes Sue
 		Sue: 0x84077e,
 	}
 	_ = a
 
-	// Verify that unnamed initializers ref/init their fields.
+	// Verify that unnamed initializers ref/init and ref/writes their fields.
 	b := &Inky{
 		//- @"a.Pinky" ref/init Pinky
+		//- @"a.Pinky" ref/writes Pinky
 		a.Pinky,
 
 		//- @"[]byte{255, 0, 0}" ref/init Blinky
+		//- @"[]byte{255, 0, 0}" ref/writes Blinky
 		[]byte{255, 0, 0},
 
 		//- @"0x84077e" ref/init Sue
+		//- @"0x84077e" ref/writes Sue
 		0x84077e,
 	}
 	_ = b
@@ -0,0 +1,28 @@
+// Package structtag tests that struct tags name their fields.
+package structtag
+
+type User struct {
+	//- @ID defines/binding ID
+	//- @"json:\"user_id,omitempty\"" ref JSONUserID
+	//- JSONUserID.node/kind name
+	//- ID named JSONUserID
+	//- ID named vname("tag json:user_id","","","","go")
+	//- @"xml:\"uid\"" ref XMLUID
+	//- ID named XMLUID=vname("tag xml:uid","","","","go")
+	ID string `json:"user_id,omitempty" xml:"uid"`
+
+	//- @Name defines/binding Name
+	//- @"protobuf:\"bytes,2,opt,name=user_name\"" ref ProtoName
+	//- Name named ProtoName=vname("tag protobuf:user_name","","","","go")
+	Name string `protobuf:"bytes,2,opt,name=user_name"`
+
+	//- @Secret defines/binding Secret
+	//- !{ Secret named _ }
+	Secret string `json:"-"`
+}
+
+type Account struct {
+	//- @Owner defines/binding Owner
+	//- Owner named vname("tag json:user_id","","","","go")
+	Owner string "json:\"user_id\""
+}
@@ -0,0 +1,72 @@
+// Package writes tests that references which modify a variable or field are
+// distinguished from those that only read it.
+package writes
+
+//- @point defines/binding Point
+type point struct {
+	//- @X defines/binding FieldX
+	//- @Y defines/binding FieldY
+	X, Y int
+}
+
+func update(ps []point) {
+	//- @n defines/binding N
+	n := 0
+
+	//- @n ref/writes N
+	n = 1
+
+	//- @n ref/writes N
+	n++
+
+	//- @n ref/writes N
+	for _, n = range []int{1, 2, 3} {
+		//- @p defines/binding P
+		//- @n ref N
+		p := &ps[n]
+
+		//- @p ref P
+		//- @X ref/writes FieldX
+		p.X = n
+
+		//- @p ref P
+		//- @Y ref/writes FieldY
+		//- !{ @Y ref FieldY }
+		(p.Y) += 2
+
+		//- @X ref FieldX
+		//- !{ @X ref/writes FieldX }
+		//- @n ref/writes N
+		n = p.X
+	}
+
+	//- @ptr defines/binding Ptr
+	//- @n ref/writes N
+	ptr := &n
+
+	//- @ptr ref Ptr
+	//- !{ @ptr ref/writes Ptr }
+	*ptr = 3
+
+	//- @n ref N
+	//- !{ @n ref/writes N }
+	_ = n
+}
+
+func build(n int) []point {
+	return []point{
+		// A keyed initializer writes the field at its value.
+		//- @X ref FieldX
+		//- !{ @X ref/writes FieldX }
+		//- @n ref/writes FieldX
+		//- @n ref/init FieldX
+		{X: n},
+
+		// A positional initializer writes the field at its value.
+		//- @"1" ref/writes FieldX
+		//- @"1" ref/init FieldX
+		//- @"2" ref/writes FieldY
+		//- @"2" ref/init FieldY
+		{1, 2},
+	}
+}
@@ -0,0 +1,51 @@
+//go:build !go1.18
+// +build !go1.18
+
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+// This file stands in for typeparams_go118.go when the indexer is built with a
+// toolchain older than Go 1.18, which has no type parameters to index. See
+// typeparams_go118.go for the documentation of each function.
+
+import (
+	"go/ast"
+	"go/types"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func withInstances(info *types.Info) *types.Info                              { return info }
+func hasInstances(*types.Info) bool                                           { return false }
+func originObject(obj types.Object) types.Object                              { return obj }
+func funcTypeParamList(*types.Signature) *cpb.MarkedSource                    { return nil }
+func namedTypeParamList(types.Type) *cpb.MarkedSource                         { return nil }
+func typeParamConstraint(types.Type) (types.Type, bool)                       { return nil, false }
+func isTypeParam(types.Type) bool                                             { return false }
+func genericTypeName(types.Type) (string, bool)                               { return "", false }
+func sigTypeParams(*types.Signature) []*types.TypeName                        { return nil }
+func namedTypeParams(*types.Named) []*types.TypeName                          { return nil }
+func funcTypeParams(*ast.FuncDecl) []*ast.Ident                               { return nil }
+func specTypeParams(*ast.TypeSpec) []*ast.Ident                               { return nil }
+func isGeneric(types.Type) bool                                               { return false }
+func isConstraint(types.Type) bool                                            { return false }
+func (e *emitter) emitTypeParams([]*ast.Ident, *spb.VName)                    {}
+func (e *emitter) emitInstance(*ast.Ident, *spb.VName, *spb.VName, stackFunc) {}
+func (e *emitter) genericTypeVName(types.Type) (*spb.VName, bool)             { return nil, false }
+func (e *emitter) emitInstanceType(types.Type, *spb.VName)                    {}
@@ -0,0 +1,330 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+// This file holds the parts of the indexer that use the type parameter support
+// added to go/ast and go/types in Go 1.18. When built with an older toolchain,
+// typeparams_go117.go is used instead, and no type parameters are indexed.
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
+
+	"github.com/golang/protobuf/proto"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// withInstances adds a map of instantiations to info, and returns info.
+func withInstances(info *types.Info) *types.Info {
+	info.Instances = make(map[*ast.Ident]types.Instance)
+	return info
+}
+
+// hasInstances reports whether info records instantiations.
+func hasInstances(info *types.Info) bool { return info.Instances != nil }
+
+// originObject returns the generic object from which obj was instantiated, if
+// any; otherwise it returns obj itself. Fields and methods of an instantiated
+// type such as List[int] are distinct objects, but refer to the declarations of
+// the corresponding generic type.
+func originObject(obj types.Object) types.Object {
+	switch t := obj.(type) {
+	case *types.Func:
+		return t.Origin()
+	case *types.Var:
+		return t.Origin()
+	}
+	return obj
+}
+
+// typeParamList returns a MarkedSource message for the type parameters of a
+// generic type or function, e.g., "[K comparable, V any]".
+func typeParamList(tps *types.TypeParamList) *cpb.MarkedSource {
+	ms := &cpb.MarkedSource{
+		Kind:          cpb.MarkedSource_BOX,
+		PreText:       "[",
+		PostChildText: ", ",
+		PostText:      "]",
+	}
+	for i := 0; i < tps.Len(); i++ {
+		tp := tps.At(i)
+		ms.Child = append(ms.Child, &cpb.MarkedSource{
+			Kind:          cpb.MarkedSource_BOX,
+			PostChildText: " ",
+			Child: []*cpb.MarkedSource{
+				{Kind: cpb.MarkedSource_IDENTIFIER, PreText: tp.Obj().Name()},
+				{Kind: cpb.MarkedSource_TYPE, PreText: typeName(tp.Constraint())},
+			},
+		})
+	}
+	return ms
+}
+
+// funcTypeParamList returns a MarkedSource message for the type parameters of
+// a generic function, or nil if sig has none.
+func funcTypeParamList(sig *types.Signature) *cpb.MarkedSource {
+	if tps := sig.TypeParams(); tps.Len() != 0 {
+		return typeParamList(tps)
+	}
+	return nil
+}
+
+// namedTypeParamList returns a MarkedSource message for the type parameters of
+// a generic named type, or nil if typ is not one.
+func namedTypeParamList(typ types.Type) *cpb.MarkedSource {
+	if named, ok := typ.(*types.Named); ok && named.TypeParams().Len() != 0 {
+		return typeParamList(named.TypeParams())
+	}
+	return nil
+}
+
+// typeParamConstraint returns the constraint of typ if it is a type parameter.
+func typeParamConstraint(typ types.Type) (types.Type, bool) {
+	if tp, ok := typ.(*types.TypeParam); ok {
+		return tp.Constraint(), true
+	}
+	return nil, false
+}
+
+// isTypeParam reports whether typ is a type parameter.
+func isTypeParam(typ types.Type) bool {
+	_, ok := typ.(*types.TypeParam)
+	return ok
+}
+
+// genericTypeName returns a human readable name for typ if it is an
+// instantiated named type, a type parameter, or an implicit constraint
+// interface; see typeName.
+func genericTypeName(typ types.Type) (string, bool) {
+	switch t := typ.(type) {
+	case *types.Named:
+		if targs := t.TypeArgs(); targs.Len() != 0 {
+			args := make([]string, targs.Len())
+			for i := range args {
+				args[i] = typeName(targs.At(i))
+			}
+			return t.Obj().Name() + "[" + strings.Join(args, ", ") + "]", true
+		}
+	case *types.TypeParam:
+		return t.Obj().Name(), true
+	case *types.Interface:
+		if t.IsImplicit() {
+			return t.String(), true // e.g., ~int | ~string
+		}
+	}
+	return "", false
+}
+
+// sigTypeParams returns the type parameters declared by a generic function or
+// method, including those of its receiver.
+func sigTypeParams(sig *types.Signature) []*types.TypeName {
+	return append(typeNames(sig.TypeParams()), typeNames(sig.RecvTypeParams())...)
+}
+
+// namedTypeParams returns the type parameters of a generic named type.
+func namedTypeParams(named *types.Named) []*types.TypeName {
+	return typeNames(named.TypeParams())
+}
+
+func typeNames(tps *types.TypeParamList) []*types.TypeName {
+	var objs []*types.TypeName
+	for i := 0; i < tps.Len(); i++ {
+		objs = append(objs, tps.At(i).Obj())
+	}
+	return objs
+}
+
+// funcTypeParams returns the identifiers declaring the type parameters of a
+// function or method. A method of a generic type redeclares the type
+// parameters of its receiver, e.g., func (l *List[T]) Push(v T).
+func funcTypeParams(decl *ast.FuncDecl) []*ast.Ident {
+	var ids []*ast.Ident
+	if decl.Recv != nil && len(decl.Recv.List) != 0 {
+		ids = recvTypeParams(decl.Recv.List[0].Type)
+	}
+	return append(ids, typeParamIdents(decl.Type.TypeParams)...)
+}
+
+// specTypeParams returns the identifiers declaring the type parameters of a
+// generic type.
+func specTypeParams(spec *ast.TypeSpec) []*ast.Ident {
+	return typeParamIdents(spec.TypeParams)
+}
+
+// emitTypeParams emits bindings for the type parameters declared by ids, as
+// type variables bound to the generic type or function denoted by parent.  The
+// constraint of each type parameter is recorded as its upper bound.
+func (e *emitter) emitTypeParams(ids []*ast.Ident, parent *spb.VName) {
+	for _, id := range ids {
+		obj, _ := e.pi.Info.Defs[id].(*types.TypeName)
+		if obj == nil {
+			continue // type error (reported elsewhere)
+		}
+		tp, ok := obj.Type().(*types.TypeParam)
+		if !ok {
+			continue
+		}
+		tvar := e.writeBinding(id, nodes.TVar, parent)
+		e.writeEdge(parent, tvar, edges.TParamIndex(tp.Index()))
+		if bound := e.emitType(tp.Constraint()); bound != nil {
+			e.writeEdge(tvar, bound, edges.BoundedUpper)
+		}
+	}
+}
+
+// emitInstance emits a tapp node for the instantiation of the generic type or
+// function denoted by id, if it is one, and a reference to it from the instantiating
+// expression. If the type arguments are inferred, the reference is implicit
+// and shares the anchor of id.
+func (e *emitter) emitInstance(id *ast.Ident, anchor, generic *spb.VName, stack stackFunc) {
+	inst, ok := e.pi.Info.Instances[id]
+	if !ok {
+		return
+	}
+
+	// Find the expression supplying the type arguments, if any, for either of
+	// the forms F[T] or pkg.F[T].
+	var expr ast.Node = id
+	i := 1
+	if sel, ok := stack(i).(*ast.SelectorExpr); ok && sel.Sel == id {
+		expr = sel
+		i++
+	}
+	var index ast.Node
+	switch p := stack(i).(type) {
+	case *ast.IndexExpr:
+		if p.X == expr {
+			index = p
+		}
+	case *ast.IndexListExpr:
+		if p.X == expr {
+			index = p
+		}
+	}
+	if index != nil && isReceiver(stack, i+1) {
+		return // a method receiver, e.g., func (l *List[T]), is not an instance
+	}
+
+	tapp := e.tappVName(generic, inst.TypeArgs)
+	e.emitTApp(tapp, generic, inst.TypeArgs)
+	if index != nil {
+		e.writeRef(index, tapp, edges.Ref)
+	} else {
+		e.writeEdge(anchor, tapp, edges.RefImplicit)
+	}
+}
+
+// tappVName returns the vname of the tapp node applying the generic type or
+// function denoted by generic to targs. Its signature is that of generic,
+// followed by a string identifying each type argument; see typeString.
+func (e *emitter) tappVName(generic *spb.VName, targs *types.TypeList) *spb.VName {
+	args := make([]string, targs.Len())
+	for i := range args {
+		args[i] = e.typeString(targs.At(i), generic)
+	}
+	tapp := proto.Clone(generic).(*spb.VName)
+	tapp.Signature += "[" + strings.Join(args, ",") + "]"
+	return tapp
+}
+
+// emitTApp emits the tapp node tapp, applying the generic type or function
+// denoted by generic to targs, along with edges to its type arguments and the
+// nodes of any type arguments that are themselves instantiations.
+func (e *emitter) emitTApp(tapp, generic *spb.VName, targs *types.TypeList) {
+	e.writeFact(tapp, facts.NodeKind, nodes.TApp)
+	e.writeEdge(tapp, generic, edges.ParamIndex(0))
+	for i := 0; i < targs.Len(); i++ {
+		if arg := e.emitType(targs.At(i)); arg != nil {
+			e.writeEdge(tapp, arg, edges.ParamIndex(i+1))
+		}
+	}
+}
+
+// isGeneric reports whether typ is a generic named type that has not been
+// instantiated.
+func isGeneric(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	return ok && named.TypeParams().Len() != 0 && named.TypeArgs().Len() == 0
+}
+
+// isConstraint reports whether typ is an interface whose type set is not fully
+// described by its methods, and so may only be used as a type constraint.
+func isConstraint(typ types.Type) bool {
+	it, ok := typ.Underlying().(*types.Interface)
+	return ok && !it.IsMethodSet()
+}
+
+// typeParamIdents returns the identifiers declared by a type parameter list.
+func typeParamIdents(fields *ast.FieldList) []*ast.Ident {
+	var ids []*ast.Ident
+	mapFields(fields, func(_ int, id *ast.Ident) { ids = append(ids, id) })
+	return ids
+}
+
+// recvTypeParams returns the identifiers declaring the type parameters of a
+// method receiver type expression, as in (l *List[K, V]).
+func recvTypeParams(expr ast.Expr) []*ast.Ident {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	var indices []ast.Expr
+	switch t := expr.(type) {
+	case *ast.IndexExpr:
+		indices = []ast.Expr{t.Index}
+	case *ast.IndexListExpr:
+		indices = t.Indices
+	}
+	var ids []*ast.Ident
+	for _, index := range indices {
+		if id, ok := index.(*ast.Ident); ok && id.Name != "_" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// genericTypeVName returns the vname of typ if it is a type parameter or an
+// instantiated named type; see typeVName.
+func (e *emitter) genericTypeVName(typ types.Type) (*spb.VName, bool) {
+	switch t := typ.(type) {
+	case *types.Named:
+		if targs := t.TypeArgs(); targs.Len() != 0 {
+			return e.tappVName(e.pi.ObjectVName(t.Origin().Obj()), targs), true
+		}
+	case *types.TypeParam:
+		return e.pi.ObjectVName(t.Obj()), true
+	}
+	return nil, false
+}
+
+// emitInstanceType emits the tapp node vname of typ, if it is an instantiated
+// named type.
+func (e *emitter) emitInstanceType(typ types.Type, vname *spb.VName) {
+	if t, ok := typ.(*types.Named); ok && t.TypeArgs().Len() != 0 {
+		e.emitTApp(vname, e.pi.ObjectVName(t.Origin().Obj()), t.TypeArgs())
+	}
+}
@@ -0,0 +1,106 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+import (
+	"context"
+	"go/types"
+	"strings"
+	"testing"
+
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/markedsource"
+
+	"github.com/golang/protobuf/proto"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func TestGenericInstances(t *testing.T) {
+	// Verify that applications of a generic type to type parameters of
+	// different functions with the same names are distinct tapp nodes, and
+	// that the signature of a nested application names its argument's node.
+	const input = `package p
+
+type Pair[K comparable, V any] struct{}
+
+func A[K comparable, V any]() Pair[K, V] { return Pair[K, V]{} }
+func B[K comparable, V any]() Pair[K, V] { return Pair[K, V]{} }
+
+var nested Pair[Pair[string, int], []int]
+`
+	unit, digest := oneFileCompilation("p.go", "p", input)
+	pi, err := Resolve(unit, memFetcher{digest: input}, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v\nInput unit:\n%s", err, proto.MarshalTextString(unit))
+	}
+
+	tapps := make(map[string]bool)                 // tapp tickets
+	params := make(map[string]map[string][]string) // tapp ticket → edge kind → target tickets
+	if err := pi.Emit(context.Background(), func(_ context.Context, e *spb.Entry) error {
+		src := kytheuri.ToString(e.Source)
+		if e.FactName == "/kythe/node/kind" && string(e.FactValue) == "tapp" {
+			tapps[src] = true
+		} else if strings.HasPrefix(e.EdgeKind, "/kythe/edge/param.") {
+			if params[src] == nil {
+				params[src] = make(map[string][]string)
+			}
+			tgt := kytheuri.ToString(e.Target)
+			for _, old := range params[src][e.EdgeKind] {
+				if old == tgt {
+					return nil
+				}
+			}
+			params[src][e.EdgeKind] = append(params[src][e.EdgeKind], tgt)
+		}
+		return nil
+	}, nil); err != nil {
+		t.Fatalf("Emit unexpectedly failed: %v", err)
+	}
+
+	// Pair[K, V] for each of A and B, and the nested and inner applications.
+	if len(tapps) != 4 {
+		t.Errorf("Got %d tapp nodes, want 4: %v", len(tapps), tapps)
+	}
+	for tapp := range tapps {
+		for kind, targets := range params[tapp] {
+			if len(targets) != 1 {
+				t.Errorf("Tapp %s: got %d %s targets, want 1: %v", tapp, len(targets), kind, targets)
+				continue
+			}
+			if arg := targets[0]; tapps[arg] && !strings.Contains(mustVName(t, tapp).Signature, mustVName(t, arg).Signature) {
+				t.Errorf("Tapp %s does not name its argument %s", tapp, arg)
+			}
+		}
+	}
+}
+
+func TestTypeParamList(t *testing.T) {
+	const input = "package p\n\nfunc Map[K comparable, V any, S ~[]V](s S) {}\n"
+	unit, digest := oneFileCompilation("p.go", "p", input)
+	pi, err := Resolve(unit, memFetcher{digest: input}, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v\nInput unit:\n%s", err, proto.MarshalTextString(unit))
+	}
+	fn := pi.Package.Scope().Lookup("Map").Type().(*types.Signature)
+	if got, want := markedsource.Render(typeParamList(fn.TypeParams())), "[K comparable, V any, S ~[]V]"; got != want {
+		t.Errorf("typeParamList: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package claim assigns the analysis of files shared by several compilations
+// to exactly one of them, so that facts about each file are emitted only once.
+//
+// An assignment is recorded by a kythe.proto.ClaimAssignment message, naming
+// the compilation responsible for (the "claimant" of) a dependency. Claims are
+// delivered to an analyzer as details of the compilation being analyzed: for
+// each of its required inputs that has a claimant, the compilation carries the
+// ClaimAssignment for that input. An analyzer should emit facts about the file
+// itself, such as its text, only if the compilation claims it. Inputs that have
+// no assignment are claimed by every compilation that requires them.
+//
+// Compilations are identified by their VNames, which should therefore be
+// unique within a set of compilations being assigned claims.
+package claim
+
+import (
+	"io"
+	"sort"
+
+	"kythe.io/kythe/go/platform/delimited"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/ptypes"
+
+	"github.com/golang/protobuf/proto"
+
+	anypb "github.com/golang/protobuf/ptypes/any"
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	clpb "kythe.io/kythe/proto/claim_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// key returns a string uniquely identifying vname.
+func key(vname *spb.VName) string { return kytheuri.ToString(vname) }
+
+// Assign assigns each input required by more than one of units to one of the
+// units that require it, and returns the assignments ordered by dependency.
+// Each dependency is assigned to whichever of its candidates has been assigned
+// the fewest dependencies so far, breaking ties by compilation VName, so that
+// the work of analyzing shared files is spread over the compilations. The
+// result depends only on the set of units, and not on their order.
+//
+// Inputs without a VName cannot be claimed, and are not assigned.
+func Assign(units []*apb.CompilationUnit) []*clpb.ClaimAssignment {
+	type dep struct {
+		vname      *spb.VName
+		candidates map[string]*spb.VName // compilation key → vname
+	}
+	deps := make(map[string]*dep)
+	for _, unit := range units {
+		ukey := key(unit.VName)
+		for _, ri := range unit.RequiredInput {
+			if ri.VName == nil {
+				continue
+			}
+			dkey := key(ri.VName)
+			d := deps[dkey]
+			if d == nil {
+				d = &dep{vname: ri.VName, candidates: make(map[string]*spb.VName)}
+				deps[dkey] = d
+			}
+			d.candidates[ukey] = unit.VName
+		}
+	}
+
+	var dkeys []string
+	for dkey, d := range deps {
+		if len(d.candidates) > 1 {
+			dkeys = append(dkeys, dkey)
+		}
+	}
+	sort.Strings(dkeys)
+
+	load := make(map[string]int) // compilation key → number of claims
+	var claims []*clpb.ClaimAssignment
+	for _, dkey := range dkeys {
+		d := deps[dkey]
+		var best string
+		for ukey := range d.candidates {
+			if best == "" || load[ukey] < load[best] || (load[ukey] == load[best] && ukey < best) {
+				best = ukey
+			}
+		}
+		load[best]++
+		claims = append(claims, &clpb.ClaimAssignment{
+			CompilationVName: d.candidates[best],
+			DependencyVName:  d.vname,
+		})
+	}
+	return claims
+}
+
+// Write writes claims to w as a delimited stream of ClaimAssignment messages.
+func Write(w io.Writer, claims []*clpb.ClaimAssignment) error {
+	dw := delimited.NewWriter(w)
+	for _, claim := range claims {
+		if err := dw.PutProto(claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A Table records the claimant of each assigned dependency. A nil *Table is
+// valid, and has no assignments.
+type Table struct {
+	claims map[string]*clpb.ClaimAssignment // dependency key → assignment
+}
+
+// NewTable returns a Table containing the given assignments. If a dependency
+// is assigned more than once, the last assignment wins.
+func NewTable(claims ...*clpb.ClaimAssignment) *Table {
+	t := &Table{claims: make(map[string]*clpb.ClaimAssignment)}
+	for _, claim := range claims {
+		t.claims[key(claim.DependencyVName)] = claim
+	}
+	return t
+}
+
+// ReadTable returns a Table containing the assignments read from r, which is
+// a delimited stream of ClaimAssignment messages as written by Write.
+func ReadTable(r io.Reader) (*Table, error) {
+	dr := delimited.NewReader(r)
+	var claims []*clpb.ClaimAssignment
+	for {
+		var claim clpb.ClaimAssignment
+		if err := dr.NextProto(&claim); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		claims = append(claims, &claim)
+	}
+	return NewTable(claims...), nil
+}
+
+// Len returns the number of dependencies assigned by t.
+func (t *Table) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.claims)
+}
+
+// Claimant returns the VName of the compilation that claims dep, or nil if dep
+// has not been assigned.
+func (t *Table) Claimant(dep *spb.VName) *spb.VName {
+	if t == nil {
+		return nil
+	}
+	return t.claims[key(dep)].GetCompilationVName()
+}
+
+// Attach returns unit with the assignments of its required inputs recorded in
+// its details, replacing any it already has. If t assigns none of the inputs
+// of unit, unit is returned unmodified; otherwise unit itself is not modified,
+// and a copy is returned.
+func (t *Table) Attach(unit *apb.CompilationUnit) *apb.CompilationUnit {
+	if t.Len() == 0 {
+		return unit
+	}
+	var claims []*anypb.Any
+	for _, ri := range unit.RequiredInput {
+		if ri.VName == nil {
+			continue
+		}
+		claim, ok := t.claims[key(ri.VName)]
+		if !ok {
+			continue
+		}
+		msg, err := ptypes.MarshalAny(claim)
+		if err != nil {
+			panic(err) // cannot happen: the message type is registered
+		}
+		claims = append(claims, msg)
+	}
+	if len(claims) == 0 {
+		return unit
+	}
+	var details []*anypb.Any
+	for _, msg := range unit.Details {
+		if ptypes.UnmarshalAny(msg, new(clpb.ClaimAssignment)) != nil {
+			details = append(details, msg)
+		}
+	}
+	cp := proto.Clone(unit).(*apb.CompilationUnit)
+	cp.Details = append(details, claims...)
+	return cp
+}
+
+// Claimed returns a function that reports whether unit claims the file with
+// the given VName, according to the assignments attached to its details. If a
+// file has no assignment, the function reports true.
+func Claimed(unit *apb.CompilationUnit) func(file *spb.VName) bool {
+	others := make(map[string]bool) // files claimed by other compilations
+	ukey := key(unit.GetVName())
+	for _, msg := range unit.GetDetails() {
+		var claim clpb.ClaimAssignment
+		if err := ptypes.UnmarshalAny(msg, &claim); err != nil {
+			continue
+		}
+		if key(claim.CompilationVName) != ukey {
+			others[key(claim.DependencyVName)] = true
+		}
+	}
+	return func(file *spb.VName) bool { return !others[key(file)] }
+}
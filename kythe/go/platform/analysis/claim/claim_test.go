@@ -0,0 +1,136 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package claim
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// testUnit returns a compilation with the given signature, requiring the
+// files with the given paths.
+func testUnit(sig string, paths ...string) *apb.CompilationUnit {
+	unit := &apb.CompilationUnit{VName: &spb.VName{Signature: sig}}
+	for _, path := range paths {
+		unit.RequiredInput = append(unit.RequiredInput, &apb.CompilationUnit_FileInput{
+			VName: &spb.VName{Path: path},
+			Info:  &apb.FileInfo{Path: path},
+		})
+	}
+	return unit
+}
+
+// claimants returns a string describing the claimant of each assignment.
+func claimants(t *Table, deps ...string) string {
+	var got []string
+	for _, dep := range deps {
+		got = append(got, dep+"="+t.Claimant(&spb.VName{Path: dep}).GetSignature())
+	}
+	return strings.Join(got, " ")
+}
+
+func TestAssign(t *testing.T) {
+	units := []*apb.CompilationUnit{
+		testUnit("A", "a.h", "common.h", "util.h"),
+		testUnit("B", "b.h", "common.h", "util.h", "x.h"),
+		testUnit("C", "common.h", "x.h"),
+	}
+	claims := Assign(units)
+
+	// Only the shared files are assigned, each to the least-loaded claimant.
+	const want = "a.h= b.h= common.h=A util.h=B x.h=C"
+	deps := []string{"a.h", "b.h", "common.h", "util.h", "x.h"}
+	if got := claimants(NewTable(claims...), deps...); got != want {
+		t.Errorf("Assign: got claims %q, want %q", got, want)
+	}
+
+	// The order of the units does not affect the result.
+	units[0], units[2] = units[2], units[0]
+	if got := claimants(NewTable(Assign(units)...), deps...); got != want {
+		t.Errorf("Assign (reordered): got claims %q, want %q", got, want)
+	}
+
+	// Assignments survive a round trip through their encoding.
+	var buf bytes.Buffer
+	if err := Write(&buf, claims); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	table, err := ReadTable(&buf)
+	if err != nil {
+		t.Fatalf("ReadTable failed: %v", err)
+	}
+	if table.Len() != len(claims) {
+		t.Errorf("ReadTable: got %d assignments, want %d", table.Len(), len(claims))
+	}
+	if got := claimants(table, deps...); got != want {
+		t.Errorf("ReadTable: got claims %q, want %q", got, want)
+	}
+}
+
+func TestAttach(t *testing.T) {
+	a := testUnit("A", "a.h", "common.h")
+	b := testUnit("B", "b.h", "common.h")
+	table := NewTable(Assign([]*apb.CompilationUnit{a, b})...)
+
+	// Without assignments, every unit claims all its inputs.
+	for _, unit := range []*apb.CompilationUnit{a, b} {
+		if !Claimed(unit)(&spb.VName{Path: "common.h"}) {
+			t.Errorf("Claimed(%s) before Attach: got false, want true", unit.VName.Signature)
+		}
+	}
+
+	tests := []struct {
+		unit *apb.CompilationUnit
+		file string
+		want bool
+	}{
+		{a, "a.h", true},
+		{a, "common.h", true},
+		{b, "b.h", true},
+		{b, "common.h", false},
+		{b, "nonesuch.h", true},
+	}
+	for _, test := range tests {
+		orig := proto.Clone(test.unit)
+		unit := table.Attach(test.unit)
+		if got := Claimed(unit)(&spb.VName{Path: test.file}); got != test.want {
+			t.Errorf("Claimed(%s, %q): got %v, want %v", unit.VName.Signature, test.file, got, test.want)
+		}
+		if !proto.Equal(test.unit, orig) {
+			t.Errorf("Attach modified its input:\n%s", proto.MarshalTextString(test.unit))
+		}
+
+		// Attaching assignments again replaces the existing ones.
+		if n := len(table.Attach(unit).Details); n != len(unit.Details) {
+			t.Errorf("Attach(%s) twice: got %d details, want %d", unit.VName.Signature, n, len(unit.Details))
+		}
+	}
+
+	if other := testUnit("C", "c.h"); table.Attach(other) != other {
+		t.Error("Attach copied a unit with no assigned inputs")
+	}
+	var none *Table
+	if none.Attach(a) != a || none.Claimant(&spb.VName{Path: "common.h"}) != nil {
+		t.Error("A nil Table has assignments")
+	}
+}
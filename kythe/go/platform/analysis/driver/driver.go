@@ -22,10 +22,15 @@ import (
 	"context"
 	goerrors "errors"
 	"log"
+	"sync"
+	"time"
 
 	"kythe.io/kythe/go/platform/analysis"
+	"kythe.io/kythe/go/platform/analysis/claim"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	apb "kythe.io/kythe/proto/analysis_go_proto"
 )
@@ -49,6 +54,18 @@ type Queue interface {
 	Next(_ context.Context, f CompilationFunc) error
 }
 
+// A ConcurrentQueue is a Queue whose compilations may be analyzed after the
+// call to Next that delivered them has returned. A Driver analyzes the
+// compilations of a queue concurrently only if it is a ConcurrentQueue.
+type ConcurrentQueue interface {
+	Queue
+
+	// Concurrent reports whether each compilation delivered by Next, and any
+	// data required to analyze it, remains available until its analysis has
+	// completed, even if Next is called again in the meantime.
+	Concurrent() bool
+}
+
 // A Context packages callbacks invoked during analysis.
 type Context interface {
 	// Setup is invoked after a compilation has been fetched from a Queue but
@@ -78,18 +95,133 @@ var (
 	// ErrEndOfQueue can be returned from a Queue to signal there are no
 	// compilations left to analyze.
 	ErrEndOfQueue = goerrors.New("end of queue")
+
+	// ErrTimeout is reported to a Driver's AnalysisError function when an
+	// analysis does not finish within the driver's Timeout.
+	ErrTimeout = goerrors.New("analysis timed out")
+
+	// errAbandoned is returned to an analyzer that writes output after the
+	// driver has stopped waiting for its analysis.
+	errAbandoned = goerrors.New("analysis abandoned by the driver")
 )
 
-// Driver sends compilations sequentially from a queue to an analyzer.
+// Stats describe the outcome of analyzing a single compilation.
+type Stats struct {
+	Attempts int           // the number of times the analysis was attempted
+	Outputs  int           // the number of outputs written, over all attempts
+	Duration time.Duration // the time spent analyzing, including retries
+	TimedOut bool          // whether the last attempt exceeded the Timeout
+	Err      error         // the error reported for the compilation, or nil
+}
+
+// A StatsContext is a Context that also receives the outcome of analyzing each
+// compilation.
+type StatsContext interface {
+	Context
+
+	// Stats is invoked after Teardown for each compilation whose setup
+	// succeeded, with the outcome of its analysis.
+	Stats(context.Context, Compilation, Stats)
+}
+
+// A RetryPolicy controls how a Driver retries analyses that fail with transient
+// errors, such as a failure to fetch an input from a remote service. The zero
+// value does not retry.
+type RetryPolicy struct {
+	// The maximum number of times to attempt each analysis, including the
+	// first. Values less than 2 disable retries.
+	MaxAttempts int
+
+	// The delay before the first retry. The delay is doubled for each
+	// subsequent retry, up to MaxBackoff if it is positive.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// Transient reports whether an analysis error may be retried. If nil,
+	// IsTransient is used.
+	Transient func(error) bool
+}
+
+func (r *RetryPolicy) transient(err error) bool {
+	if r.Transient != nil {
+		return r.Transient(err)
+	}
+	return IsTransient(err)
+}
+
+// transientError marks an error as transient.
+type transientError struct{ error }
+
+func (transientError) Temporary() bool { return true }
+
+// TransientError returns an error wrapping err that is reported as transient
+// by IsTransient. Fetchers may use it to mark failures that can be retried.
+func TransientError(err error) error { return transientError{err} }
+
+// IsTransient reports whether err, or its cause as reported by errors.Cause,
+// has a Temporary method returning true, or is a gRPC status error with code
+// Unavailable or DeadlineExceeded. This includes errors returned by
+// TransientError, temporary network errors, and the failures of a remote
+// fetcher that could not reach its service.
+func IsTransient(err error) bool {
+	type temporary interface {
+		Temporary() bool
+	}
+	for _, e := range []error{err, errors.Cause(err)} {
+		if t, ok := e.(temporary); ok && t.Temporary() {
+			return true
+		}
+		switch status.Code(e) {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return true
+		}
+	}
+	return false
+}
+
+// Driver sends compilations from a queue to an analyzer, either one at a time
+// or, if Parallelism is greater than 1, concurrently.
+//
+// Calls to WriteOutput are never concurrent, and the outputs of each analysis
+// are written in the order the analyzer produced them. When compilations are
+// analyzed concurrently their outputs may be interleaved. Outputs are written
+// only while the driver is waiting for the analysis that produced them; once
+// an analysis has returned or been abandoned after its Timeout, any further
+// outputs are discarded. An analysis that is retried may repeat the outputs of
+// its earlier attempts.
 type Driver struct {
 	Analyzer        analysis.CompilationAnalyzer
 	FileDataService string
 	Context         Context             // if nil, callbacks are no-ops
 	WriteOutput     analysis.OutputFunc // if nil, output is discarded
+
+	// The number of compilations to analyze concurrently. If this is greater
+	// than 1, the queue delivers the next compilation before the previous ones
+	// have been analyzed, and so must keep their data available until then;
+	// Run reports an error unless the queue is a ConcurrentQueue that does so.
+	// The methods of Context may then be called concurrently.
+	Parallelism int
+
+	// If positive, the time allowed for each attempt to analyze a compilation.
+	// An attempt that exceeds it fails with ErrTimeout, and is abandoned even
+	// if the analyzer ignores the cancellation of its context.
+	Timeout time.Duration
+
+	// Controls the retrying of analyses that fail with transient errors.
+	Retry RetryPolicy
+
+	// If set, the claim assignments of each compilation's required inputs are
+	// attached to its details before it is sent to the analyzer, so that only
+	// the claimant of a shared file emits facts about it.
+	Claims *claim.Table
+
+	mu sync.Mutex // serializes calls to WriteOutput
 }
 
 func (d *Driver) writeOutput(ctx context.Context, out *apb.AnalysisOutput) error {
 	if write := d.WriteOutput; write != nil {
+		d.mu.Lock()
+		defer d.mu.Unlock()
 		return write(ctx, out)
 	}
 	return nil
@@ -116,39 +248,186 @@ func (d *Driver) analysisError(ctx context.Context, unit Compilation, err error)
 	return err
 }
 
+func (d *Driver) stats(ctx context.Context, unit Compilation, stats Stats) {
+	if c, ok := d.Context.(StatsContext); ok {
+		c.Stats(ctx, unit, stats)
+	}
+}
+
 // Run sends each compilation received from the driver's Queue to the driver's
 // Analyzer.  All outputs are passed to Output in turn.  An error is immediately
 // returned if the Analyzer, Output, or Compilations fields are unset.
+//
+// Run stops at the first error that is not handled by the driver's Context.
+// If compilations are being analyzed concurrently, the analyses still in
+// progress are then cancelled and abandoned, as if they had timed out.
 func (d *Driver) Run(ctx context.Context, queue Queue) error {
 	if d.Analyzer == nil {
 		return errors.New("no analyzer has been specified")
 	}
+	if d.Parallelism > 1 {
+		if cq, ok := queue.(ConcurrentQueue); !ok || !cq.Concurrent() {
+			return errors.Errorf("driver: queue %T does not support concurrent analysis", queue)
+		}
+		return d.runParallel(ctx, queue)
+	}
 
 	for {
-		if err := queue.Next(ctx, func(ctx context.Context, cu Compilation) error {
-			if err := d.setup(ctx, cu); err != nil {
-				return errors.WithMessage(err, "driver: analysis setup")
-			}
-			err := ErrRetry
-			for err == ErrRetry {
-				err = d.analysisError(ctx, cu, d.Analyzer.Analyze(ctx, &apb.AnalysisRequest{
-					Compilation:     cu.Unit,
-					FileDataService: d.FileDataService,
-					Revision:        cu.Revision,
-					BuildId:         cu.BuildID,
-				}, d.writeOutput))
-			}
-			if terr := d.teardown(ctx, cu); terr != nil {
-				if err == nil {
-					return errors.WithMessage(terr, "driver: analysis teardown")
-				}
-				log.Printf("WARNING: analysis teardown failed: %v (analysis error: %v)", terr, err)
-			}
+		if err := queue.Next(ctx, d.analyze); err == ErrEndOfQueue {
+			return nil
+		} else if err != nil {
 			return err
-		}); err == ErrEndOfQueue {
+		}
+	}
+}
+
+// runParallel implements Run for Parallelism > 1.
+func (d *Driver) runParallel(ctx context.Context, queue Queue) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var runErr error // the first error, protected by mu
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if runErr == nil {
+			runErr = err
+			cancel()
+		}
+	}
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return runErr != nil
+	}
+
+	sem := make(chan struct{}, d.Parallelism)
+	for !failed() {
+		sem <- struct{}{} // wait for a free worker before fetching a compilation
+		scheduled := false
+		err := queue.Next(ctx, func(ctx context.Context, cu Compilation) error {
+			scheduled = true
+			wg.Add(1)
+			go func() {
+				defer func() { <-sem; wg.Done() }()
+				if err := d.analyze(ctx, cu); err != nil {
+					fail(err)
+				}
+			}()
 			return nil
+		})
+		if !scheduled {
+			<-sem
+		}
+		if err == ErrEndOfQueue {
+			break
 		} else if err != nil {
+			fail(err)
+		}
+	}
+	wg.Wait()
+	return runErr
+}
+
+// analyze sets up, analyzes, and tears down a single compilation.
+func (d *Driver) analyze(ctx context.Context, cu Compilation) error {
+	if err := d.setup(ctx, cu); err != nil {
+		return errors.WithMessage(err, "driver: analysis setup")
+	}
+	var stats Stats
+	start := time.Now()
+	err := ErrRetry
+	for err == ErrRetry {
+		err = d.analysisError(ctx, cu, d.analyzeWithRetries(ctx, cu, &stats))
+	}
+	stats.Duration = time.Since(start)
+	if terr := d.teardown(ctx, cu); terr != nil {
+		if err == nil {
+			err = errors.WithMessage(terr, "driver: analysis teardown")
+		} else {
+			log.Printf("WARNING: analysis teardown failed: %v (analysis error: %v)", terr, err)
+		}
+	}
+	stats.Err = err
+	d.stats(ctx, cu, stats)
+	return err
+}
+
+// analyzeWithRetries analyzes cu, retrying according to the driver's retry
+// policy, and returns the error of the last attempt.
+func (d *Driver) analyzeWithRetries(ctx context.Context, cu Compilation, stats *Stats) error {
+	delay := d.Retry.Backoff
+	for attempt := 1; ; attempt++ {
+		err := d.attempt(ctx, cu, stats)
+		if err == nil || attempt >= d.Retry.MaxAttempts || !d.Retry.transient(err) {
+			return err
+		}
+		log.Printf("Retrying analysis after transient error: %v", err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
 			return err
 		}
+		if delay *= 2; d.Retry.MaxBackoff > 0 && delay > d.Retry.MaxBackoff {
+			delay = d.Retry.MaxBackoff
+		}
 	}
 }
+
+// attempt makes a single attempt to analyze cu, subject to the driver's
+// Timeout.
+func (d *Driver) attempt(ctx context.Context, cu Compilation, stats *Stats) error {
+	actx, cancel := ctx, context.CancelFunc(func() {})
+	if d.Timeout > 0 {
+		actx, cancel = context.WithTimeout(ctx, d.Timeout)
+	}
+	defer cancel()
+
+	var mu sync.Mutex
+	done := false // set once the driver stops waiting for the analysis
+	output := func(ctx context.Context, out *apb.AnalysisOutput) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			return errAbandoned
+		}
+		stats.Outputs++
+		return d.writeOutput(ctx, out)
+	}
+
+	stats.Attempts++
+	errc := make(chan error, 1)
+	go func() {
+		errc <- d.Analyzer.Analyze(actx, &apb.AnalysisRequest{
+			Compilation:     d.Claims.Attach(cu.Unit),
+			FileDataService: d.FileDataService,
+			Revision:        cu.Revision,
+			BuildId:         cu.BuildID,
+		}, output)
+	}()
+
+	var err error
+	select {
+	case err = <-errc:
+	case <-actx.Done():
+		// The analyzer may not respect cancellation, so stop waiting for it
+		// unless it has already finished.
+		select {
+		case err = <-errc:
+		default:
+			err = actx.Err()
+		}
+	}
+	mu.Lock()
+	done = true
+	mu.Unlock()
+
+	if err != nil && actx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		stats.TimedOut = true
+		return ErrTimeout
+	}
+	stats.TimedOut = false
+	return err
+}
@@ -22,12 +22,20 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"kythe.io/kythe/go/platform/analysis"
+	"kythe.io/kythe/go/platform/analysis/claim"
 	"kythe.io/kythe/go/test/testutil"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	apb "kythe.io/kythe/proto/analysis_go_proto"
+	clpb "kythe.io/kythe/proto/claim_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
 )
 
@@ -96,6 +104,7 @@ type testContext struct {
 	setup         func(context.Context, Compilation) error
 	teardown      func(context.Context, Compilation) error
 	analysisError func(context.Context, Compilation, error) error
+	stats         func(context.Context, Compilation, Stats)
 }
 
 func (t testContext) Setup(ctx context.Context, unit Compilation) error {
@@ -119,6 +128,12 @@ func (t testContext) AnalysisError(ctx context.Context, unit Compilation, err er
 	return nil
 }
 
+func (t testContext) Stats(ctx context.Context, unit Compilation, stats Stats) {
+	if t.stats != nil {
+		t.stats(ctx, unit, stats)
+	}
+}
+
 func TestDriverInvalid(t *testing.T) {
 	m := &mock{t: t}
 	test := new(Driver)
@@ -311,3 +326,254 @@ func comps(sigs ...string) (cs []Compilation) {
 	}
 	return
 }
+
+// A queue is a Queue delivering a fixed sequence of compilations.
+type queue []Compilation
+
+func (q *queue) Next(ctx context.Context, f CompilationFunc) error {
+	if len(*q) == 0 {
+		return ErrEndOfQueue
+	}
+	next := (*q)[0]
+	*q = (*q)[1:]
+	return f(ctx, next)
+}
+
+// Concurrent implements ConcurrentQueue; the compilations of a queue are
+// always available.
+func (*queue) Concurrent() bool { return true }
+
+// analyzerFunc implements analysis.CompilationAnalyzer with a function.
+type analyzerFunc func(context.Context, *apb.AnalysisRequest, analysis.OutputFunc) error
+
+func (f analyzerFunc) Analyze(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+	return f(ctx, req, out)
+}
+
+// statsRecorder returns a testContext that records the stats of each
+// compilation by its signature, passing analysis errors to handle.
+func statsRecorder(stats map[string]Stats, handle func(error) error) testContext {
+	var mu sync.Mutex
+	return testContext{
+		analysisError: func(_ context.Context, _ Compilation, err error) error { return handle(err) },
+		stats: func(_ context.Context, cu Compilation, s Stats) {
+			mu.Lock()
+			defer mu.Unlock()
+			stats[cu.Unit.VName.Signature] = s
+		},
+	}
+}
+
+func TestDriverParallel(t *testing.T) {
+	const parallelism = 3
+	var active, maxActive, writing int32
+	q := queue(comps("a", "b", "c", "d", "e", "f", "g"))
+	var outputs []string
+	stats := make(map[string]Stats)
+	d := &Driver{
+		Parallelism: parallelism,
+		Analyzer: analyzerFunc(func(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+			n := atomic.AddInt32(&active, 1)
+			defer atomic.AddInt32(&active, -1)
+			for {
+				max := atomic.LoadInt32(&maxActive)
+				if n <= max || atomic.CompareAndSwapInt32(&maxActive, max, n) {
+					break
+				}
+			}
+			sig := req.Compilation.VName.Signature
+			for i := 0; i < 3; i++ {
+				time.Sleep(time.Millisecond)
+				if err := out(ctx, &apb.AnalysisOutput{Value: []byte(fmt.Sprintf("%s%d", sig, i))}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+		WriteOutput: func(_ context.Context, out *apb.AnalysisOutput) error {
+			if atomic.AddInt32(&writing, 1) != 1 {
+				t.Error("Concurrent call of WriteOutput")
+			}
+			defer atomic.AddInt32(&writing, -1)
+			outputs = append(outputs, string(out.Value))
+			return nil
+		},
+		Context: statsRecorder(stats, func(err error) error { return err }),
+	}
+	testutil.FatalOnErrT(t, "Driver error: %v", d.Run(context.Background(), &q))
+
+	if maxActive < 2 || maxActive > parallelism {
+		t.Errorf("Maximum concurrent analyses: got %d, want 2..%d", maxActive, parallelism)
+	}
+	// The outputs of each compilation must be complete and in order.
+	next := make(map[byte]byte)
+	for _, out := range outputs {
+		if want := '0' + next[out[0]]; out[1] != want {
+			t.Errorf("Output %q: want %c%c", out, out[0], want)
+		}
+		next[out[0]]++
+	}
+	if len(outputs) != 3*7 || len(next) != 7 {
+		t.Errorf("Outputs: got %q, want 3 for each of 7 compilations", outputs)
+	}
+	for sig, s := range stats {
+		if s.Attempts != 1 || s.Outputs != 3 || s.TimedOut || s.Err != nil {
+			t.Errorf("Stats for %q: got %+v, want 1 attempt with 3 outputs", sig, s)
+		}
+	}
+	if len(stats) != 7 {
+		t.Errorf("Stats reported for %d compilations, want 7", len(stats))
+	}
+}
+
+func TestDriverParallelError(t *testing.T) {
+	q := queue(comps("a", "b", "bad", "c", "d", "e", "f", "g"))
+	var analyzed int32
+	d := &Driver{
+		Parallelism: 2,
+		Analyzer: analyzerFunc(func(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+			atomic.AddInt32(&analyzed, 1)
+			if req.Compilation.VName.Signature == "bad" {
+				return errFromAnalysis
+			}
+			select {
+			case <-ctx.Done(): // cancelled by the failure
+				return ctx.Err()
+			case <-time.After(10 * time.Millisecond):
+				return nil
+			}
+		}),
+	}
+	if err := d.Run(context.Background(), &q); err != errFromAnalysis {
+		t.Errorf("Driver error: got %v, want %v", err, errFromAnalysis)
+	}
+	if n := atomic.LoadInt32(&analyzed); n > 5 {
+		t.Errorf("Analyzed %d compilations; want analysis to stop after the error", n)
+	}
+}
+
+func TestDriverTimeout(t *testing.T) {
+	q := queue(comps("slow", "fast"))
+	release := make(chan struct{})
+	lateErr := make(chan error, 1)
+	var outputs []string
+	stats := make(map[string]Stats)
+	var timeouts []error
+	d := &Driver{
+		Timeout: 20 * time.Millisecond,
+		Analyzer: analyzerFunc(func(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+			sig := req.Compilation.VName.Signature
+			if err := out(ctx, &apb.AnalysisOutput{Value: []byte(sig)}); err != nil {
+				return err
+			}
+			if sig == "slow" {
+				// Ignore the cancellation of ctx, and write more output once
+				// the driver has given up.
+				<-release
+				lateErr <- out(ctx, &apb.AnalysisOutput{Value: []byte("late")})
+			}
+			return nil
+		}),
+		WriteOutput: func(_ context.Context, out *apb.AnalysisOutput) error {
+			outputs = append(outputs, string(out.Value))
+			return nil
+		},
+		Context: statsRecorder(stats, func(err error) error {
+			timeouts = append(timeouts, err)
+			return nil // skip the compilation
+		}),
+	}
+	testutil.FatalOnErrT(t, "Driver error: %v", d.Run(context.Background(), &q))
+	close(release)
+	if err := <-lateErr; err != errAbandoned {
+		t.Errorf("Output after timeout: got error %v, want %v", err, errAbandoned)
+	}
+
+	if got, want := strings.Join(outputs, " "), "slow fast"; got != want {
+		t.Errorf("Outputs: got %q, want %q", got, want)
+	}
+	if len(timeouts) != 1 || timeouts[0] != ErrTimeout {
+		t.Errorf("Analysis errors: got %v, want [%v]", timeouts, ErrTimeout)
+	}
+	if s := stats["slow"]; !s.TimedOut || s.Outputs != 1 || s.Err != nil {
+		t.Errorf("Stats for slow compilation: got %+v, want timed out with 1 output", s)
+	}
+	if s := stats["fast"]; s.TimedOut || s.Outputs != 1 || s.Err != nil {
+		t.Errorf("Stats for fast compilation: got %+v, want success with 1 output", s)
+	}
+}
+
+func TestDriverRetry(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	tests := []struct {
+		sig      string
+		failures int   // the number of attempts that fail
+		err      error // the error of each failure
+		attempts int
+		ok       bool
+	}{
+		{"ok", 0, nil, 1, true},
+		{"transient", 2, TransientError(errFetch), 3, true},
+		{"persistent", 5, TransientError(errFetch), 3, false},
+		{"permanent", 1, errFetch, 1, false},
+		{"unavailable", 1, status.Error(codes.Unavailable, "no service"), 2, true},
+		{"deadline", 1, status.Error(codes.DeadlineExceeded, "slow"), 2, true},
+		{"notfound", 1, status.Error(codes.NotFound, "no file"), 1, false},
+	}
+	for _, test := range tests {
+		q := queue(comps(test.sig))
+		stats := make(map[string]Stats)
+		var attempts int
+		d := &Driver{
+			Retry: RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond},
+			Analyzer: analyzerFunc(func(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+				if attempts++; attempts <= test.failures {
+					return test.err
+				}
+				return nil
+			}),
+			Context: statsRecorder(stats, func(err error) error { return err }),
+		}
+		err := d.Run(context.Background(), &q)
+		if ok := err == nil; ok != test.ok {
+			t.Errorf("Driver %q: got error %v, want success %v", test.sig, err, test.ok)
+		}
+		if s := stats[test.sig]; s.Attempts != test.attempts || s.Err != err {
+			t.Errorf("Stats for %q: got %+v, want %d attempts with error %v", test.sig, s, test.attempts, err)
+		}
+	}
+}
+
+func TestDriverClaims(t *testing.T) {
+	shared := &spb.VName{Path: "shared.h"}
+	cs := comps("a", "b")
+	for _, cu := range cs {
+		cu.Unit.RequiredInput = []*apb.CompilationUnit_FileInput{{VName: shared}}
+	}
+	q := queue(cs)
+
+	var got []string // signatures of the units claiming shared.h
+	d := &Driver{
+		Claims: claim.NewTable(&clpb.ClaimAssignment{
+			CompilationVName: cs[1].Unit.VName,
+			DependencyVName:  shared,
+		}),
+		Analyzer: analyzerFunc(func(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+			if claim.Claimed(req.Compilation)(shared) {
+				got = append(got, req.Compilation.VName.Signature)
+			}
+			return nil
+		}),
+	}
+	if err := d.Run(context.Background(), &q); err != nil {
+		t.Fatalf("Driver error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "b" {
+		t.Errorf("Claimants of %v: got %q, want [b]", shared, got)
+	}
+	for _, cu := range cs {
+		if len(cu.Unit.Details) != 0 {
+			t.Errorf("Driver modified compilation %q: %v", cu.Unit.VName.Signature, cu.Unit.Details)
+		}
+	}
+}
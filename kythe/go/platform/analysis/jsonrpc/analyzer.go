@@ -0,0 +1,250 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonrpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	dpb "kythe.io/kythe/proto/driver_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// Options control the behaviour of Run.
+type Options struct {
+	// If set, request only compilations for this language.
+	Language string
+
+	// The maximum number of compilations to analyze concurrently. If zero,
+	// compilations are analyzed one at a time.
+	Concurrency int
+}
+
+func (o *Options) language() string {
+	if o == nil {
+		return ""
+	}
+	return o.Language
+}
+
+func (o *Options) concurrency() int {
+	if o == nil || o.Concurrency <= 0 {
+		return 1
+	}
+	return o.Concurrency
+}
+
+// A Task is a compilation delivered by the driver for analysis. Its methods
+// may be called concurrently, but not after the AnalyzeFunc it was passed to
+// has returned.
+type Task struct {
+	ID   int64                // the analysis ID assigned by the driver
+	Unit *apb.CompilationUnit // the compilation to analyze
+
+	c *client
+}
+
+// Fetch implements the analysis.Fetcher interface by requesting the file from
+// the driver.
+func (t *Task) Fetch(path, digest string) ([]byte, error) {
+	var rsp dpb.FileReply
+	if err := t.c.call("file", &dpb.FileRequest{Id: t.ID, Path: path, Digest: digest}, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.Data, nil
+}
+
+// WriteEntries sends entries to the driver as output of the analysis.
+func (t *Task) WriteEntries(entries ...*spb.Entry) error {
+	return t.c.notify("out", &dpb.OutRequest{Id: t.ID, Entries: entries})
+}
+
+// WriteOutputs sends values to the driver as output of the analysis.
+func (t *Task) WriteOutputs(values ...[]byte) error {
+	return t.c.notify("out", &dpb.OutRequest{Id: t.ID, Output: values})
+}
+
+// Log sends a diagnostic message about the analysis to the driver.
+func (t *Task) Log(diag *cpb.Diagnostic) error {
+	return t.c.notify("log", &dpb.LogRequest{Id: t.ID, Message: diag})
+}
+
+// An AnalyzeFunc analyzes the compilation delivered by a Task. If it returns
+// an error, the error is logged to the driver before the analysis is done.
+type AnalyzeFunc func(context.Context, *Task) error
+
+// Run implements the analyzer side of the protocol, reading responses from
+// the driver on in and writing requests to it on out. After a handshake, Run
+// repeatedly requests a compilation and calls f to analyze it, until the
+// driver closes in. In that case Run returns nil, after waiting for the
+// analyses in progress to finish; otherwise it returns the error that stopped
+// it.
+func Run(ctx context.Context, in io.Reader, out io.Writer, opts *Options, f AnalyzeFunc) error {
+	c := &client{
+		w:       bufio.NewWriter(out),
+		pending: make(map[int64]chan *response),
+	}
+	go c.read(bufio.NewReader(in))
+
+	var init dpb.InitReply
+	if err := c.call("init", &dpb.InitRequest{Protocol: ProtocolVersion}, &init); err != nil {
+		return c.result(err)
+	} else if init.Protocol != ProtocolVersion {
+		return fmt.Errorf("jsonrpc: driver replied with protocol %q", init.Protocol)
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	sem := make(chan struct{}, opts.concurrency())
+	for {
+		sem <- struct{}{}
+		var rsp dpb.AnalyzeReply
+		if err := c.call("analyze", &dpb.AnalyzeRequest{Language: opts.language()}, &rsp); err != nil {
+			return c.result(err)
+		}
+		t := &Task{ID: rsp.Id, Unit: rsp.Unit, c: c}
+		wg.Add(1)
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			if err := f(ctx, t); err != nil {
+				t.Log(&cpb.Diagnostic{Message: err.Error()})
+			}
+			c.notify("done", &dpb.LogRequest{Id: t.ID})
+		}()
+	}
+}
+
+// A client is the JSON-RPC client used by an analyzer to call its driver.
+// Responses are matched to calls by their IDs, so calls may be concurrent.
+type client struct {
+	wmu sync.Mutex // protects w
+	w   *bufio.Writer
+
+	mu      sync.Mutex
+	nextID  int64
+	pending map[int64]chan *response // calls awaiting responses, by ID
+	err     error                    // set once in is closed or fails
+}
+
+// call invokes method with params, and decodes its result into rsp.
+func (c *client) call(method string, params, rsp proto.Message) error {
+	ch := make(chan *response, 1)
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return c.err
+	}
+	c.nextID++
+	id := c.nextID
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	if err := c.send(json.RawMessage(strconv.FormatInt(id, 10)), method, params); err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return err
+	}
+	r, ok := <-ch
+	if !ok {
+		return c.err
+	} else if r.Error != nil {
+		return r.Error
+	}
+	return decodeProto(r.Result, rsp)
+}
+
+// notify sends a notification of method with params.
+func (c *client) notify(method string, params proto.Message) error {
+	return c.send(nil, method, params)
+}
+
+// send writes a request with the given ID, or a notification if id == nil.
+func (c *client) send(id json.RawMessage, method string, params proto.Message) error {
+	args, err := encodeProto(params)
+	if err != nil {
+		return err
+	}
+	bits, err := json.Marshal(&request{Version: "2.0", ID: id, Method: method, Params: args})
+	if err != nil {
+		return err
+	}
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return writeFrame(c.w, bits)
+}
+
+// read delivers each response read from r to its waiting caller, until r ends
+// or a corrupt frame is read.
+func (c *client) read(r *bufio.Reader) {
+	var err error
+	for {
+		var frame []byte
+		if frame, err = readFrame(r); err != nil {
+			break
+		}
+		var rsps []*response
+		if isBatch(frame) {
+			err = json.Unmarshal(frame, &rsps)
+		} else {
+			rsp := new(response)
+			err = json.Unmarshal(frame, rsp)
+			rsps = append(rsps, rsp)
+		}
+		if err != nil {
+			break
+		}
+		for _, rsp := range rsps {
+			id, perr := strconv.ParseInt(string(rsp.ID), 10, 64)
+			c.mu.Lock()
+			ch := c.pending[id]
+			delete(c.pending, id)
+			c.mu.Unlock()
+			if perr == nil && ch != nil {
+				ch <- rsp
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+	for id, ch := range c.pending {
+		delete(c.pending, id)
+		close(ch)
+	}
+}
+
+// result returns the error that Run should report after a call failed with
+// err: nil if the driver closed its end of the connection, or else err.
+func (c *client) result(err error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == io.EOF {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,507 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonrpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"kythe.io/kythe/go/platform/analysis"
+	"kythe.io/kythe/go/platform/analysis/driver"
+
+	"github.com/golang/protobuf/proto"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	dpb "kythe.io/kythe/proto/driver_go_proto"
+)
+
+// gracePeriod is how long an analyzer process is given to exit once its input
+// has been closed, before it is killed.
+const gracePeriod = 10 * time.Second
+
+// An Analyzer is an analysis.CompilationAnalyzer that delivers each
+// compilation to an analyzer subprocess speaking the protocol. The process is
+// started when the first compilation is analyzed, and restarted as needed if
+// it exits. Concurrent calls to Analyze are safe, and are delivered to the
+// analyzer as it requests them.
+//
+// The protocol has no way for an analyzer to report that an analysis failed,
+// other than by sending a log message about it. If Context is set, each
+// message is reported to its AnalysisError method as a *Diagnostic, and if
+// that returns an error the analysis fails with it. Otherwise the messages are
+// written to the log.
+//
+// The analyzer may request only the files required by the compilations it is
+// analyzing; requests for any other file fail with CodeFileNotFound.
+type Analyzer struct {
+	Path    string           // the path of the analyzer program
+	Args    []string         // the arguments to the analyzer, excluding its name
+	Fetcher analysis.Fetcher // serves the analyzer's requests for files
+	Context driver.Context   // if set, receives log messages
+	Stderr  io.Writer        // receives the analyzer's standard error; if nil, os.Stderr
+
+	mu   sync.Mutex
+	proc *process // the running analyzer process, or nil
+}
+
+// A Diagnostic is the error reported to a driver.Context for each log message
+// sent by an analyzer.
+type Diagnostic struct{ *cpb.Diagnostic }
+
+func (d *Diagnostic) Error() string {
+	if d.Details != "" {
+		return fmt.Sprintf("analyzer: %s: %s", d.Message, d.Details)
+	}
+	return "analyzer: " + d.Message
+}
+
+// Analyze implements the analysis.CompilationAnalyzer interface. It returns an
+// error if the analyzer process exits before reporting the analysis done.
+func (a *Analyzer) Analyze(ctx context.Context, req *apb.AnalysisRequest, f analysis.OutputFunc) error {
+	if a.Fetcher == nil {
+		return errors.New("jsonrpc: no fetcher has been specified")
+	}
+	p, err := a.process()
+	if err != nil {
+		return err
+	}
+
+	t := &task{ctx: ctx, req: req, output: f, done: make(chan error, 1)}
+	select {
+	case p.tasks <- t:
+	case <-p.exited:
+		return p.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-t.done:
+		return err
+	case <-p.exited:
+		return p.err
+	case <-ctx.Done():
+		p.finish(t, ctx.Err())
+		return ctx.Err()
+	}
+}
+
+// Close closes the input of the analyzer process, if it is running, and waits
+// for it to exit. A process that does not exit within 10 seconds is killed.
+func (a *Analyzer) Close() error {
+	a.mu.Lock()
+	p := a.proc
+	a.proc = nil
+	a.mu.Unlock()
+	if p == nil {
+		return nil
+	}
+	p.stdin.Close()
+	select {
+	case <-p.exited:
+	case <-time.After(gracePeriod):
+		p.cmd.Process.Kill()
+		<-p.exited
+	}
+	return nil
+}
+
+// process returns the running analyzer process, starting it if necessary.
+func (a *Analyzer) process() (*process, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.proc != nil {
+		select {
+		case <-a.proc.exited:
+			a.proc = nil
+		default:
+			return a.proc, nil
+		}
+	}
+
+	cmd := exec.Command(a.Path, a.Args...)
+	cmd.Stderr = a.Stderr
+	if cmd.Stderr == nil {
+		cmd.Stderr = os.Stderr
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("jsonrpc: starting analyzer: %v", err)
+	}
+	p := &process{
+		a:      a,
+		cmd:    cmd,
+		stdin:  stdin,
+		w:      bufio.NewWriter(stdin),
+		tasks:  make(chan *task),
+		active: make(map[int64]*task),
+		exited: make(chan struct{}),
+	}
+	go p.run(bufio.NewReader(stdout))
+	a.proc = p
+	return p, nil
+}
+
+// A task is a compilation delivered to the analyzer.
+type task struct {
+	ctx    context.Context
+	req    *apb.AnalysisRequest
+	output analysis.OutputFunc
+	done   chan error // receives the outcome of the analysis
+
+	finished bool // whether the outcome has been sent; protected by process.mu
+}
+
+// requires reports whether the compilation of t requires a file with the given
+// path and digest, either of which may be empty to match any value.
+func (t *task) requires(path, digest string) bool {
+	for _, ri := range t.req.Compilation.GetRequiredInput() {
+		if (path == "" || path == ri.Info.GetPath()) && (digest == "" || digest == ri.Info.GetDigest()) {
+			return true
+		}
+	}
+	return false
+}
+
+// A process is a running analyzer process.
+type process struct {
+	a     *Analyzer
+	cmd   *exec.Cmd
+	stdin io.Closer
+
+	wmu sync.Mutex // protects w
+	w   *bufio.Writer
+
+	tasks chan *task // compilations waiting to be delivered
+
+	mu     sync.Mutex
+	ready  bool            // whether the handshake has completed
+	nextID int64           // the last analysis ID assigned
+	active map[int64]*task // pending analyses, by ID
+
+	exited chan struct{} // closed once the process has exited
+	err    error         // the reason the process exited
+}
+
+// run reads and handles the requests of the analyzer until it closes its
+// output, then fails any pending analyses and waits for it to exit.
+func (p *process) run(r *bufio.Reader) {
+	var err error
+	for {
+		var frame []byte
+		frame, err = readFrame(r)
+		if err != nil {
+			break
+		}
+		p.handleFrame(frame)
+	}
+	p.stdin.Close()
+	if err == io.EOF {
+		err = errors.New("jsonrpc: analyzer closed its output")
+	}
+	if werr := p.cmd.Wait(); werr != nil {
+		err = fmt.Errorf("%v (%v)", err, werr)
+	}
+
+	p.mu.Lock()
+	p.err = err
+	for id, t := range p.active {
+		delete(p.active, id)
+		t.finished = true
+		t.done <- err
+	}
+	p.mu.Unlock()
+	close(p.exited)
+}
+
+// handleFrame handles the request or batch of requests in frame and writes
+// the responses to the calls among them. Requests for analyses block until a
+// compilation is available, so the response to a frame containing one is
+// written asynchronously. All other requests, and in particular the
+// notifications, are handled in the order they were received.
+func (p *process) handleFrame(frame []byte) {
+	batch := isBatch(frame)
+	var reqs []*request
+	var err error
+	if batch {
+		err = json.Unmarshal(frame, &reqs)
+	} else {
+		req := new(request)
+		err = json.Unmarshal(frame, req)
+		reqs = append(reqs, req)
+	}
+	if err != nil {
+		p.write(&response{Error: &Error{Code: CodeParseError, Message: err.Error()}})
+		return
+	} else if len(reqs) == 0 {
+		p.write(&response{Error: &Error{Code: CodeInvalidRequest, Message: "empty batch"}})
+		return
+	}
+
+	rsps := make([]*response, len(reqs))
+	var wg sync.WaitGroup
+	async := false
+	for i, req := range reqs {
+		if req == nil {
+			req = new(request) // reported as an unknown method
+			reqs[i] = req
+		}
+		if req.Method == "analyze" {
+			async = true
+			wg.Add(1)
+			go func(i int, req *request) {
+				defer wg.Done()
+				rsps[i] = p.handle(req)
+			}(i, req)
+		} else {
+			rsps[i] = p.handle(req)
+		}
+	}
+	reply := func() {
+		wg.Wait()
+		var out []*response
+		for i, rsp := range rsps {
+			if reqs[i].ID != nil {
+				rsp.Version = "2.0"
+				rsp.ID = reqs[i].ID
+				out = append(out, rsp)
+			}
+		}
+		if len(out) == 0 {
+			return // only notifications
+		} else if batch {
+			p.write(out)
+		} else {
+			p.write(out[0])
+		}
+	}
+	if async {
+		go reply()
+	} else {
+		reply()
+	}
+}
+
+// write encodes and writes a response or batch of responses to the analyzer.
+// Errors are ignored; if the analyzer has stopped reading, it will notice.
+func (p *process) write(v interface{}) {
+	if rsp, ok := v.(*response); ok && rsp.Version == "" {
+		rsp.Version = "2.0"
+		rsp.ID = json.RawMessage("null")
+	}
+	bits, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("Error encoding response: %v", err)
+		return
+	}
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+	writeFrame(p.w, bits)
+}
+
+// protocolError returns a response reporting a protocol violation.
+func protocolError(format string, args ...interface{}) *response {
+	return &response{Error: &Error{Code: CodeProtocolError, Message: fmt.Sprintf(format, args...)}}
+}
+
+// handle handles a single request and returns its response. The response to
+// a notification is discarded.
+func (p *process) handle(req *request) *response {
+	p.mu.Lock()
+	ready := p.ready
+	p.mu.Unlock()
+	if req.Method == "init" {
+		var msg dpb.InitRequest
+		if err := decodeProto(req.Params, &msg); err != nil {
+			return &response{Error: &Error{Code: CodeInvalidParams, Message: err.Error()}}
+		} else if msg.Protocol != ProtocolVersion {
+			p.stdin.Close()
+			return protocolError("unsupported protocol version %q", msg.Protocol)
+		}
+		p.mu.Lock()
+		p.ready = true
+		p.mu.Unlock()
+		return p.result(&dpb.InitReply{Protocol: ProtocolVersion})
+	} else if !ready {
+		p.stdin.Close()
+		return protocolError("method %q called before init", req.Method)
+	}
+
+	switch req.Method {
+	case "analyze":
+		// The requested language is not checked; the driver decides which
+		// compilations to deliver to the analyzer.
+		for {
+			var t *task
+			select {
+			case t = <-p.tasks:
+			case <-p.exited:
+				return protocolError("analyzer exited")
+			}
+			p.mu.Lock()
+			if t.finished {
+				p.mu.Unlock()
+				continue // abandoned by the caller
+			}
+			p.nextID++
+			id := p.nextID
+			p.active[id] = t
+			p.mu.Unlock()
+			return p.result(&dpb.AnalyzeReply{Id: id, Unit: t.req.Compilation})
+		}
+
+	case "file":
+		var msg dpb.FileRequest
+		if err := decodeProto(req.Params, &msg); err != nil {
+			return &response{Error: &Error{Code: CodeInvalidParams, Message: err.Error()}}
+		}
+		t := p.task(msg.Id)
+		if t == nil {
+			return protocolError("no analysis with ID %d", msg.Id)
+		} else if msg.Path == "" && msg.Digest == "" {
+			return &response{Error: &Error{Code: CodeInvalidParams, Message: "missing path and digest"}}
+		} else if !t.requires(msg.Path, msg.Digest) {
+			return &response{Error: &Error{
+				Code:    CodeFileNotFound,
+				Message: fmt.Sprintf("file %q (digest %q) is not an input of analysis %d", msg.Path, msg.Digest, msg.Id),
+			}}
+		}
+		data, err := p.a.Fetcher.Fetch(msg.Path, msg.Digest)
+		if err != nil {
+			return &response{Error: &Error{Code: CodeFileNotFound, Message: err.Error()}}
+		}
+		return p.result(&dpb.FileReply{Path: msg.Path, Digest: msg.Digest, Data: data})
+
+	case "out":
+		var msg dpb.OutRequest
+		if err := decodeProto(req.Params, &msg); err != nil {
+			return &response{Error: &Error{Code: CodeInvalidParams, Message: err.Error()}}
+		}
+		if t := p.task(msg.Id); t != nil {
+			if err := p.writeOutputs(t, &msg); err != nil {
+				p.finish(t, err)
+			}
+		}
+		return &response{}
+
+	case "log":
+		var msg dpb.LogRequest
+		if err := decodeProto(req.Params, &msg); err != nil {
+			return &response{Error: &Error{Code: CodeInvalidParams, Message: err.Error()}}
+		}
+		if t := p.task(msg.Id); t != nil && msg.Message != nil {
+			if err := p.a.report(t, &Diagnostic{msg.Message}); err != nil {
+				p.finish(t, err)
+			}
+		}
+		return &response{}
+
+	case "done":
+		var msg dpb.LogRequest
+		if err := decodeProto(req.Params, &msg); err != nil {
+			return &response{Error: &Error{Code: CodeInvalidParams, Message: err.Error()}}
+		}
+		if t := p.task(msg.Id); t != nil {
+			p.finish(t, nil)
+		}
+		return &response{}
+	}
+	return &response{Error: &Error{Code: CodeMethodNotFound, Message: fmt.Sprintf("unknown method %q", req.Method)}}
+}
+
+// result returns a response whose result is the encoding of msg.
+func (p *process) result(msg proto.Message) *response {
+	bits, err := encodeProto(msg)
+	if err != nil {
+		return &response{Error: &Error{Code: CodeProtocolError, Message: err.Error()}}
+	}
+	return &response{Result: bits}
+}
+
+// task returns the pending analysis with the given ID, or nil.
+func (p *process) task(id int64) *task {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.active[id]
+}
+
+// finish ends the analysis t with the outcome err, unless it has already
+// ended. Subsequent requests for its ID are discarded.
+func (p *process) finish(t *task, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t.finished {
+		return
+	}
+	t.finished = true
+	t.done <- err
+	for id, u := range p.active {
+		if u == t {
+			delete(p.active, id)
+		}
+	}
+}
+
+// writeOutputs passes the outputs and entries of msg to the output function of
+// t, the latter as wire-format values.
+func (p *process) writeOutputs(t *task, msg *dpb.OutRequest) error {
+	for _, value := range msg.Output {
+		if err := t.output(t.ctx, &apb.AnalysisOutput{Value: value}); err != nil {
+			return err
+		}
+	}
+	for _, entry := range msg.Entries {
+		bits, err := proto.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if err := t.output(t.ctx, &apb.AnalysisOutput{Value: bits}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// report delivers a log message about the analysis t to the driver context,
+// or writes it to the log if there is none.
+func (a *Analyzer) report(t *task, diag *Diagnostic) error {
+	if a.Context == nil {
+		log.Printf("Analysis of %q: %v", t.req.Compilation.GetVName().GetSignature(), diag)
+		return nil
+	}
+	return a.Context.AnalysisError(t.ctx, driver.Compilation{
+		Unit:     t.req.Compilation,
+		Revision: t.req.Revision,
+		BuildID:  t.req.BuildId,
+	}, diag)
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jsonrpc implements both sides of the protocol between a Kythe
+// analyzer and its driver defined by kythe/proto/driver.proto and described in
+// kythe/docs/rfc/2966.md.
+//
+// The analyzer and driver exchange JSON-RPC 2.0 messages over a pair of byte
+// streams, typically the standard input and output of the analyzer process.
+// Each message is sent as a frame consisting of its length in bytes, written
+// in decimal and terminated by a newline (LF), followed by the message itself.
+// The analyzer is the client, and calls these methods of the driver:
+//
+//   init(InitRequest) → InitReply          -- handshake, must be called first
+//   analyze(AnalyzeRequest) → AnalyzeReply -- wait for a compilation
+//   file(FileRequest) → FileReply          -- fetch a required input
+//   out(OutRequest)                        -- notification: write outputs
+//   log(LogRequest)                        -- notification: report a diagnostic
+//   done(LogRequest)                       -- notification: finish an analysis
+//
+// The messages are encoded using the canonical JSON mapping for proto3.
+//
+// An Analyzer implements the driver side, as an analysis.CompilationAnalyzer
+// that delivers compilations to an analyzer subprocess. The Run function
+// implements the analyzer side, calling a user-provided function to analyze
+// each compilation.
+package jsonrpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// ProtocolVersion is the version of the protocol implemented by this package.
+const ProtocolVersion = "kythe1"
+
+// Error codes defined by JSON-RPC 2.0 and by the protocol.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+
+	CodeProtocolError = -1 // a violation of the protocol
+	CodeFileNotFound  = -2 // a requested file was not found
+)
+
+// An Error is the error value of a JSON-RPC response.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string { return fmt.Sprintf("jsonrpc: %s (code %d)", e.Message, e.Code) }
+
+// A request is a JSON-RPC request or, if it has no ID, notification.
+type request struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// A response is a JSON-RPC response to a request.
+type response struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// errCorruptFrame is reported for a frame whose length tag is malformed, or
+// which ends before the length specified by its tag.
+var errCorruptFrame = errors.New("jsonrpc: corrupt frame")
+
+// readFrame reads the body of a single frame from r. It returns io.EOF if r
+// ends before a frame begins.
+func readFrame(r *bufio.Reader) ([]byte, error) {
+	tag, err := r.ReadString('\n')
+	if err == io.EOF && tag == "" {
+		return nil, io.EOF
+	} else if err != nil || len(tag) == 1 {
+		return nil, errCorruptFrame
+	}
+	for _, c := range tag[:len(tag)-1] {
+		if c < '0' || c > '9' {
+			return nil, errCorruptFrame
+		}
+	}
+	if len(tag) > 2 && tag[0] == '0' {
+		return nil, errCorruptFrame // the length must have minimum width
+	}
+	n, err := strconv.Atoi(tag[:len(tag)-1])
+	if err != nil {
+		return nil, errCorruptFrame
+	}
+	body := make([]byte, n)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, errCorruptFrame
+	}
+	return body, nil
+}
+
+// writeFrame writes body to w as a single frame, and flushes w.
+func writeFrame(w *bufio.Writer, body []byte) error {
+	if _, err := fmt.Fprintf(w, "%d\n", len(body)); err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// isBatch reports whether the JSON value in data is an array.
+func isBatch(data []byte) bool {
+	data = bytes.TrimLeft(data, " \t\r\n")
+	return len(data) != 0 && data[0] == '['
+}
+
+var toJSON = &jsonpb.Marshaler{}
+
+// encodeProto returns the canonical JSON encoding of msg.
+func encodeProto(msg proto.Message) (json.RawMessage, error) {
+	s, err := toJSON.MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(s), nil
+}
+
+// decodeProto decodes the JSON encoding of a message from data into msg. An
+// empty or null value leaves msg unchanged.
+func decodeProto(data json.RawMessage, msg proto.Message) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	u := &jsonpb.Unmarshaler{AllowUnknownFields: true}
+	return u.Unmarshal(bytes.NewReader(data), msg)
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonrpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"kythe.io/kythe/go/platform/analysis/driver"
+
+	"github.com/golang/protobuf/proto"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// When this variable is set in the environment, the test binary acts as an
+// analyzer instead of running tests.
+const analyzerEnv = "JSONRPC_TEST_ANALYZER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(analyzerEnv) != "" {
+		if err := Run(context.Background(), os.Stdin, os.Stdout, &Options{Concurrency: 2}, fakeAnalyze); err != nil {
+			log.Fatalf("Analyzer failed: %v", err)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// fakeAnalyze fetches each of the required inputs of the compilation and
+// writes its contents as output, followed by an entry naming the compilation.
+// A compilation whose signature is "log" logs a message, and one whose
+// signature is "fail" fails after writing its outputs.
+func fakeAnalyze(ctx context.Context, t *Task) error {
+	for _, ri := range t.Unit.RequiredInput {
+		data, err := t.Fetch(ri.Info.Path, ri.Info.Digest)
+		if err != nil {
+			return err
+		}
+		if err := t.WriteOutputs(data); err != nil {
+			return err
+		}
+	}
+	sig := t.Unit.VName.Signature
+	if err := t.WriteEntries(&spb.Entry{Source: t.Unit.VName, FactName: "/done"}); err != nil {
+		return err
+	}
+	switch sig {
+	case "log":
+		return t.Log(&cpb.Diagnostic{Message: "hello"})
+	case "fail":
+		return errors.New("bad compilation")
+	}
+	return nil
+}
+
+type fakeFetcher map[string]string
+
+func (f fakeFetcher) Fetch(path, digest string) ([]byte, error) {
+	if data, ok := f[digest]; ok {
+		return []byte(data), nil
+	}
+	return nil, fmt.Errorf("file %q not found", path)
+}
+
+// fakeContext records the diagnostics reported for each compilation, and
+// fails the analysis if the message contains "bad".
+type fakeContext struct {
+	mu    sync.Mutex
+	diags []string
+}
+
+func (*fakeContext) Setup(context.Context, driver.Compilation) error    { return nil }
+func (*fakeContext) Teardown(context.Context, driver.Compilation) error { return nil }
+func (c *fakeContext) AnalysisError(_ context.Context, cu driver.Compilation, err error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if d, ok := err.(*Diagnostic); ok {
+		c.diags = append(c.diags, cu.Unit.VName.Signature+": "+d.Message)
+		if strings.Contains(d.Message, "bad") {
+			return err
+		}
+		return nil
+	}
+	return err
+}
+
+func testRequest(sig string, digests ...string) *apb.AnalysisRequest {
+	unit := &apb.CompilationUnit{VName: &spb.VName{Signature: sig}}
+	for _, digest := range digests {
+		unit.RequiredInput = append(unit.RequiredInput, &apb.CompilationUnit_FileInput{
+			Info: &apb.FileInfo{Path: digest + ".txt", Digest: digest},
+		})
+	}
+	return &apb.AnalysisRequest{Compilation: unit}
+}
+
+func TestAnalyzer(t *testing.T) {
+	os.Setenv(analyzerEnv, "1")
+	defer os.Unsetenv(analyzerEnv)
+
+	dc := new(fakeContext)
+	a := &Analyzer{
+		Path:    os.Args[0],
+		Fetcher: fakeFetcher{"a": "apple", "b": "banana"},
+		Context: dc,
+	}
+	defer a.Close()
+
+	tests := []struct {
+		req  *apb.AnalysisRequest
+		want string // outputs
+		ok   bool
+	}{
+		{testRequest("ab", "a", "b"), "apple banana ab", true},
+		{testRequest("none"), "none", true},
+		{testRequest("log", "b"), "banana log", true},
+		{testRequest("fail", "a"), "apple fail", false},
+		{testRequest("missing", "c"), "", true}, // the context ignores the error
+	}
+
+	// Analyze the compilations concurrently, as a parallel driver would.
+	var wg sync.WaitGroup
+	for _, test := range tests {
+		test := test
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var got []string
+			err := a.Analyze(context.Background(), test.req, func(_ context.Context, out *apb.AnalysisOutput) error {
+				var entry spb.Entry
+				if proto.Unmarshal(out.Value, &entry) == nil && entry.FactName == "/done" {
+					got = append(got, entry.Source.Signature)
+				} else {
+					got = append(got, string(out.Value))
+				}
+				return nil
+			})
+			sig := test.req.Compilation.VName.Signature
+			if ok := err == nil; ok != test.ok {
+				t.Errorf("Analyze %q: got error %v, want success %v", sig, err, test.ok)
+			}
+			if got := strings.Join(got, " "); got != test.want {
+				t.Errorf("Analyze %q outputs: got %q, want %q", sig, got, test.want)
+			}
+		}()
+	}
+	wg.Wait()
+
+	sort.Strings(dc.diags)
+	want := []string{
+		"fail: bad compilation",
+		"log: hello",
+		`missing: jsonrpc: file "c.txt" not found (code -2)`,
+	}
+	if got := strings.Join(dc.diags, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("Diagnostics:\ngot  %q\nwant %q", dc.diags, want)
+	}
+}
+
+func TestAnalyzerExit(t *testing.T) {
+	// The test binary does not speak the protocol when not run as an analyzer,
+	// so the pending analysis fails, and the process is restarted for the next.
+	a := &Analyzer{Path: os.Args[0], Args: []string{"-test.run=NONE"}, Fetcher: fakeFetcher{}}
+	defer a.Close()
+	for i := 0; i < 2; i++ {
+		if err := a.Analyze(context.Background(), testRequest("x"), nil); err == nil {
+			t.Errorf("Analyze #%d: got success, want error", i+1)
+		}
+	}
+}
+
+func TestBatch(t *testing.T) {
+	var buf bytes.Buffer
+	p := &process{
+		a:      &Analyzer{Fetcher: fakeFetcher{"a": "apple", "z": "zebra"}},
+		stdin:  ioutil.NopCloser(nil),
+		w:      bufio.NewWriter(&buf),
+		tasks:  make(chan *task),
+		active: make(map[int64]*task),
+		exited: make(chan struct{}),
+	}
+	var outputs []string
+	p.active[5] = &task{
+		ctx:  context.Background(),
+		req:  testRequest("x", "a", "b"),
+		done: make(chan error, 1),
+		output: func(_ context.Context, out *apb.AnalysisOutput) error {
+			outputs = append(outputs, string(out.Value))
+			return nil
+		},
+	}
+
+	for _, frame := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"file","params":{"id":5,"digest":"a"}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"init","params":{"protocol":"kythe1"}}`,
+		`[{"jsonrpc":"2.0","id":3,"method":"file","params":{"id":5,"digest":"a"}},
+		  {"jsonrpc":"2.0","method":"out","params":{"id":5,"output":["YmVl"]}},
+		  {"jsonrpc":"2.0","id":"x","method":"file","params":{"id":5,"digest":"b"}}]`,
+		`{"jsonrpc":"2.0","id":6,"method":"file","params":{"id":5,"digest":"z"}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"file","params":{"id":5,"path":"b.txt","digest":"a"}}`,
+		`{"jsonrpc":"2.0","method":"done","params":{"id":5}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"file","params":{"id":5,"digest":"a"}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"nonesuch"}`,
+		`{"jsonrpc":`,
+	} {
+		p.handleFrame([]byte(frame))
+	}
+
+	var got []string
+	r := bufio.NewReader(&buf)
+	for {
+		frame, err := readFrame(r)
+		if err != nil {
+			break
+		}
+		got = append(got, string(frame))
+	}
+	want := []string{
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-1,"message":"method \"file\" called before init"}}`,
+		`{"jsonrpc":"2.0","id":2,"result":{"protocol":"kythe1"}}`,
+		`[{"jsonrpc":"2.0","id":3,"result":{"digest":"a","data":"YXBwbGU="}},` +
+			`{"jsonrpc":"2.0","id":"x","error":{"code":-2,"message":"file \"\" not found"}}]`,
+		`{"jsonrpc":"2.0","id":6,"error":{"code":-2,"message":"file \"\" (digest \"z\") is not an input of analysis 5"}}`,
+		`{"jsonrpc":"2.0","id":7,"error":{"code":-2,"message":"file \"b.txt\" (digest \"a\") is not an input of analysis 5"}}`,
+		`{"jsonrpc":"2.0","id":4,"error":{"code":-1,"message":"no analysis with ID 5"}}`,
+		`{"jsonrpc":"2.0","id":5,"error":{"code":-32601,"message":"unknown method \"nonesuch\""}}`,
+		`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"unexpected end of JSON input"}}`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Responses:\ngot  %s\nwant %s", strings.Join(got, "\n     "), strings.Join(want, "\n     "))
+	}
+	if got := strings.Join(outputs, " "); got != "bee" {
+		t.Errorf("Outputs: got %q, want %q", got, "bee")
+	}
+}
+
+func TestFrames(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	for _, body := range []string{"foobar", "", "{}"} {
+		if err := writeFrame(w, []byte(body)); err != nil {
+			t.Fatalf("writeFrame(%q) failed: %v", body, err)
+		}
+	}
+	if got, want := buf.String(), "6\nfoobar0\n2\n{}"; got != want {
+		t.Errorf("Frames: got %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		input, want string
+		err         error
+	}{
+		{"3\nabc", "abc", nil},
+		{"0\n", "", nil},
+		{"", "", io.EOF},
+		{"\nabc", "", errCorruptFrame},
+		{"3abc", "", errCorruptFrame},
+		{"-1\n", "", errCorruptFrame},
+		{" 3\nabc", "", errCorruptFrame},
+		{"5\nabc", "", errCorruptFrame},
+		{"03\nabc", "", errCorruptFrame},
+		{"00\n", "", errCorruptFrame},
+		{"10\n0123456789", "0123456789", nil},
+	}
+	for _, test := range tests {
+		got, err := readFrame(bufio.NewReader(strings.NewReader(test.input)))
+		if err != test.err || string(got) != test.want {
+			t.Errorf("readFrame(%q): got (%q, %v), want (%q, %v)", test.input, got, err, test.want, test.err)
+		}
+	}
+}
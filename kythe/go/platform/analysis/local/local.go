@@ -50,6 +50,10 @@ func (o *Options) revision() string {
 // A FileQueue is a driver.Queue reading each compilation from a sequence of
 // .kzip and .kindex files.  On each call to the driver.CompilationFunc, the
 // FileQueue's analysis.Fetcher interface exposes the current file's contents.
+//
+// The current file is closed by the next call to Next, so a FileQueue is not
+// a driver.ConcurrentQueue, and its compilations cannot be analyzed
+// concurrently.
 type FileQueue struct {
 	index    int                    // the next index to consume from paths
 	paths    []string               // the paths of kindex files to read
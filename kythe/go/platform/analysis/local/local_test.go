@@ -0,0 +1,125 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"kythe.io/kythe/go/platform/analysis"
+	"kythe.io/kythe/go/platform/analysis/driver"
+	"kythe.io/kythe/go/platform/kzip"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// writeKzip writes a kzip file at path containing one compilation for each of
+// the given files, which requires that file with the given contents.
+func writeKzip(t *testing.T, path string, files map[string]string) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Creating kzip: %v", err)
+	}
+	w, err := kzip.NewWriteCloser(f)
+	if err != nil {
+		t.Fatalf("Creating kzip writer: %v", err)
+	}
+	for name, text := range files {
+		digest, err := w.AddFile(strings.NewReader(text))
+		if err != nil {
+			t.Fatalf("Adding file %q: %v", name, err)
+		}
+		if _, err := w.AddUnit(&apb.CompilationUnit{
+			VName: &spb.VName{Signature: name},
+			RequiredInput: []*apb.CompilationUnit_FileInput{{
+				VName: &spb.VName{Path: name},
+				Info:  &apb.FileInfo{Path: name, Digest: digest},
+			}},
+		}, nil); err != nil {
+			t.Fatalf("Adding unit for %q: %v", name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Closing kzip: %v", err)
+	}
+}
+
+// fetchAll implements analysis.CompilationAnalyzer by fetching each required
+// input of a compilation from a fetcher and reporting its contents as output.
+type fetchAll struct{ f analysis.Fetcher }
+
+func (a fetchAll) Analyze(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+	for _, ri := range req.Compilation.RequiredInput {
+		data, err := a.f.Fetch(ri.Info.Path, ri.Info.Digest)
+		if err != nil {
+			return err
+		}
+		if err := out(ctx, &apb.AnalysisOutput{Value: data}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestFileQueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestFileQueue")
+	if err != nil {
+		t.Fatalf("Creating temp directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	paths := []string{filepath.Join(dir, "a.kzip"), filepath.Join(dir, "b.kzip")}
+	writeKzip(t, paths[0], map[string]string{"a1": "alpha", "a2": "apple"})
+	writeKzip(t, paths[1], map[string]string{"b1": "bravo"})
+
+	// Each compilation is analyzed while the contents of its archive are
+	// available from the queue.
+	q := NewFileQueue(paths, &Options{Revision: "r1"})
+	var outputs []string
+	d := &driver.Driver{
+		Analyzer: fetchAll{q},
+		WriteOutput: func(_ context.Context, out *apb.AnalysisOutput) error {
+			outputs = append(outputs, string(out.Value))
+			return nil
+		},
+	}
+	if err := d.Run(context.Background(), q); err != nil {
+		t.Fatalf("Driver error: %v", err)
+	}
+	got := strings.Join(outputs, " ")
+	for _, want := range []string{"alpha", "apple", "bravo"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Outputs: got %q, want %q", got, want)
+		}
+	}
+	if len(outputs) != 3 {
+		t.Errorf("Outputs: got %d, want 3", len(outputs))
+	}
+
+	// A FileQueue does not keep its archives open for concurrent analyses.
+	q = NewFileQueue(paths, nil)
+	d.Analyzer = fetchAll{q}
+	d.Parallelism = 2
+	if err := d.Run(context.Background(), q); err == nil {
+		t.Error("Driver with Parallelism 2 accepted a FileQueue")
+	}
+}
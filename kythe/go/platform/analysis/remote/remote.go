@@ -0,0 +1,172 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package remote implements the CompilationAnalyzer and FileDataService gRPC
+// services of kythe/proto/analysis_service.proto in terms of the interfaces of
+// the analysis package.
+//
+// NewAnalyzerServer and NewFileDataServer adapt an analysis.CompilationAnalyzer
+// and an analysis.Fetcher respectively to be served over gRPC, while Analyzer
+// and Fetcher implement those interfaces by calling a remote service.
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"kythe.io/kythe/go/platform/analysis"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	aspb "kythe.io/kythe/proto/analysis_service_go_proto"
+)
+
+// NewAnalyzerServer returns a CompilationAnalyzerServer that analyzes each
+// request using a, streaming its outputs back to the caller.
+func NewAnalyzerServer(a analysis.CompilationAnalyzer) aspb.CompilationAnalyzerServer {
+	return analyzerServer{a}
+}
+
+type analyzerServer struct{ analyzer analysis.CompilationAnalyzer }
+
+// Analyze implements the aspb.CompilationAnalyzerServer interface.
+func (s analyzerServer) Analyze(req *apb.AnalysisRequest, stream aspb.CompilationAnalyzer_AnalyzeServer) error {
+	if req.Compilation == nil {
+		return status.Error(codes.InvalidArgument, "missing compilation")
+	}
+	return s.analyzer.Analyze(stream.Context(), req, func(_ context.Context, out *apb.AnalysisOutput) error {
+		return stream.Send(out)
+	})
+}
+
+// Analyzer is an analysis.CompilationAnalyzer that sends each request to a
+// remote CompilationAnalyzer service.
+type Analyzer struct {
+	Client aspb.CompilationAnalyzerClient
+}
+
+// Analyze implements the analysis.CompilationAnalyzer interface. If the remote
+// analyzer reports a final result other than COMPLETE, Analyze returns an error
+// carrying its summary.
+func (a Analyzer) Analyze(ctx context.Context, req *apb.AnalysisRequest, f analysis.OutputFunc) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // abandon the stream if f fails
+
+	stream, err := a.Client.Analyze(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		out, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if res := out.FinalResult; res != nil {
+			if _, err := stream.Recv(); err == nil {
+				return errors.New("remote: analyzer sent output after its final result")
+			} else if err != io.EOF {
+				return err
+			}
+			if res.Status != apb.AnalysisResult_COMPLETE {
+				return fmt.Errorf("remote: analysis %v: %s", res.Status, res.Summary)
+			}
+			return nil
+		}
+		if err := f(ctx, out); err != nil {
+			return err
+		}
+	}
+}
+
+// NewFileDataServer returns a FileDataServiceServer that serves the files
+// fetched from f. Files that f fails to fetch are reported as missing.
+func NewFileDataServer(f analysis.Fetcher) aspb.FileDataServiceServer {
+	return fileDataServer{f}
+}
+
+type fileDataServer struct{ fetcher analysis.Fetcher }
+
+// Get implements the aspb.FileDataServiceServer interface.
+func (s fileDataServer) Get(req *apb.FilesRequest, stream aspb.FileDataService_GetServer) error {
+	for _, fi := range req.Files {
+		if fi.Path == "" && fi.Digest == "" {
+			return status.Error(codes.InvalidArgument, "missing path and digest")
+		}
+	}
+
+	type fileKey struct{ path, digest string }
+	seen := make(map[fileKey]bool)
+	for _, fi := range req.Files {
+		key := fileKey{fi.Path, fi.Digest}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		fd := &apb.FileData{Info: fi}
+		if data, err := s.fetcher.Fetch(fi.Path, fi.Digest); err != nil {
+			fd.Missing = true
+		} else {
+			fd.Content = data
+		}
+		if err := stream.Send(fd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Fetcher is an analysis.Fetcher that retrieves files from a remote
+// FileDataService.
+type Fetcher struct {
+	ctx    context.Context
+	client aspb.FileDataServiceClient
+}
+
+// NewFetcher returns a Fetcher that retrieves files using client. The given
+// context governs each of its calls to the service.
+func NewFetcher(ctx context.Context, client aspb.FileDataServiceClient) *Fetcher {
+	return &Fetcher{ctx: ctx, client: client}
+}
+
+// Fetch implements the analysis.Fetcher interface.
+func (f *Fetcher) Fetch(path, digest string) ([]byte, error) {
+	ctx, cancel := context.WithCancel(f.ctx)
+	defer cancel()
+
+	stream, err := f.client.Get(ctx, &apb.FilesRequest{
+		Files: []*apb.FileInfo{{Path: path, Digest: digest}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fd, err := stream.Recv()
+	if err == io.EOF {
+		return nil, errors.New("remote: no file data returned")
+	} else if err != nil {
+		return nil, err
+	} else if fd.Missing {
+		return nil, fmt.Errorf("remote: file not found (path %q, digest %q)", path, digest)
+	}
+	return fd.Content, nil
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"kythe.io/kythe/go/platform/analysis"
+
+	"google.golang.org/grpc"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	aspb "kythe.io/kythe/proto/analysis_service_go_proto"
+)
+
+// fakeFetcher serves files by digest from a map.
+type fakeFetcher map[string]string
+
+func (f fakeFetcher) Fetch(path, digest string) ([]byte, error) {
+	if data, ok := f[digest]; ok {
+		return []byte(data), nil
+	}
+	return nil, fmt.Errorf("file %q not found", path)
+}
+
+// fakeAnalyzer fetches each of the required inputs of a compilation from the
+// file data service named by the request, and emits its contents as output.
+// If result is set, it is sent as the final output.
+type fakeAnalyzer struct{ result *apb.AnalysisResult }
+
+func (a fakeAnalyzer) Analyze(ctx context.Context, req *apb.AnalysisRequest, f analysis.OutputFunc) error {
+	conn, err := grpc.Dial(req.FileDataService, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	fetcher := NewFetcher(ctx, aspb.NewFileDataServiceClient(conn))
+	for _, ri := range req.Compilation.RequiredInput {
+		data, err := fetcher.Fetch(ri.Info.Path, ri.Info.Digest)
+		if err != nil {
+			return err
+		}
+		if err := f(ctx, &apb.AnalysisOutput{Value: data}); err != nil {
+			return err
+		}
+	}
+	if a.result != nil {
+		return f(ctx, &apb.AnalysisOutput{FinalResult: a.result})
+	}
+	return nil
+}
+
+// serve starts a gRPC server with the given services registered, and returns
+// its address along with a function to stop it.
+func serve(t *testing.T, a analysis.CompilationAnalyzer, f analysis.Fetcher) (string, func()) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	s := grpc.NewServer()
+	if a != nil {
+		aspb.RegisterCompilationAnalyzerServer(s, NewAnalyzerServer(a))
+	}
+	if f != nil {
+		aspb.RegisterFileDataServiceServer(s, NewFileDataServer(f))
+	}
+	go s.Serve(lis)
+	return lis.Addr().String(), s.Stop
+}
+
+func dial(t *testing.T, addr string) *grpc.ClientConn {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	return conn
+}
+
+func testRequest(fds string, digests ...string) *apb.AnalysisRequest {
+	unit := new(apb.CompilationUnit)
+	for _, digest := range digests {
+		unit.RequiredInput = append(unit.RequiredInput, &apb.CompilationUnit_FileInput{
+			Info: &apb.FileInfo{Path: digest + ".txt", Digest: digest},
+		})
+	}
+	return &apb.AnalysisRequest{Compilation: unit, FileDataService: fds}
+}
+
+func TestAnalyze(t *testing.T) {
+	fds, stop := serve(t, nil, fakeFetcher{"a": "apple", "b": "banana"})
+	defer stop()
+	addr, stop := serve(t, fakeAnalyzer{}, nil)
+	defer stop()
+	conn := dial(t, addr)
+	defer conn.Close()
+	a := Analyzer{Client: aspb.NewCompilationAnalyzerClient(conn)}
+
+	var got []string
+	if err := a.Analyze(context.Background(), testRequest(fds, "b", "a", "b"), func(_ context.Context, out *apb.AnalysisOutput) error {
+		got = append(got, string(out.Value))
+		return nil
+	}); err != nil {
+		t.Fatalf("Analyze failed: %v", err)
+	}
+	if want := "banana apple banana"; strings.Join(got, " ") != want {
+		t.Errorf("Analyze outputs: got %q, want %q", got, want)
+	}
+
+	// A missing file causes the remote analysis to fail.
+	if err := a.Analyze(context.Background(), testRequest(fds, "a", "c"), func(context.Context, *apb.AnalysisOutput) error {
+		return nil
+	}); err == nil || !strings.Contains(err.Error(), "file not found") {
+		t.Errorf("Analyze with missing file: got error %v, want file not found", err)
+	}
+}
+
+func TestAnalyzeFinalResult(t *testing.T) {
+	fds, stop := serve(t, nil, fakeFetcher{"a": "apple"})
+	defer stop()
+	tests := []struct {
+		result *apb.AnalysisResult
+		ok     bool
+	}{
+		{nil, true},
+		{&apb.AnalysisResult{Status: apb.AnalysisResult_COMPLETE}, true},
+		{&apb.AnalysisResult{Status: apb.AnalysisResult_INCOMPLETE, Summary: "ran out of pie"}, false},
+	}
+	for _, test := range tests {
+		addr, stop := serve(t, fakeAnalyzer{test.result}, nil)
+		conn := dial(t, addr)
+		a := Analyzer{Client: aspb.NewCompilationAnalyzerClient(conn)}
+		var n int
+		err := a.Analyze(context.Background(), testRequest(fds, "a"), func(_ context.Context, out *apb.AnalysisOutput) error {
+			if out.FinalResult != nil {
+				t.Errorf("Unexpected final result passed to output function: %v", out)
+			}
+			n++
+			return nil
+		})
+		if ok := err == nil; ok != test.ok {
+			t.Errorf("Analyze with result %v: got error %v, want success %v", test.result, err, test.ok)
+		}
+		if n != 1 {
+			t.Errorf("Analyze with result %v: got %d outputs, want 1", test.result, n)
+		}
+		conn.Close()
+		stop()
+	}
+}
+
+func TestFileDataServer(t *testing.T) {
+	addr, stop := serve(t, nil, fakeFetcher{"a": "apple"})
+	defer stop()
+	conn := dial(t, addr)
+	defer conn.Close()
+	client := aspb.NewFileDataServiceClient(conn)
+	ctx := context.Background()
+
+	stream, err := client.Get(ctx, &apb.FilesRequest{Files: []*apb.FileInfo{
+		{Digest: "a"}, {Path: "b.txt", Digest: "b"}, {Digest: "a"},
+	}})
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	var got []string
+	for {
+		fd, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		got = append(got, fmt.Sprintf("%s=%q/%v", fd.Info.Digest, fd.Content, fd.Missing))
+	}
+	if want := `a="apple"/false b=""/true`; strings.Join(got, " ") != want {
+		t.Errorf("Get results: got %q, want %q", got, want)
+	}
+
+	// A request for a file without a path or digest is invalid.
+	stream, err = client.Get(ctx, &apb.FilesRequest{Files: []*apb.FileInfo{{}}})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if err == nil {
+		t.Error("Get with empty FileInfo: got success, want error")
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Binary claim_kzips assigns each file shared by the compilations stored in a
+// set of .kzip files to exactly one of them, and writes the assignments as a
+// delimited stream of kythe.proto.ClaimAssignment messages.
+//
+// An indexer given the assignments (e.g., go_indexer --claims) emits facts
+// about a shared file only when indexing the compilation that claims it, so
+// that they need not be deduplicated afterward.
+//
+// Example:
+//   claim_kzips --output claims.pb a.kzip b.kzip
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"kythe.io/kythe/go/platform/analysis/claim"
+	"kythe.io/kythe/go/platform/kzip"
+	"kythe.io/kythe/go/util/flagutil"
+	"kythe.io/kythe/go/util/kytheuri"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+)
+
+var output = flag.String("output", "", "Write assignments to this path instead of stdout")
+
+func init() {
+	flag.Usage = flagutil.SimpleUsage("Assign files shared by the compilations in .kzip files to one compilation each",
+		"[--output path] <kzip>...")
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flagutil.UsageError("no input .kzip files were specified")
+	}
+
+	var units []*apb.CompilationUnit
+	seen := make(map[string]bool) // compilation tickets
+	for _, path := range flag.Args() {
+		if err := scanUnits(path, func(unit *apb.CompilationUnit) {
+			if ticket := kytheuri.ToString(unit.VName); seen[ticket] {
+				log.Printf("WARNING: Compilation %s appears more than once; its copies share claims", ticket)
+			} else {
+				seen[ticket] = true
+			}
+			units = append(units, unit)
+		}); err != nil {
+			log.Fatalf("Reading %q: %v", path, err)
+		}
+	}
+	claims := claim.Assign(units)
+	log.Printf("Assigned %d shared files among %d compilations", len(claims), len(units))
+
+	out := os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatalf("Creating output: %v", err)
+		}
+		out = f
+	}
+	if err := claim.Write(out, claims); err != nil {
+		log.Fatalf("Writing assignments: %v", err)
+	} else if err := out.Close(); err != nil {
+		log.Fatalf("Closing output: %v", err)
+	}
+}
+
+// scanUnits calls f with each compilation stored in the .kzip file at path.
+func scanUnits(path string, f func(*apb.CompilationUnit)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return kzip.Scan(file, func(_ *kzip.Reader, unit *kzip.Unit) error {
+		f(unit.Proto)
+		return nil
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"os"
 	"strings"
 
+	"kythe.io/kythe/go/services/explore"
 	"kythe.io/kythe/go/services/filetree"
 	"kythe.io/kythe/go/services/graph"
 	"kythe.io/kythe/go/services/web"
@@ -58,6 +59,7 @@ type API struct {
 	GraphService      graph.Service
 	FileTreeService   filetree.Service
 	IdentifierService identifiers.Service
+	ExploreService    explore.Service
 }
 
 // Execute registers all Kythe CLI commands to subcommands.DefaultCommander and
@@ -81,6 +83,12 @@ func Execute(ctx context.Context, api API) subcommands.ExitStatus {
 	RegisterCommand(&sourceCommand{}, "xrefs")
 	RegisterCommand(&xrefsCommand{}, "xrefs")
 
+	RegisterCommand(&parentsCommand{}, "explore")
+	RegisterCommand(&childrenCommand{}, "explore")
+	RegisterCommand(&callersCommand{}, "explore")
+	RegisterCommand(&calleesCommand{}, "explore")
+	RegisterCommand(&hierarchyCommand{}, "explore")
+
 	return subcommands.Execute(ctx, api)
 }
 
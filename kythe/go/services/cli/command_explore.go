@@ -0,0 +1,220 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	epb "kythe.io/kythe/proto/explore_go_proto"
+)
+
+type parentsCommand struct{}
+
+func (parentsCommand) Name() string                { return "parents" }
+func (parentsCommand) Synopsis() string            { return "list the parents of the given nodes" }
+func (parentsCommand) Usage() string               { return "<ticket>..." }
+func (parentsCommand) SetFlags(flag *flag.FlagSet) {}
+func (c parentsCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("no tickets given")
+	}
+	req := &epb.ParentsRequest{Tickets: flag.Args()}
+	LogRequest(req)
+	reply, err := api.ExploreService.Parents(ctx, req)
+	if err != nil {
+		return err
+	}
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	}
+	return displayRelatives(reply.InputToParents)
+}
+
+type childrenCommand struct{}
+
+func (childrenCommand) Name() string                { return "children" }
+func (childrenCommand) Synopsis() string            { return "list the children of the given nodes" }
+func (childrenCommand) Usage() string               { return "<ticket>..." }
+func (childrenCommand) SetFlags(flag *flag.FlagSet) {}
+func (c childrenCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("no tickets given")
+	}
+	req := &epb.ChildrenRequest{Tickets: flag.Args()}
+	LogRequest(req)
+	reply, err := api.ExploreService.Children(ctx, req)
+	if err != nil {
+		return err
+	}
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	}
+	return displayRelatives(reply.InputToChildren)
+}
+
+func displayRelatives(relatives map[string]*epb.Tickets) error {
+	tickets := make([]string, 0, len(relatives))
+	for ticket := range relatives {
+		tickets = append(tickets, ticket)
+	}
+	sort.Strings(tickets)
+
+	for _, ticket := range tickets {
+		if _, err := fmt.Fprintln(out, ticket); err != nil {
+			return err
+		}
+		for _, rel := range relatives[ticket].Tickets {
+			if _, err := fmt.Fprintf(out, "  %s\n", rel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type callersCommand struct {
+	maxDepth, maxNodes int
+}
+
+func (callersCommand) Name() string     { return "callers" }
+func (callersCommand) Synopsis() string { return "display the callers of the given functions" }
+func (callersCommand) Usage() string    { return "<ticket>..." }
+func (c *callersCommand) SetFlags(flag *flag.FlagSet) {
+	flag.IntVar(&c.maxDepth, "depth", 1, "Maximum number of levels of callers to display")
+	flag.IntVar(&c.maxNodes, "max_nodes", 0, "Maximum number of functions to display (0 uses the server default)")
+}
+func (c callersCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("no tickets given")
+	}
+	req := &epb.CallersRequest{
+		Tickets:  flag.Args(),
+		MaxDepth: int32(c.maxDepth),
+		MaxNodes: int32(c.maxNodes),
+	}
+	LogRequest(req)
+	reply, err := api.ExploreService.Callers(ctx, req)
+	if err != nil {
+		return err
+	}
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	}
+	return displayGraph(reply.Graph, "calls")
+}
+
+type calleesCommand struct {
+	maxDepth, maxNodes int
+}
+
+func (calleesCommand) Name() string     { return "callees" }
+func (calleesCommand) Synopsis() string { return "display the callees of the given functions" }
+func (calleesCommand) Usage() string    { return "<ticket>..." }
+func (c *calleesCommand) SetFlags(flag *flag.FlagSet) {
+	flag.IntVar(&c.maxDepth, "depth", 1, "Maximum number of levels of callees to display")
+	flag.IntVar(&c.maxNodes, "max_nodes", 0, "Maximum number of functions to display (0 uses the server default)")
+}
+func (c calleesCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("no tickets given")
+	}
+	req := &epb.CalleesRequest{
+		Tickets:  flag.Args(),
+		MaxDepth: int32(c.maxDepth),
+		MaxNodes: int32(c.maxNodes),
+	}
+	LogRequest(req)
+	reply, err := api.ExploreService.Callees(ctx, req)
+	if err != nil {
+		return err
+	}
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	}
+	return displayGraph(reply.Graph, "calls")
+}
+
+type hierarchyCommand struct {
+	maxDepth, maxFanOut int
+	languages           string
+}
+
+func (hierarchyCommand) Name() string     { return "hierarchy" }
+func (hierarchyCommand) Synopsis() string { return "display the type hierarchy of the given type" }
+func (hierarchyCommand) Usage() string    { return "<ticket>" }
+func (c *hierarchyCommand) SetFlags(flag *flag.FlagSet) {
+	flag.IntVar(&c.maxDepth, "depth", 0, "Maximum number of levels of supertypes/subtypes to display (0 uses the server default)")
+	flag.IntVar(&c.maxFanOut, "max_fan_out", 0, "Maximum number of direct supertypes/subtypes to display per type (0 uses the server default)")
+	flag.StringVar(&c.languages, "languages", "", "Comma-separated list of languages with which to restrict the hierarchy")
+}
+func (c hierarchyCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("no ticket given")
+	} else if flag.NArg() > 1 {
+		return fmt.Errorf("only 1 ticket may be given; found: %v", flag.Args())
+	}
+	req := &epb.TypeHierarchyRequest{
+		TypeTicket: flag.Arg(0),
+		MaxDepth:   int32(c.maxDepth),
+		MaxFanOut:  int32(c.maxFanOut),
+	}
+	if c.languages != "" {
+		req.NodeFilter = &epb.NodeFilter{IncludedLanguages: strings.Split(c.languages, ",")}
+	}
+	LogRequest(req)
+	reply, err := api.ExploreService.TypeHierarchy(ctx, req)
+	if err != nil {
+		return err
+	}
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	}
+	return displayGraph(reply.Graph, "extends")
+}
+
+// displayGraph prints each edge in graph as "<source> <verb> <target>" along
+// with the nodes whose traversal was truncated.
+func displayGraph(graph *epb.Graph, verb string) error {
+	tickets := make([]string, 0, len(graph.GetNodes()))
+	for ticket := range graph.GetNodes() {
+		tickets = append(tickets, ticket)
+	}
+	sort.Strings(tickets)
+
+	for _, ticket := range tickets {
+		succs := append([]string(nil), graph.Nodes[ticket].Successors...)
+		sort.Strings(succs)
+		for _, succ := range succs {
+			if _, err := fmt.Fprintf(out, "%s %s %s\n", ticket, verb, succ); err != nil {
+				return err
+			}
+		}
+	}
+	for _, ticket := range tickets {
+		if graph.Nodes[ticket].Truncated {
+			if _, err := fmt.Fprintf(out, "%s (truncated)\n", ticket); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -21,13 +21,18 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"log"
 	"strings"
 
 	ipb "kythe.io/kythe/proto/identifier_go_proto"
 )
 
 type identCommand struct {
-	corpora, languages string
+	corpora, languages, kinds string
+
+	search    bool
+	pageToken string
+	pageSize  int
 }
 
 func (identCommand) Name() string     { return "identifier" }
@@ -36,6 +41,10 @@ func (identCommand) Usage() string    { return "<identifier>" }
 func (c *identCommand) SetFlags(flag *flag.FlagSet) {
 	flag.StringVar(&c.corpora, "corpora", "", "Comma-separated list of corpora with which to restrict matches")
 	flag.StringVar(&c.languages, "languages", "", "Comma-separated list of languages with which to restrict matches")
+	flag.StringVar(&c.kinds, "kinds", "", "Comma-separated list of node kinds with which to restrict matches")
+	flag.BoolVar(&c.search, "search", false, "Treat the identifier as a case-insensitive prefix or fuzzy search query and list ranked matches")
+	flag.StringVar(&c.pageToken, "page_token", "", "Search page token")
+	flag.IntVar(&c.pageSize, "page_size", 0, "Maximum number of search matches returned (0 lets the service use a sensible default)")
 }
 func (c identCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
 	if flag.NArg() == 0 {
@@ -46,6 +55,9 @@ func (c identCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) erro
 
 	req := &ipb.FindRequest{
 		Identifier: flag.Arg(0),
+		Search:     c.search,
+		PageToken:  c.pageToken,
+		PageSize:   int32(c.pageSize),
 	}
 	if c.corpora != "" {
 		req.Corpus = strings.Split(c.corpora, ",")
@@ -53,6 +65,9 @@ func (c identCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) erro
 	if c.languages != "" {
 		req.Languages = strings.Split(c.languages, ",")
 	}
+	if c.kinds != "" {
+		req.NodeKind = strings.Split(c.kinds, ",")
+	}
 
 	LogRequest(req)
 	reply, err := api.IdentifierService.Find(ctx, req)
@@ -60,6 +75,9 @@ func (c identCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) erro
 		return err
 	}
 
+	if reply.NextPageToken != "" {
+		defer log.Printf("Next page token: %s", reply.NextPageToken)
+	}
 	return c.displayMatches(reply)
 }
 
@@ -73,7 +91,11 @@ func (c identCommand) displayMatches(reply *ipb.FindReply) error {
 		if m.NodeSubkind != "" {
 			kind += "/" + m.NodeSubkind
 		}
-		fmt.Printf("%s [kind: %s]\n", m.Ticket, kind)
+		if c.search {
+			fmt.Printf("%s %s [kind: %s]\n", m.QualifiedName, m.Ticket, kind)
+		} else {
+			fmt.Printf("%s [kind: %s]\n", m.Ticket, kind)
+		}
 	}
 	return nil
 }
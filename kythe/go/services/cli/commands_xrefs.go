@@ -53,7 +53,7 @@ func (xrefsCommand) Usage() string    { return "" }
 func (c *xrefsCommand) SetFlags(flag *flag.FlagSet) {
 	flag.StringVar(&c.defKind, "definitions", "binding", "Kind of definitions to return (kinds: all, binding, full, or none)")
 	flag.StringVar(&c.declKind, "declarations", "all", "Kind of declarations to return (kinds: all or none)")
-	flag.StringVar(&c.refKind, "references", "noncall", "Kind of references to return (kinds: all, noncall, call, or none)")
+	flag.StringVar(&c.refKind, "references", "noncall", "Kind of references to return (kinds: all, noncall, call, writes, or none)")
 	flag.StringVar(&c.callerKind, "callers", "direct", "Kind of callers to return (kinds: direct, overrides, or none)")
 	flag.BoolVar(&c.relatedNodes, "related_nodes", true, "Whether to request related nodes")
 	flag.StringVar(&c.nodeFilters, "filters", "", "Comma-separated list of additional fact filters to use when requesting related nodes")
@@ -106,6 +106,8 @@ func (c xrefsCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) erro
 		req.ReferenceKind = xpb.CrossReferencesRequest_NON_CALL_REFERENCES
 	case "call":
 		req.ReferenceKind = xpb.CrossReferencesRequest_CALL_REFERENCES
+	case "writes":
+		req.ReferenceKind = xpb.CrossReferencesRequest_WRITE_REFERENCES
 	case "none":
 		req.ReferenceKind = xpb.CrossReferencesRequest_NO_REFERENCES
 	default:
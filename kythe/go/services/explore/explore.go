@@ -19,6 +19,12 @@ package explore
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"kythe.io/kythe/go/services/web"
 
 	epb "kythe.io/kythe/proto/explore_go_proto"
 )
@@ -27,7 +33,6 @@ import (
 type Service interface {
 	// Returns the hierarchy (supertypes and subtypes, including implementations)
 	// of a specified type, as a directed acyclic graph.
-	// NOT YET IMPLEMENTED
 	TypeHierarchy(context.Context, *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error)
 
 	// Returns the (recursive) callers of a specified function, as a directed
@@ -35,16 +40,13 @@ type Service interface {
 	// The Callers/Callees functions are distinct from XrefService.CrossReferences
 	// in that these functions capture the semantic relationships between methods,
 	// rather than the locations in the code base where a method is called.
-	// NOT YET IMPLEMENTED
 	Callers(context.Context, *epb.CallersRequest) (*epb.CallersReply, error)
 
 	// Returns the (recursive) callees of a specified function (that is, what
 	// functions this function calls), as a directed graph.
-	// NOT YET IMPLEMENTED
 	Callees(context.Context, *epb.CalleesRequest) (*epb.CalleesReply, error)
 
 	// Returns the parameters of a specified function.
-	// NOT YET IMPLEMENTED
 	Parameters(context.Context, *epb.ParametersRequest) (*epb.ParametersReply, error)
 
 	// Returns the parents of a specified node
@@ -64,3 +66,240 @@ type BoundedRequests struct {
 	MaxTickets int
 	Service
 }
+
+func (b BoundedRequests) checkTickets(tickets []string) error {
+	if len(tickets) > b.MaxTickets {
+		return fmt.Errorf("too many tickets requested: %d (max %d)", len(tickets), b.MaxTickets)
+	}
+	return nil
+}
+
+// Callers implements part of the Service interface.
+func (b BoundedRequests) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	if err := b.checkTickets(req.Tickets); err != nil {
+		return nil, err
+	}
+	return b.Service.Callers(ctx, req)
+}
+
+// Callees implements part of the Service interface.
+func (b BoundedRequests) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	if err := b.checkTickets(req.Tickets); err != nil {
+		return nil, err
+	}
+	return b.Service.Callees(ctx, req)
+}
+
+// Parameters implements part of the Service interface.
+func (b BoundedRequests) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	if err := b.checkTickets(req.FunctionTickets); err != nil {
+		return nil, err
+	}
+	return b.Service.Parameters(ctx, req)
+}
+
+// Parents implements part of the Service interface.
+func (b BoundedRequests) Parents(ctx context.Context, req *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	if err := b.checkTickets(req.Tickets); err != nil {
+		return nil, err
+	}
+	return b.Service.Parents(ctx, req)
+}
+
+// Children implements part of the Service interface.
+func (b BoundedRequests) Children(ctx context.Context, req *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	if err := b.checkTickets(req.Tickets); err != nil {
+		return nil, err
+	}
+	return b.Service.Children(ctx, req)
+}
+
+type webClient struct{ addr string }
+
+// TypeHierarchy implements part of the Service interface.
+func (w *webClient) TypeHierarchy(ctx context.Context, q *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
+	var reply epb.TypeHierarchyReply
+	return &reply, web.Call(w.addr, "typeHierarchy", q, &reply)
+}
+
+// Callers implements part of the Service interface.
+func (w *webClient) Callers(ctx context.Context, q *epb.CallersRequest) (*epb.CallersReply, error) {
+	var reply epb.CallersReply
+	return &reply, web.Call(w.addr, "callers", q, &reply)
+}
+
+// Callees implements part of the Service interface.
+func (w *webClient) Callees(ctx context.Context, q *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	var reply epb.CalleesReply
+	return &reply, web.Call(w.addr, "callees", q, &reply)
+}
+
+// Parameters implements part of the Service interface.
+func (w *webClient) Parameters(ctx context.Context, q *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	var reply epb.ParametersReply
+	return &reply, web.Call(w.addr, "parameters", q, &reply)
+}
+
+// Parents implements part of the Service interface.
+func (w *webClient) Parents(ctx context.Context, q *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	var reply epb.ParentsReply
+	return &reply, web.Call(w.addr, "parents", q, &reply)
+}
+
+// Children implements part of the Service interface.
+func (w *webClient) Children(ctx context.Context, q *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	var reply epb.ChildrenReply
+	return &reply, web.Call(w.addr, "children", q, &reply)
+}
+
+// WebClient returns an explore Service based on a remote web server.
+func WebClient(addr string) Service {
+	return &webClient{addr}
+}
+
+// RegisterHTTPHandlers registers JSON HTTP handlers with mux using the given
+// explore Service.  The following methods with be exposed:
+//
+//   GET /typeHierarchy
+//     Request: JSON encoded explore.TypeHierarchyRequest
+//     Response: JSON encoded explore.TypeHierarchyReply
+//   GET /callers
+//     Request: JSON encoded explore.CallersRequest
+//     Response: JSON encoded explore.CallersReply
+//   GET /callees
+//     Request: JSON encoded explore.CalleesRequest
+//     Response: JSON encoded explore.CalleesReply
+//   GET /parameters
+//     Request: JSON encoded explore.ParametersRequest
+//     Response: JSON encoded explore.ParametersReply
+//   GET /parents
+//     Request: JSON encoded explore.ParentsRequest
+//     Response: JSON encoded explore.ParentsReply
+//   GET /children
+//     Request: JSON encoded explore.ChildrenRequest
+//     Response: JSON encoded explore.ChildrenReply
+//
+// Note: each method will return its response as a serialized protobuf if the
+// "proto" query parameter is set.
+func RegisterHTTPHandlers(ctx context.Context, es Service, mux *http.ServeMux) {
+	mux.HandleFunc("/typeHierarchy", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("explore.TypeHierarchy:\t%s", time.Since(start))
+		}()
+
+		var req epb.TypeHierarchyRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reply, err := es.TypeHierarchy(ctx, &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+	mux.HandleFunc("/callers", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("explore.Callers:\t%s", time.Since(start))
+		}()
+
+		var req epb.CallersRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reply, err := es.Callers(ctx, &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+	mux.HandleFunc("/callees", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("explore.Callees:\t%s", time.Since(start))
+		}()
+
+		var req epb.CalleesRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reply, err := es.Callees(ctx, &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+	mux.HandleFunc("/parameters", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("explore.Parameters:\t%s", time.Since(start))
+		}()
+
+		var req epb.ParametersRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reply, err := es.Parameters(ctx, &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+	mux.HandleFunc("/parents", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("explore.Parents:\t%s", time.Since(start))
+		}()
+
+		var req epb.ParentsRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reply, err := es.Parents(ctx, &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+	mux.HandleFunc("/children", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("explore.Children:\t%s", time.Since(start))
+		}()
+
+		var req epb.ChildrenRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reply, err := es.Children(ctx, &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+}
@@ -123,18 +123,21 @@ func IsDeclKind(requestedKind xpb.CrossReferencesRequest_DeclarationKind, edgeKi
 }
 
 // IsRefKind determines whether the given edgeKind matches the requested
-// reference kind.
+// reference kind.  A ref/call/dynamic edge, which only records a possible
+// target of a call, matches no reference kind.
 func IsRefKind(requestedKind xpb.CrossReferencesRequest_ReferenceKind, edgeKind string) bool {
 	edgeKind = edges.Canonical(edgeKind)
 	switch requestedKind {
 	case xpb.CrossReferencesRequest_NO_REFERENCES:
 		return false
 	case xpb.CrossReferencesRequest_CALL_REFERENCES:
-		return edges.IsVariant(edgeKind, edges.RefCall)
+		return edges.IsCall(edgeKind)
 	case xpb.CrossReferencesRequest_NON_CALL_REFERENCES:
 		return !edges.IsVariant(edgeKind, edges.RefCall) && edges.IsVariant(edgeKind, edges.Ref)
 	case xpb.CrossReferencesRequest_ALL_REFERENCES:
-		return edges.IsVariant(edgeKind, edges.Ref)
+		return edges.IsVariant(edgeKind, edges.Ref) && !edges.IsVariant(edgeKind, edges.RefCallDynamic)
+	case xpb.CrossReferencesRequest_WRITE_REFERENCES:
+		return edges.IsVariant(edgeKind, edges.RefWrites)
 	default:
 		log.Printf("ERROR: unhandled CrossReferencesRequest_ReferenceKind: %v", requestedKind)
 		return false
@@ -143,10 +146,15 @@ func IsRefKind(requestedKind xpb.CrossReferencesRequest_ReferenceKind, edgeKind
 
 // Internal-only edge kinds for cross-references
 const (
-	internalKindPrefix         = "#internal/"
-	internalCallerKindDirect   = internalKindPrefix + "ref/call/direct"
-	internalCallerKindOverride = internalKindPrefix + "ref/call/override"
-	internalDeclarationKind    = internalKindPrefix + "ref/declare"
+	internalKindPrefix      = "#internal/"
+	internalDeclarationKind = internalKindPrefix + "ref/declare"
+
+	// InternalCallerKindDirect is the kind of a serving cross-references group
+	// of callers with direct callsites to a node.
+	InternalCallerKindDirect = internalKindPrefix + "ref/call/direct"
+	// InternalCallerKindOverride is the kind of a serving cross-references group
+	// of callers with callsites to a node that the node overrides.
+	InternalCallerKindOverride = internalKindPrefix + "ref/call/override"
 )
 
 // IsInternalKind determines whether the given edge kind is an internal variant.
@@ -168,9 +176,9 @@ func IsCallerKind(requestedKind xpb.CrossReferencesRequest_CallerKind, edgeKind
 	case xpb.CrossReferencesRequest_NO_CALLERS:
 		return false
 	case xpb.CrossReferencesRequest_DIRECT_CALLERS:
-		return edgeKind == internalCallerKindDirect
+		return edgeKind == InternalCallerKindDirect
 	case xpb.CrossReferencesRequest_OVERRIDE_CALLERS:
-		return edgeKind == internalCallerKindDirect || edgeKind == internalCallerKindOverride
+		return edgeKind == InternalCallerKindDirect || edgeKind == InternalCallerKindOverride
 	default:
 		log.Printf("ERROR: unhandled CrossReferencesRequest_CallerKind: %v", requestedKind)
 		return false
@@ -20,7 +20,10 @@ import (
 	"regexp"
 	"testing"
 
+	"kythe.io/kythe/go/util/schema/edges"
 	"kythe.io/kythe/go/util/schema/facts"
+
+	xpb "kythe.io/kythe/proto/xref_go_proto"
 )
 
 func TestFilterRegexp(t *testing.T) {
@@ -54,3 +57,28 @@ func TestFilterRegexp(t *testing.T) {
 		}
 	}
 }
+
+func TestIsRefKind(t *testing.T) {
+	tests := []struct {
+		kind          string
+		call, nonCall bool
+	}{
+		{edges.Ref, false, true},
+		{edges.RefWrites, false, true},
+		{edges.RefCall, true, false},
+		{edges.RefCallImplicit, true, false},
+		{edges.RefCallDynamic, false, false},
+		{edges.Mirror(edges.RefCallDynamic), false, false},
+	}
+	for _, test := range tests {
+		if got := IsRefKind(xpb.CrossReferencesRequest_CALL_REFERENCES, test.kind); got != test.call {
+			t.Errorf("IsRefKind(CALL_REFERENCES, %q): got %v, want %v", test.kind, got, test.call)
+		}
+		if got := IsRefKind(xpb.CrossReferencesRequest_NON_CALL_REFERENCES, test.kind); got != test.nonCall {
+			t.Errorf("IsRefKind(NON_CALL_REFERENCES, %q): got %v, want %v", test.kind, got, test.nonCall)
+		}
+		if got, want := IsRefKind(xpb.CrossReferencesRequest_ALL_REFERENCES, test.kind), test.call || test.nonCall; got != want {
+			t.Errorf("IsRefKind(ALL_REFERENCES, %q): got %v, want %v", test.kind, got, want)
+		}
+	}
+}
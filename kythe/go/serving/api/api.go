@@ -14,8 +14,8 @@
  * limitations under the License.
  */
 
-// Package api provides a union of the filetree, xrefs, and graph interfaces
-// and a command-line flag parser.
+// Package api provides a union of the filetree, xrefs, graph, identifiers, and
+// explore interfaces and a command-line flag parser.
 package api
 
 import (
@@ -25,9 +25,11 @@ import (
 	"os"
 	"strings"
 
+	"kythe.io/kythe/go/services/explore"
 	"kythe.io/kythe/go/services/filetree"
 	"kythe.io/kythe/go/services/graph"
 	"kythe.io/kythe/go/services/xrefs"
+	esrv "kythe.io/kythe/go/serving/explore"
 	ftsrv "kythe.io/kythe/go/serving/filetree"
 	gsrv "kythe.io/kythe/go/serving/graph"
 	"kythe.io/kythe/go/serving/identifiers"
@@ -35,18 +37,21 @@ import (
 	"kythe.io/kythe/go/storage/leveldb"
 	"kythe.io/kythe/go/storage/table"
 
+	epb "kythe.io/kythe/proto/explore_go_proto"
 	ftpb "kythe.io/kythe/proto/filetree_go_proto"
 	gpb "kythe.io/kythe/proto/graph_go_proto"
 	ipb "kythe.io/kythe/proto/identifier_go_proto"
 	xpb "kythe.io/kythe/proto/xref_go_proto"
 )
 
-// Interface is a union of the xrefs and filetree interfaces.
+// Interface is a union of the xrefs, graph, filetree, identifiers, and explore
+// interfaces.
 type Interface interface {
 	xrefs.Service
 	graph.Service
 	filetree.Service
 	identifiers.Service
+	explore.Service
 
 	// Close releases the underlying resources for the API.
 	Close(context.Context) error
@@ -82,6 +87,7 @@ func ParseSpec(apiSpec string) (Interface, error) {
 		api.gs = graph.WebClient(apiSpec)
 		api.ft = filetree.WebClient(apiSpec)
 		api.id = identifiers.WebClient(apiSpec)
+		api.es = explore.WebClient(apiSpec)
 	} else if _, err := os.Stat(apiSpec); err == nil {
 		db, err := leveldb.Open(apiSpec, nil)
 		if err != nil {
@@ -89,11 +95,13 @@ func ParseSpec(apiSpec string) (Interface, error) {
 		}
 		api.closer = func(ctx context.Context) error { return db.Close(ctx) }
 
-		api.xs = xsrv.NewService(context.Background(), db)
+		ctx := context.Background()
+		api.xs = xsrv.NewService(ctx, db)
+		api.gs = gsrv.NewService(ctx, db)
+		api.ft = ftsrv.NewService(ctx, db)
 		tbl := &table.KVProto{db}
-		api.gs = gsrv.NewCombinedTable(tbl)
-		api.ft = &ftsrv.Table{tbl, true}
-		api.id = &identifiers.Table{tbl}
+		api.id = &identifiers.Table{tbl, true}
+		api.es = esrv.NewCombinedTable(tbl)
 	} else {
 		return nil, fmt.Errorf("unknown API spec format: %q", apiSpec)
 	}
@@ -125,6 +133,7 @@ type apiCloser struct {
 	gs graph.Service
 	ft filetree.Service
 	id identifiers.Service
+	es explore.Service
 
 	closer func(context.Context) error
 }
@@ -176,3 +185,33 @@ func (api apiCloser) CorpusRoots(ctx context.Context, req *ftpb.CorpusRootsReque
 func (api apiCloser) Find(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
 	return api.id.Find(ctx, req)
 }
+
+// TypeHierarchy implements part of the explore Service interface.
+func (api apiCloser) TypeHierarchy(ctx context.Context, req *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
+	return api.es.TypeHierarchy(ctx, req)
+}
+
+// Callers implements part of the explore Service interface.
+func (api apiCloser) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	return api.es.Callers(ctx, req)
+}
+
+// Callees implements part of the explore Service interface.
+func (api apiCloser) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	return api.es.Callees(ctx, req)
+}
+
+// Parameters implements part of the explore Service interface.
+func (api apiCloser) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	return api.es.Parameters(ctx, req)
+}
+
+// Parents implements part of the explore Service interface.
+func (api apiCloser) Parents(ctx context.Context, req *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	return api.es.Parents(ctx, req)
+}
+
+// Children implements part of the explore Service interface.
+func (api apiCloser) Children(ctx context.Context, req *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	return api.es.Children(ctx, req)
+}
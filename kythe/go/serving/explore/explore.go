@@ -22,14 +22,27 @@
 //   <child ticket>    -> srvpb.Relatives (parents)
 //   <called ticket>   -> srvpb.Callgraph (callers)
 //   <calling ticket>  -> srvpb.Callgraph (callees)
+//   <type ticket>     -> srvpb.TypeHierarchy (supertypes and subtypes)
+//   <function ticket> -> srvpb.FunctionParameters
+//
+// In a combined table, each key is prefixed to distinguish the separate tables
+// (see the ParentsKey, ChildrenKey, CallersKey, CalleesKey, TypeHierarchyKey,
+// and ParametersKey functions).
 package explore
 
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/markedsource"
+	"kythe.io/kythe/go/util/schema/facts"
+
+	"github.com/golang/protobuf/proto"
 
+	cpb "kythe.io/kythe/proto/common_go_proto"
 	epb "kythe.io/kythe/proto/explore_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
 )
@@ -50,52 +63,254 @@ type Tables struct {
 	// FunctionToCallees is a table of srvpb.Callgraph keyed by function ticket
 	// that points to the callees of the specified function.
 	FunctionToCallees table.ProtoLookup
+
+	// TypeToHierarchy is a table of srvpb.TypeHierarchy keyed by type ticket
+	// that points to the direct supertypes and subtypes of the specified type.
+	TypeToHierarchy table.ProtoLookup
+
+	// FunctionToParameters is a table of srvpb.FunctionParameters keyed by
+	// function ticket.
+	FunctionToParameters table.ProtoLookup
+}
+
+// Key prefixes for the combined explore table.
+const (
+	parentsTablePrefix       = "parents:"
+	childrenTablePrefix      = "children:"
+	callersTablePrefix       = "callers:"
+	calleesTablePrefix       = "callees:"
+	typeHierarchyTablePrefix = "typeHierarchy:"
+	parametersTablePrefix    = "parameters:"
+)
+
+// NewCombinedTable returns a set of Tables for the given combined explore
+// lookup table.  The table's keys are expected to be constructed using only
+// the *Key functions in this package.
+func NewCombinedTable(t table.ProtoLookup) *Tables {
+	return &Tables{
+		ParentToChildren:     prefixedTable{childrenTablePrefix, t},
+		ChildToParents:       prefixedTable{parentsTablePrefix, t},
+		FunctionToCallers:    prefixedTable{callersTablePrefix, t},
+		FunctionToCallees:    prefixedTable{calleesTablePrefix, t},
+		TypeToHierarchy:      prefixedTable{typeHierarchyTablePrefix, t},
+		FunctionToParameters: prefixedTable{parametersTablePrefix, t},
+	}
 }
 
+// prefixedTable is a table.ProtoLookup that prepends a fixed prefix to each
+// key before it is looked up in the underlying table.
+type prefixedTable struct {
+	prefix string
+	table.ProtoLookup
+}
+
+// Lookup implements part of the table.ProtoLookup interface.
+func (p prefixedTable) Lookup(ctx context.Context, key []byte, msg proto.Message) error {
+	return p.ProtoLookup.Lookup(ctx, []byte(p.prefix+string(key)), msg)
+}
+
+// ParentsKey returns the parents combined table key for the given child
+// ticket.
+func ParentsKey(ticket string) []byte {
+	return []byte(parentsTablePrefix + ticket)
+}
+
+// ChildrenKey returns the children combined table key for the given parent
+// ticket.
+func ChildrenKey(ticket string) []byte {
+	return []byte(childrenTablePrefix + ticket)
+}
+
+// CallersKey returns the callers combined table key for the given function
+// ticket.
+func CallersKey(ticket string) []byte {
+	return []byte(callersTablePrefix + ticket)
+}
+
+// CalleesKey returns the callees combined table key for the given function
+// ticket.
+func CalleesKey(ticket string) []byte {
+	return []byte(calleesTablePrefix + ticket)
+}
+
+// TypeHierarchyKey returns the type hierarchy combined table key for the given
+// type ticket.
+func TypeHierarchyKey(ticket string) []byte {
+	return []byte(typeHierarchyTablePrefix + ticket)
+}
+
+// ParametersKey returns the parameters combined table key for the given
+// function ticket.
+func ParametersKey(ticket string) []byte {
+	return []byte(parametersTablePrefix + ticket)
+}
+
+// Traversal limits used when a TypeHierarchyRequest does not specify its own.
+const (
+	defaultMaxTypeHierarchyDepth  = 16
+	defaultMaxTypeHierarchyFanOut = 256
+)
+
 // TypeHierarchy returns the hierarchy (supertypes and subtypes, including implementations)
-// of a specified type, as a directed acyclic graph.
-// TODO: not yet implemented
+// of a specified type, as a directed acyclic graph.  Each edge in the graph
+// points from a subtype to one of its supertypes.
 func (t *Tables) TypeHierarchy(ctx context.Context, req *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
-	return nil, nil
-}
+	ticket := req.TypeTicket
+	if ticket == "" {
+		return nil, fmt.Errorf("missing input type ticket: %v", req)
+	}
 
-// Callers returns the callers of a specified function, as a directed graph.
-func (t *Tables) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
-	tickets := req.Tickets
-	if len(tickets) == 0 {
-		return nil, fmt.Errorf("missing input tickets: %v", req)
+	maxDepth := int(req.MaxDepth)
+	if maxDepth <= 0 {
+		maxDepth = defaultMaxTypeHierarchyDepth
+	}
+	maxFanOut := int(req.MaxFanOut)
+	if maxFanOut <= 0 {
+		maxFanOut = defaultMaxTypeHierarchyFanOut
 	}
 
-	// succMap maps nodes onto sets of successor nodes
-	succMap := make(map[string]map[string]bool)
+	w := &hierarchyWalker{
+		tables:    t,
+		filter:    req.NodeFilter,
+		maxDepth:  maxDepth,
+		maxFanOut: maxFanOut,
+		succMap:   make(map[string]map[string]bool),
+		truncated: make(map[string]bool),
+	}
+	// Supertypes and subtypes are walked separately so that the siblings of the
+	// requested type are not included in its hierarchy.
+	if err := w.walk(ctx, ticket, true); err != nil {
+		return nil, err
+	}
+	if err := w.walk(ctx, ticket, false); err != nil {
+		return nil, err
+	}
 
-	// At the moment, this is our policy for missing data: if an input ticket has
-	// no record in the table, we don't include data for that ticket in the response.
-	// Other table access errors result in returning an error.
-	for _, ticket := range tickets {
-		var callgraph srvpb.Callgraph
-		if err := t.FunctionToCallers.Lookup(ctx, []byte(ticket), &callgraph); err == table.ErrNoSuchKey {
-			continue // skip tickets with no mappings
-		} else if err != nil {
-			return nil, fmt.Errorf("error looking up callers with ticket %q: %v", ticket, err)
-		}
+	graph := convertSuccMapToGraph(w.succMap)
+	for node := range w.truncated {
+		getGraphNode(graph, node).Truncated = true
+	}
+	return &epb.TypeHierarchyReply{TypeTicket: ticket, Graph: graph}, nil
+}
+
+// hierarchyWalker accumulates the graph of a type hierarchy traversal.
+type hierarchyWalker struct {
+	tables *Tables
+	filter *epb.NodeFilter
+
+	maxDepth, maxFanOut int
 
-		// This can only happen in the context of a postprocessor bug.
-		if callgraph.Type != srvpb.Callgraph_CALLER {
-			return nil, fmt.Errorf("type of callgraph is not 'CALLER': %v", callgraph)
+	// succMap maps subtypes onto sets of their supertypes
+	succMap map[string]map[string]bool
+
+	// truncated is the set of nodes with relatives that were not followed
+	truncated map[string]bool
+}
+
+// walk performs a breadth-first traversal of the type hierarchy rooted at
+// root, following either supertype (if up is true) or subtype relationships.
+func (w *hierarchyWalker) walk(ctx context.Context, root string, up bool) error {
+	visited := map[string]bool{root: true}
+	frontier := []string{root}
+	for depth := 0; len(frontier) > 0; depth++ {
+		var next []string
+		for _, ticket := range frontier {
+			var th srvpb.TypeHierarchy
+			if err := w.tables.TypeToHierarchy.Lookup(ctx, []byte(ticket), &th); err == table.ErrNoSuchKey {
+				continue // skip tickets with no mappings
+			} else if err != nil {
+				return fmt.Errorf("error looking up type hierarchy with ticket %q: %v", ticket, err)
+			}
+
+			relatives := th.Subtypes
+			if up {
+				relatives = th.Supertypes
+			}
+
+			var followed int
+			for _, rel := range relatives {
+				if !matchesFilter(w.filter, rel) {
+					continue
+				} else if depth >= w.maxDepth || followed >= w.maxFanOut {
+					w.truncated[ticket] = true
+					break
+				}
+				followed++
+
+				if up {
+					addSuccessor(w.succMap, ticket, rel)
+				} else {
+					addSuccessor(w.succMap, rel, ticket)
+				}
+				if !visited[rel] {
+					visited[rel] = true
+					next = append(next, rel)
+				}
+			}
 		}
+		frontier = next
+	}
+	return nil
+}
 
-		// TODO(jrtom): consider logging a warning if len(callgraph.Tickets) == 0
-		// (postprocessing should disallow this)
-		for _, predTicket := range callgraph.Tickets {
-			if succMap[predTicket] == nil {
-				succMap[predTicket] = make(map[string]bool)
+func addSuccessor(succMap map[string]map[string]bool, ticket, succ string) {
+	if succMap[ticket] == nil {
+		succMap[ticket] = make(map[string]bool)
+	}
+	succMap[ticket][succ] = true
+}
+
+// matchesFilter reports whether the node with the given ticket is allowed by
+// f.  An unset NodeFilter allows every node.
+func matchesFilter(f *epb.NodeFilter, ticket string) bool {
+	langs, files := f.GetIncludedLanguages(), f.GetIncludedFiles()
+	if len(langs) == 0 && len(files) == 0 {
+		return true
+	}
+	uri, err := kytheuri.Parse(ticket)
+	if err != nil {
+		return false
+	}
+
+	if len(langs) > 0 {
+		var found bool
+		for _, lang := range langs {
+			if lang == uri.Language {
+				found = true
+				break
 			}
-			succMap[predTicket][ticket] = true
+		}
+		if !found {
+			return false
 		}
 	}
 
-	return &epb.CallersReply{Graph: convertSuccMapToGraph(succMap)}, nil
+	if len(files) == 0 {
+		return true
+	}
+	for _, file := range files {
+		if (file.Corpus == "" || file.Corpus == uri.Corpus) &&
+			(file.Root == "" || file.Root == uri.Root) &&
+			(file.Path == "" || file.Path == uri.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// Callers returns the (recursive) callers of a specified function, as a
+// directed graph.
+func (t *Tables) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	tickets := req.Tickets
+	if len(tickets) == 0 {
+		return nil, fmt.Errorf("missing input tickets: %v", req)
+	}
+
+	w := newCallgraphWalker(t.FunctionToCallers, srvpb.Callgraph_CALLER, req.MaxDepth, req.MaxNodes)
+	if err := w.walk(ctx, tickets); err != nil {
+		return nil, err
+	}
+	return &epb.CallersReply{Graph: w.graph()}, nil
 }
 
 func convertSuccMapToGraph(succMap map[string]map[string]bool) *epb.Graph {
@@ -124,7 +339,7 @@ func getGraphNode(graph *epb.Graph, ticket string) *epb.GraphNode {
 	return node
 }
 
-// Callees returns the callees of a specified function
+// Callees returns the (recursive) callees of a specified function
 // (that is, what functions this function calls), as a directed graph.
 func (t *Tables) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
 	tickets := req.Tickets
@@ -132,40 +347,219 @@ func (t *Tables) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.Cal
 		return nil, fmt.Errorf("missing input tickets: %v", req)
 	}
 
+	w := newCallgraphWalker(t.FunctionToCallees, srvpb.Callgraph_CALLEE, req.MaxDepth, req.MaxNodes)
+	if err := w.walk(ctx, tickets); err != nil {
+		return nil, err
+	}
+	return &epb.CalleesReply{Graph: w.graph()}, nil
+}
+
+// callgraphWalker accumulates the graph of a callers or callees traversal.
+type callgraphWalker struct {
+	callgraphs table.ProtoLookup
+	typ        srvpb.Callgraph_Type
+
+	maxDepth, maxNodes int
+
 	// succMap maps nodes onto sets of successor nodes
-	succMap := make(map[string]map[string]bool)
+	succMap map[string]map[string]bool
+
+	// visited is the set of nodes reached by the traversal
+	visited map[string]bool
 
-	// At the moment, this is our policy for missing data: if an input ticket has
-	// no record in the table, we don't include data for that ticket in the response.
+	// truncated is the set of nodes with callers/callees that were not followed
+	truncated map[string]bool
+}
+
+func newCallgraphWalker(callgraphs table.ProtoLookup, typ srvpb.Callgraph_Type, maxDepth, maxNodes int32) *callgraphWalker {
+	w := &callgraphWalker{
+		callgraphs: callgraphs,
+		typ:        typ,
+		maxDepth:   int(maxDepth),
+		maxNodes:   int(maxNodes),
+		succMap:    make(map[string]map[string]bool),
+		visited:    make(map[string]bool),
+		truncated:  make(map[string]bool),
+	}
+	if w.maxDepth <= 0 {
+		w.maxDepth = 1
+	}
+	if w.maxNodes <= 0 {
+		w.maxNodes = defaultMaxCallgraphNodes
+	}
+	return w
+}
+
+// defaultMaxCallgraphNodes is the node budget used when a CallersRequest or
+// CalleesRequest does not specify its own.
+const defaultMaxCallgraphNodes = 1024
+
+// walk performs a breadth-first traversal of the callgraph starting from each
+// of the given tickets.  Each node is visited at most once so that recursive
+// (and mutually recursive) functions do not cause cycles in the traversal.
+func (w *callgraphWalker) walk(ctx context.Context, tickets []string) error {
+	var frontier []string
+	for _, ticket := range tickets {
+		if !w.visited[ticket] {
+			w.visited[ticket] = true
+			frontier = append(frontier, ticket)
+		}
+	}
+
+	// At the moment, this is our policy for missing data: if a ticket has no
+	// record in the table, we don't include data for that ticket in the response.
 	// Other table access errors result in returning an error.
+	for depth := 0; len(frontier) > 0; depth++ {
+		var next []string
+		for _, ticket := range frontier {
+			var callgraph srvpb.Callgraph
+			if err := w.callgraphs.Lookup(ctx, []byte(ticket), &callgraph); err == table.ErrNoSuchKey {
+				continue // skip tickets with no mappings
+			} else if err != nil {
+				return fmt.Errorf("error looking up %s with ticket %q: %v", w.relation(), ticket, err)
+			}
+
+			// This can only happen in the context of a postprocessor bug.
+			if callgraph.Type != w.typ {
+				return fmt.Errorf("type of callgraph is not '%s': %v", w.typ, callgraph)
+			}
+
+			if depth >= w.maxDepth {
+				if len(callgraph.Tickets) != 0 {
+					w.truncated[ticket] = true
+				}
+				continue
+			}
+
+			// TODO(jrtom): consider logging a warning if len(callgraph.Tickets) == 0
+			// (postprocessing should disallow this)
+			if w.typ == srvpb.Callgraph_CALLEE && w.succMap[ticket] == nil {
+				w.succMap[ticket] = make(map[string]bool)
+			}
+			for _, rel := range callgraph.Tickets {
+				if !w.visited[rel] {
+					if len(w.visited) >= w.maxNodes {
+						w.truncated[ticket] = true
+						continue
+					}
+					w.visited[rel] = true
+					next = append(next, rel)
+				}
+
+				if w.typ == srvpb.Callgraph_CALLER {
+					addSuccessor(w.succMap, rel, ticket)
+				} else {
+					addSuccessor(w.succMap, ticket, rel)
+				}
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
+
+// relation returns a human-readable name of the relationship being walked.
+func (w *callgraphWalker) relation() string {
+	if w.typ == srvpb.Callgraph_CALLER {
+		return "callers"
+	}
+	return "callees"
+}
+
+// graph returns the traversed callgraph.
+func (w *callgraphWalker) graph() *epb.Graph {
+	graph := convertSuccMapToGraph(w.succMap)
+	for ticket := range w.truncated {
+		getGraphNode(graph, ticket).Truncated = true
+	}
+	return graph
+}
+
+// Parameters returns the parameters of a specified function.
+func (t *Tables) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	tickets := req.FunctionTickets
+	if len(tickets) == 0 {
+		return nil, fmt.Errorf("missing input tickets: %v", req)
+	}
+
+	reply := &epb.ParametersReply{
+		FunctionToParameters:  make(map[string]*epb.Tickets),
+		FunctionToReturnValue: make(map[string]string),
+		NodeData:              make(map[string]*epb.NodeData),
+	}
+
+	// At the moment, this is our policy for missing data: if a function (input)
+	// ticket has no record in the table, we don't include data for that ticket in
+	// the response.  Other table access errors result in returning an error.
 	for _, ticket := range tickets {
-		var callgraph srvpb.Callgraph
-		if err := t.FunctionToCallees.Lookup(ctx, []byte(ticket), &callgraph); err == table.ErrNoSuchKey {
+		var params srvpb.FunctionParameters
+		if err := t.FunctionToParameters.Lookup(ctx, []byte(ticket), &params); err == table.ErrNoSuchKey {
 			continue // skip tickets with no mappings
 		} else if err != nil {
-			return nil, fmt.Errorf("error looking up callees with ticket %q: %v", ticket, err)
+			return nil, fmt.Errorf("error looking up parameters with ticket %q: %v", ticket, err)
 		}
 
-		// This can only happen in the context of a postprocessor bug.
-		if callgraph.Type != srvpb.Callgraph_CALLEE {
-			return nil, fmt.Errorf("type of callgraph is not 'CALLEE': %v", callgraph)
+		fn, err := nodeData(params.Function)
+		if err != nil {
+			return nil, fmt.Errorf("invalid function node %q: %v", ticket, err)
+		}
+		reply.NodeData[ticket] = fn
+		if ret := returnValue(fn.Code); ret != "" {
+			reply.FunctionToReturnValue[ticket] = ret
 		}
 
-		// TODO(jrtom): consider logging a warning if len(callgraph.Tickets) == 0
-		// (postprocessing should disallow this)
-		succMap[ticket] = make(map[string]bool)
-		for _, succTicket := range callgraph.Tickets {
-			succMap[ticket][succTicket] = true
+		ps := &epb.Tickets{}
+		for _, p := range params.Parameters {
+			data, err := nodeData(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parameter node %q: %v", p.Ticket, err)
+			}
+			ps.Tickets = append(ps.Tickets, p.Ticket)
+			reply.NodeData[p.Ticket] = data
 		}
+		reply.FunctionToParameters[ticket] = ps
 	}
 
-	return &epb.CalleesReply{Graph: convertSuccMapToGraph(succMap)}, nil
+	return reply, nil
 }
 
-// Parameters returns the parameters of a specified function.
-// TODO: not yet implemented
-func (t *Tables) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
-	return nil, nil
+// nodeData returns the NodeData for the given node's kind, subkind, and code
+// facts.
+func nodeData(n *srvpb.Node) (*epb.NodeData, error) {
+	data := &epb.NodeData{}
+	for _, f := range n.GetFact() {
+		switch f.Name {
+		case facts.NodeKind:
+			data.Kind = string(f.Value)
+		case facts.Subkind:
+			data.Subkind = string(f.Value)
+		case facts.Code:
+			var ms cpb.MarkedSource
+			if err := proto.Unmarshal(f.Value, &ms); err != nil {
+				return nil, fmt.Errorf("error unmarshaling code fact: %v", err)
+			}
+			data.Code = &ms
+		}
+	}
+	return data, nil
+}
+
+// returnValue renders the return type of a function from its MarkedSource.  The
+// return type is expected to be the first TYPE node following the function's
+// parameters.  If no such node exists, "" is returned.
+func returnValue(ms *cpb.MarkedSource) string {
+	var seenParams bool
+	for _, child := range ms.GetChild() {
+		switch child.Kind {
+		case cpb.MarkedSource_PARAMETER, cpb.MarkedSource_PARAMETER_LOOKUP_BY_PARAM:
+			seenParams = true
+		case cpb.MarkedSource_TYPE:
+			if seenParams {
+				return strings.TrimSpace(markedsource.Render(child))
+			}
+		}
+	}
+	return ""
 }
 
 // Parents returns the parents of a specified node
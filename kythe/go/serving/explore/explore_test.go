@@ -23,10 +23,12 @@ import (
 
 	"kythe.io/kythe/go/storage/table"
 	"kythe.io/kythe/go/test/testutil"
+	"kythe.io/kythe/go/util/schema/facts"
 
 	"bitbucket.org/creachadair/stringset"
 	"github.com/golang/protobuf/proto"
 
+	cpb "kythe.io/kythe/proto/common_go_proto"
 	epb "kythe.io/kythe/proto/explore_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
 )
@@ -45,6 +47,18 @@ const (
 	f2r1     = "kythe:#f2caller1"
 	f3       = "kythe:#function3_recursive"
 	dne      = "kythe:#does_not_exist"
+
+	iface    = "kythe:?lang=go#iface"
+	base     = "kythe:?lang=go#base"
+	impl1    = "kythe:?lang=go#impl1"
+	impl2    = "kythe:?lang=go#impl2"
+	sub      = "kythe:?lang=go#sub"
+	javaImpl = "kythe:?lang=java#JavaImpl"
+
+	fn     = "kythe:#fn_with_params"
+	param0 = "kythe:#param0"
+	param1 = "kythe:#param1"
+	noArgs = "kythe:#fn_without_params"
 )
 
 var (
@@ -128,8 +142,95 @@ var (
 			Type:    srvpb.Callgraph_CALLER,
 		},
 	}
+
+	typeToHierarchy = &protoTable{
+		iface: &srvpb.TypeHierarchy{
+			Subtypes: []string{impl1, impl2, javaImpl},
+		},
+		base: &srvpb.TypeHierarchy{
+			Subtypes: []string{impl1},
+		},
+		impl1: &srvpb.TypeHierarchy{
+			Supertypes: []string{iface, base},
+			Subtypes:   []string{sub},
+		},
+		impl2: &srvpb.TypeHierarchy{
+			Supertypes: []string{iface},
+		},
+		sub: &srvpb.TypeHierarchy{
+			Supertypes: []string{impl1},
+		},
+		javaImpl: &srvpb.TypeHierarchy{
+			Supertypes: []string{iface},
+		},
+	}
+
+	fnCode = &cpb.MarkedSource{
+		Kind: cpb.MarkedSource_BOX,
+		Child: []*cpb.MarkedSource{{
+			PreText: "func ",
+		}, {
+			Kind:    cpb.MarkedSource_IDENTIFIER,
+			PreText: "fn",
+		}, {
+			Kind:          cpb.MarkedSource_PARAMETER_LOOKUP_BY_PARAM,
+			PreText:       "(",
+			PostChildText: ", ",
+			PostText:      ")",
+		}, {
+			Kind:    cpb.MarkedSource_TYPE,
+			PreText: " ",
+			Child:   []*cpb.MarkedSource{{PreText: "int"}},
+		}},
+	}
+	param0Code = &cpb.MarkedSource{
+		Kind:    cpb.MarkedSource_IDENTIFIER,
+		PreText: "x",
+	}
+
+	functionToParameters = &protoTable{
+		fn: &srvpb.FunctionParameters{
+			Function: &srvpb.Node{
+				Ticket: fn,
+				Fact: []*cpb.Fact{
+					{Name: facts.NodeKind, Value: []byte("function")},
+					{Name: facts.Code, Value: mustMarshal(fnCode)},
+				},
+			},
+			Parameters: []*srvpb.Node{{
+				Ticket: param0,
+				Fact: []*cpb.Fact{
+					{Name: facts.NodeKind, Value: []byte("variable")},
+					{Name: facts.Subkind, Value: []byte("local/parameter")},
+					{Name: facts.Code, Value: mustMarshal(param0Code)},
+				},
+			}, {
+				Ticket: param1,
+			}},
+		},
+		noArgs: &srvpb.FunctionParameters{
+			Function: &srvpb.Node{
+				Ticket: noArgs,
+				Fact:   []*cpb.Fact{{Name: facts.NodeKind, Value: []byte("function")}},
+			},
+		},
+		badType: &srvpb.FunctionParameters{
+			Function: &srvpb.Node{
+				Ticket: badType,
+				Fact:   []*cpb.Fact{{Name: facts.Code, Value: []byte("not a MarkedSource")}},
+			},
+		},
+	}
 )
 
+func mustMarshal(msg proto.Message) []byte {
+	rec, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return rec
+}
+
 func TestChildren_badData(t *testing.T) {
 	svc := construct(t)
 
@@ -407,6 +508,419 @@ func TestCallees_multipleInputs(t *testing.T) {
 	checkEqualGraphs(t, expectedGraph, reply.Graph)
 }
 
+func TestCallers_truncated(t *testing.T) {
+	svc := construct(t)
+	request := &epb.CallersRequest{
+		Tickets: []string{f1},
+	}
+
+	reply, err := svc.Callers(ctx, request)
+	testutil.FatalOnErrT(t, "Callers error: %v", err)
+
+	// fr has its own callers but only the direct callers of f1 were requested.
+	checkTruncated(t, reply.Graph, fr)
+}
+
+func TestCallers_recursive(t *testing.T) {
+	svc := construct(t)
+	request := &epb.CallersRequest{
+		Tickets:  []string{f1},
+		MaxDepth: 10,
+	}
+
+	reply, err := svc.Callers(ctx, request)
+	testutil.FatalOnErrT(t, "Callers error: %v", err)
+
+	// fr and f2 call each other; each is only visited once.
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			f1: {
+				Predecessors: []string{f1r1, fr},
+			},
+			f1r1: {
+				Successors: []string{f1},
+			},
+			fr: {
+				Predecessors: []string{f2},
+				Successors:   []string{f1, f2},
+			},
+			f2: {
+				Predecessors: []string{f2r1, fr},
+				Successors:   []string{fr},
+			},
+			f2r1: {
+				Successors: []string{f2},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph)
+}
+
+func TestCallers_maxNodes(t *testing.T) {
+	svc := construct(t)
+	request := &epb.CallersRequest{
+		Tickets:  []string{f1},
+		MaxDepth: 10,
+		MaxNodes: 3,
+	}
+
+	reply, err := svc.Callers(ctx, request)
+	testutil.FatalOnErrT(t, "Callers error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			f1: {
+				Predecessors: []string{f1r1, fr},
+			},
+			f1r1: {
+				Successors: []string{f1},
+			},
+			fr: {
+				Successors: []string{f1},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph, fr)
+}
+
+func TestCallees_recursive(t *testing.T) {
+	svc := construct(t)
+	request := &epb.CalleesRequest{
+		Tickets:  []string{f1},
+		MaxDepth: 10,
+	}
+
+	reply, err := svc.Callees(ctx, request)
+	testutil.FatalOnErrT(t, "Callees error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			f1: {
+				Successors: []string{f3},
+			},
+			f3: {
+				Predecessors: []string{f1, f3},
+				Successors:   []string{f3},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph)
+}
+
+func TestCallees_maxDepth(t *testing.T) {
+	svc := construct(t)
+	request := &epb.CalleesRequest{
+		Tickets:  []string{f2},
+		MaxDepth: 2,
+	}
+
+	reply, err := svc.Callees(ctx, request)
+	testutil.FatalOnErrT(t, "Callees error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			f2: {
+				Predecessors: []string{fr},
+				Successors:   []string{fr},
+			},
+			fr: {
+				Predecessors: []string{f2},
+				Successors:   []string{f1, f2, f3},
+			},
+			f1: {
+				Predecessors: []string{fr},
+			},
+			f3: {
+				Predecessors: []string{fr},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph, f1, f3)
+}
+
+func TestParameters_missingTicket(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.Parameters(ctx, &epb.ParametersRequest{})
+	if err == nil {
+		t.Errorf("Expected Parameters error for missing tickets, got: %v", reply)
+	}
+}
+
+func TestParameters_badData(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.Parameters(ctx, &epb.ParametersRequest{
+		FunctionTickets: []string{badType},
+	})
+	if err == nil {
+		t.Errorf("Expected Parameters error for bad data, got: %v", reply)
+	}
+}
+
+func TestParameters_noData(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.Parameters(ctx, &epb.ParametersRequest{
+		FunctionTickets: []string{dne},
+	})
+	testutil.FatalOnErrT(t, "ParametersRequest error: %v", err)
+	if len(reply.FunctionToParameters) != 0 || len(reply.NodeData) != 0 {
+		t.Errorf("Expected empty response for missing key, got: %v", reply)
+	}
+}
+
+func TestParameters(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.Parameters(ctx, &epb.ParametersRequest{
+		FunctionTickets: []string{fn, noArgs},
+	})
+	testutil.FatalOnErrT(t, "Parameters error: %v", err)
+
+	expected := &epb.ParametersReply{
+		FunctionToParameters: map[string]*epb.Tickets{
+			fn:     {Tickets: []string{param0, param1}},
+			noArgs: {},
+		},
+		FunctionToReturnValue: map[string]string{
+			fn: "int",
+		},
+		NodeData: map[string]*epb.NodeData{
+			fn:     {Kind: "function", Code: fnCode},
+			noArgs: {Kind: "function"},
+			param0: {Kind: "variable", Subkind: "local/parameter", Code: param0Code},
+			param1: {},
+		},
+	}
+	if !proto.Equal(expected, reply) {
+		t.Errorf("Expected Parameters reply:\n%v\nfound:\n%v", expected, reply)
+	}
+}
+
+func TestNewCombinedTable(t *testing.T) {
+	svc := NewCombinedTable(&protoTable{
+		string(ChildrenKey(p1)): &srvpb.Relatives{
+			Tickets: []string{p1c1},
+			Type:    srvpb.Relatives_CHILDREN,
+		},
+		string(ParentsKey(p1c1)): &srvpb.Relatives{
+			Tickets: []string{p1},
+			Type:    srvpb.Relatives_PARENTS,
+		},
+		string(CallersKey(f1)): &srvpb.Callgraph{
+			Tickets: []string{fr},
+			Type:    srvpb.Callgraph_CALLER,
+		},
+		string(CalleesKey(fr)): &srvpb.Callgraph{
+			Tickets: []string{f1},
+			Type:    srvpb.Callgraph_CALLEE,
+		},
+		string(TypeHierarchyKey(impl2)): &srvpb.TypeHierarchy{
+			Supertypes: []string{iface},
+		},
+		string(ParametersKey(noArgs)): &srvpb.FunctionParameters{
+			Function: &srvpb.Node{Ticket: noArgs},
+		},
+	})
+
+	children, err := svc.Children(ctx, &epb.ChildrenRequest{Tickets: []string{p1}})
+	testutil.FatalOnErrT(t, "Children error: %v", err)
+	checkEquivalentLists(t, []string{p1c1}, children.InputToChildren[p1].GetTickets(), "children")
+
+	parents, err := svc.Parents(ctx, &epb.ParentsRequest{Tickets: []string{p1c1}})
+	testutil.FatalOnErrT(t, "Parents error: %v", err)
+	checkEquivalentLists(t, []string{p1}, parents.InputToParents[p1c1].GetTickets(), "parents")
+
+	callers, err := svc.Callers(ctx, &epb.CallersRequest{Tickets: []string{f1}})
+	testutil.FatalOnErrT(t, "Callers error: %v", err)
+	checkEquivalentLists(t, []string{fr}, callers.Graph.Nodes[f1].GetPredecessors(), "callers")
+
+	callees, err := svc.Callees(ctx, &epb.CalleesRequest{Tickets: []string{fr}})
+	testutil.FatalOnErrT(t, "Callees error: %v", err)
+	checkEquivalentLists(t, []string{f1}, callees.Graph.Nodes[fr].GetSuccessors(), "callees")
+
+	hierarchy, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{TypeTicket: impl2})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+	checkEquivalentLists(t, []string{iface}, hierarchy.Graph.Nodes[impl2].GetSuccessors(), "supertypes")
+
+	params, err := svc.Parameters(ctx, &epb.ParametersRequest{FunctionTickets: []string{noArgs}})
+	testutil.FatalOnErrT(t, "Parameters error: %v", err)
+	if _, ok := params.FunctionToParameters[noArgs]; !ok {
+		t.Errorf("Missing parameters for %q: %v", noArgs, params)
+	}
+}
+
+func TestTypeHierarchy_missingTicket(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{})
+	if err == nil {
+		t.Errorf("Expected TypeHierarchy error for missing ticket, got: %v", reply)
+	}
+}
+
+func TestTypeHierarchy_noData(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+		TypeTicket: dne,
+	})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+	if len(reply.Graph.Nodes) != 0 {
+		t.Errorf("Expected empty response for missing key, got: %v", reply)
+	}
+}
+
+func TestTypeHierarchy(t *testing.T) {
+	svc := construct(t)
+	request := &epb.TypeHierarchyRequest{
+		TypeTicket: impl1,
+	}
+
+	reply, err := svc.TypeHierarchy(ctx, request)
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	if reply.TypeTicket != impl1 {
+		t.Errorf("Expected type ticket %q, got %q", impl1, reply.TypeTicket)
+	}
+
+	// The sibling impl2 is not in the reply because it is neither a supertype
+	// nor a subtype of impl1.
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			impl1: {
+				Predecessors: []string{sub},
+				Successors:   []string{iface, base},
+			},
+			iface: {
+				Predecessors: []string{impl1},
+			},
+			base: {
+				Predecessors: []string{impl1},
+			},
+			sub: {
+				Successors: []string{impl1},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph)
+}
+
+func TestTypeHierarchy_maxDepth(t *testing.T) {
+	svc := construct(t)
+	request := &epb.TypeHierarchyRequest{
+		TypeTicket: iface,
+		MaxDepth:   1,
+	}
+
+	reply, err := svc.TypeHierarchy(ctx, request)
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			iface: {
+				Predecessors: []string{impl1, impl2, javaImpl},
+			},
+			impl1: {
+				Successors: []string{iface},
+			},
+			impl2: {
+				Successors: []string{iface},
+			},
+			javaImpl: {
+				Successors: []string{iface},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph, impl1)
+}
+
+func TestTypeHierarchy_maxFanOut(t *testing.T) {
+	svc := construct(t)
+	request := &epb.TypeHierarchyRequest{
+		TypeTicket: impl1,
+		MaxFanOut:  1,
+	}
+
+	reply, err := svc.TypeHierarchy(ctx, request)
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			impl1: {
+				Predecessors: []string{sub},
+				Successors:   []string{iface},
+			},
+			iface: {
+				Predecessors: []string{impl1},
+			},
+			sub: {
+				Successors: []string{impl1},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph, impl1)
+}
+
+func TestTypeHierarchy_languageFilter(t *testing.T) {
+	svc := construct(t)
+	request := &epb.TypeHierarchyRequest{
+		TypeTicket: iface,
+		NodeFilter: &epb.NodeFilter{IncludedLanguages: []string{"go"}},
+	}
+
+	reply, err := svc.TypeHierarchy(ctx, request)
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			iface: {
+				Predecessors: []string{impl1, impl2},
+			},
+			impl1: {
+				Predecessors: []string{sub},
+				Successors:   []string{iface},
+			},
+			impl2: {
+				Successors: []string{iface},
+			},
+			sub: {
+				Successors: []string{impl1},
+			},
+		},
+	}
+
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+	checkTruncated(t, reply.Graph)
+}
+
+// checkTruncated checks that exactly the given tickets are marked as
+// truncated in the graph.
+func checkTruncated(t *testing.T, graph *epb.Graph, expected ...string) {
+	var actual []string
+	for ticket, node := range graph.Nodes {
+		if node.Truncated {
+			actual = append(actual, ticket)
+		}
+	}
+	checkEquivalentLists(t, expected, actual, "truncated nodes")
+}
+
 func checkEqualGraphs(t *testing.T, expected, actual *epb.Graph) {
 	if len(expected.Nodes) != len(actual.Nodes) {
 		t.Errorf("Mismatch in graph node counts: expected: %d, actual: %d",
@@ -459,5 +973,8 @@ func construct(t *testing.T) *Tables {
 		ChildToParents:    childToParents,
 		FunctionToCallers: functionToCallers,
 		FunctionToCallees: functionToCallees,
+		TypeToHierarchy:   typeToHierarchy,
+
+		FunctionToParameters: functionToParameters,
 	}
 }
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filetree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"kythe.io/kythe/go/services/filetree"
+	"kythe.io/kythe/go/serving/xrefs/columnar"
+	"kythe.io/kythe/go/storage/keyvalue"
+	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/keys"
+	"kythe.io/kythe/go/util/kytheuri"
+
+	"github.com/golang/protobuf/proto"
+
+	ftpb "kythe.io/kythe/proto/filetree_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	xspb "kythe.io/kythe/proto/xref_serving_go_proto"
+)
+
+// NewService returns a filetree.Service backed by the given table.  The format
+// of the table with be automatically detected.
+func NewService(ctx context.Context, t keyvalue.DB) filetree.Service {
+	_, err := t.Get(ctx, []byte(columnar.TableKeyMarker), nil)
+	if err == nil {
+		log.Println("WARNING: detected a experimental columnar filetree table")
+		return NewColumnarTable(t)
+	}
+	return &Table{Proto: &table.KVProto{t}, PrefixedKeys: true}
+}
+
+// NewColumnarTable returns a table for the given columnar filetree lookup
+// table.
+func NewColumnarTable(t keyvalue.DB) *ColumnarTable { return &ColumnarTable{t} }
+
+// ColumnarTable implements the FileTree interface using a columnar serving
+// table.
+type ColumnarTable struct{ keyvalue.DB }
+
+// Directory implements part of the filetree Service interface.
+func (c *ColumnarTable) Directory(ctx context.Context, req *ftpb.DirectoryRequest) (*ftpb.DirectoryReply, error) {
+	dir := columnar.DirectoryVName(req.Corpus, req.Root, req.Path)
+	prefix, err := keys.Append(columnar.DirectoryKeyPrefix, dir)
+	if err != nil {
+		return nil, err
+	}
+	it, err := c.ScanPrefix(ctx, prefix, &keyvalue.Options{LargeRead: true})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	reply := &ftpb.DirectoryReply{}
+	for {
+		k, val, err := it.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("lookup error: %v", err)
+		}
+
+		e, err := columnar.DecodeDirectoryEntry(dir, string(k[len(prefix):]), val)
+		if err != nil {
+			return nil, err
+		}
+		switch e := e.Entry.(type) {
+		case *xspb.FileDirectory_Subdirectory_:
+			reply.Subdirectory = append(reply.Subdirectory, kytheuri.ToString(e.Subdirectory.Subdirectory))
+		case *xspb.FileDirectory_File_:
+			reply.File = append(reply.File, kytheuri.ToString(e.File.File))
+		}
+	}
+	return reply, nil
+}
+
+// CorpusRoots implements part of the filetree Service interface.
+func (c *ColumnarTable) CorpusRoots(ctx context.Context, req *ftpb.CorpusRootsRequest) (*ftpb.CorpusRootsReply, error) {
+	val, err := c.Get(ctx, columnar.CorpusRootsKey, nil)
+	if err == io.EOF {
+		return nil, errors.New("internal error: missing corpusRoots in table")
+	} else if err != nil {
+		return nil, fmt.Errorf("corpusRoots lookup error: %v", err)
+	}
+	var cr srvpb.CorpusRoots
+	if err := proto.Unmarshal(val, &cr); err != nil {
+		return nil, fmt.Errorf("corpusRoots decoding error: %v", err)
+	}
+
+	reply := &ftpb.CorpusRootsReply{
+		Corpus: make([]*ftpb.CorpusRootsReply_Corpus, len(cr.Corpus)),
+	}
+	for i, corpus := range cr.Corpus {
+		reply.Corpus[i] = &ftpb.CorpusRootsReply_Corpus{
+			Name: corpus.Corpus,
+			Root: corpus.Root,
+		}
+	}
+	return reply, nil
+}
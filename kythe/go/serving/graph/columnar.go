@@ -0,0 +1,178 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graph
+
+import (
+	"context"
+	"io"
+	"log"
+	"sort"
+
+	"kythe.io/kythe/go/services/graph"
+	"kythe.io/kythe/go/serving/xrefs/columnar"
+	"kythe.io/kythe/go/storage/keyvalue"
+	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/compare"
+	"kythe.io/kythe/go/util/keys"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema"
+	"kythe.io/kythe/go/util/schema/facts"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	scpb "kythe.io/kythe/proto/schema_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	xspb "kythe.io/kythe/proto/xref_serving_go_proto"
+)
+
+// NewService returns a graph.Service backed by the given table.  The format of
+// the table with be automatically detected.
+func NewService(ctx context.Context, t keyvalue.DB) graph.Service {
+	_, err := t.Get(ctx, []byte(columnar.TableKeyMarker), nil)
+	if err == nil {
+		log.Println("WARNING: detected a experimental columnar graph table")
+		return NewColumnarTable(t)
+	}
+	return NewCombinedTable(&table.KVProto{t})
+}
+
+// NewColumnarTable returns a table for the given columnar graph lookup table.
+func NewColumnarTable(t keyvalue.DB) *Table { return &Table{&columnarTable{t}} }
+
+// columnarTable implements staticLookupTables by assembling each
+// srvpb.PagedEdgeSet from a node's columnar Edges entries.  Columnar edge sets
+// are never split into separate pages.
+type columnarTable struct{ keyvalue.DB }
+
+func (c *columnarTable) pagedEdgeSets(ctx context.Context, tickets []string) (<-chan edgeSetResult, error) {
+	tracePrintf(ctx, "Reading columnar Edges: %s", tickets)
+	ch := make(chan edgeSetResult)
+	go func() {
+		defer close(ch)
+		for _, ticket := range tickets {
+			pes, err := c.pagedEdgeSet(ctx, ticket)
+			if err == table.ErrNoSuchKey {
+				log.Printf("Could not locate edges for %q", ticket)
+			}
+			ch <- edgeSetResult{PagedEdgeSet: pes, Err: err}
+		}
+	}()
+	return ch, nil
+}
+
+func (c *columnarTable) edgePage(ctx context.Context, key string) (*srvpb.EdgePage, error) {
+	return nil, table.ErrNoSuchKey
+}
+
+func (c *columnarTable) pagedEdgeSet(ctx context.Context, ticket string) (*srvpb.PagedEdgeSet, error) {
+	src, err := kytheuri.ToVName(ticket)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := keys.Append(columnar.EdgesKeyPrefix, src)
+	if err != nil {
+		return nil, err
+	}
+	it, err := c.ScanPrefix(ctx, prefix, &keyvalue.Options{LargeRead: true})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	set := &srvpb.PagedEdgeSet{Source: &srvpb.Node{Ticket: ticket}}
+	groups := make(map[string]*srvpb.EdgeGroup)
+	targets := make(map[string]*srvpb.Node)
+	var found bool
+	for {
+		k, val, err := it.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		found = true
+
+		e, err := columnar.DecodeEdgesEntry(src, string(k[len(prefix):]), val)
+		if err != nil {
+			return nil, err
+		}
+		switch e := e.Entry.(type) {
+		case *xspb.Edges_Index_:
+			set.Source = columnarNode(ticket, e.Index.Node)
+		case *xspb.Edges_Edge_:
+			kind := columnar.EdgeKindString(e.Edge)
+			g, ok := groups[kind]
+			if !ok {
+				g = &srvpb.EdgeGroup{Kind: kind}
+				groups[kind] = g
+				set.Group = append(set.Group, g)
+			}
+			target := kytheuri.ToString(e.Edge.Target)
+			n, ok := targets[target]
+			if !ok {
+				n = &srvpb.Node{Ticket: target}
+				targets[target] = n
+			}
+			g.Edge = append(g.Edge, &srvpb.EdgeGroup_Edge{
+				Target:  n,
+				Ordinal: e.Edge.Ordinal,
+			})
+		case *xspb.Edges_Target_:
+			// Target entries follow all Edge entries.
+			target := kytheuri.ToString(e.Target.Node.GetSource())
+			if n, ok := targets[target]; ok {
+				n.Fact = columnarNode(target, e.Target.Node).Fact
+			}
+		}
+	}
+	if !found {
+		return nil, table.ErrNoSuchKey
+	}
+
+	sort.Slice(set.Group, func(i, j int) bool { return set.Group[i].Kind < set.Group[j].Kind })
+	for _, g := range set.Group {
+		sort.Slice(g.Edge, func(i, j int) bool {
+			return compare.Compare(g.Edge[i].Ordinal, g.Edge[j].Ordinal).
+				AndThen(g.Edge[i].Target.Ticket, g.Edge[j].Target.Ticket) == compare.LT
+		})
+	}
+	return set, nil
+}
+
+// columnarNode converts the given columnar *scpb.Node to a *srvpb.Node.
+func columnarNode(ticket string, node *scpb.Node) *srvpb.Node {
+	n := &srvpb.Node{Ticket: ticket}
+	if kind := schema.GetNodeKind(node); kind != "" {
+		n.Fact = append(n.Fact, &cpb.Fact{
+			Name:  facts.NodeKind,
+			Value: []byte(kind),
+		})
+	}
+	if subkind := schema.GetSubkind(node); subkind != "" {
+		n.Fact = append(n.Fact, &cpb.Fact{
+			Name:  facts.Subkind,
+			Value: []byte(subkind),
+		})
+	}
+	for _, f := range node.GetFact() {
+		n.Fact = append(n.Fact, &cpb.Fact{
+			Name:  schema.GetFactName(f),
+			Value: f.Value,
+		})
+	}
+	sort.Slice(n.Fact, func(i, j int) bool { return n.Fact[i].Name < n.Fact[j].Name })
+	return n
+}
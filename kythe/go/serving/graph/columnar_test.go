@@ -0,0 +1,192 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graph
+
+import (
+	"testing"
+
+	"kythe.io/kythe/go/serving/xrefs/columnar"
+	"kythe.io/kythe/go/storage/inmemory"
+	"kythe.io/kythe/go/storage/keyvalue"
+	"kythe.io/kythe/go/test/testutil"
+	"kythe.io/kythe/go/util/kytheuri"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	gpb "kythe.io/kythe/proto/graph_go_proto"
+	scpb "kythe.io/kythe/proto/schema_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+	xspb "kythe.io/kythe/proto/xref_serving_go_proto"
+)
+
+func mustWriteEdges(t *testing.T, w keyvalue.Writer, e *xspb.Edges) {
+	kv, err := columnar.EncodeEdgesEntry(columnar.EdgesKeyPrefix, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(kv.Key, kv.Value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestColumnarEdges(t *testing.T) {
+	db := inmemory.NewKeyValueDB()
+	w, err := db.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]byte(columnar.TableKeyMarker), []byte{}); err != nil {
+		t.Fatal(err)
+	}
+
+	src := &spb.VName{Language: "go", Signature: "func"}
+	param0 := &spb.VName{Language: "go", Signature: "param0"}
+	param1 := &spb.VName{Language: "go", Signature: "param1"}
+	caller := &spb.VName{Language: "go", Signature: "caller"}
+	entries := []*xspb.Edges{{
+		Source: src,
+		Entry: &xspb.Edges_Index_{&xspb.Edges_Index{Node: &scpb.Node{
+			Source: src,
+			Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+		}}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Edge_{&xspb.Edges_Edge{
+			Target:  param1,
+			Kind:    &xspb.Edges_Edge_KytheKind{scpb.EdgeKind_PARAM},
+			Ordinal: 1,
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Edge_{&xspb.Edges_Edge{
+			Target: param0,
+			Kind:   &xspb.Edges_Edge_KytheKind{scpb.EdgeKind_PARAM},
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Edge_{&xspb.Edges_Edge{
+			Target:  caller,
+			Kind:    &xspb.Edges_Edge_GenericKind{"/some/edge"},
+			Reverse: true,
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Target_{&xspb.Edges_Target{Node: &scpb.Node{
+			Source: param0,
+			Kind:   &scpb.Node_KytheKind{scpb.NodeKind_VARIABLE},
+		}}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Target_{&xspb.Edges_Target{Node: &scpb.Node{
+			Source: caller,
+			Fact: []*scpb.Fact{{
+				Name:  &scpb.Fact_GenericName{"/some/fact"},
+				Value: []byte("value"),
+			}},
+		}}},
+	}}
+	for _, e := range entries {
+		mustWriteEdges(t, w, e)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	gs := NewService(ctx, db)
+
+	ticket := kytheuri.ToString(src)
+	t.Run("nodes", func(t *testing.T) {
+		reply, err := gs.Nodes(ctx, &gpb.NodesRequest{Ticket: []string{ticket, "kythe:#missing"}})
+		testutil.FatalOnErrT(t, "NodesRequest error: %v", err)
+
+		if err := testutil.DeepEqual(map[string]*cpb.NodeInfo{
+			ticket: {Facts: map[string][]byte{"/kythe/node/kind": []byte("function")}},
+		}, reply.Nodes); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("edges", func(t *testing.T) {
+		reply, err := gs.Edges(ctx, &gpb.EdgesRequest{
+			Ticket: []string{ticket},
+			Filter: []string{"**"},
+		})
+		testutil.FatalOnErrT(t, "EdgesRequest error: %v", err)
+
+		expected := &gpb.EdgesReply{
+			EdgeSets: map[string]*gpb.EdgeSet{
+				ticket: {Groups: map[string]*gpb.EdgeSet_Group{
+					"%/some/edge": {Edge: []*gpb.EdgeSet_Group_Edge{{
+						TargetTicket: kytheuri.ToString(caller),
+					}}},
+					"/kythe/edge/param": {Edge: []*gpb.EdgeSet_Group_Edge{{
+						TargetTicket: kytheuri.ToString(param0),
+					}, {
+						TargetTicket: kytheuri.ToString(param1),
+						Ordinal:      1,
+					}}},
+				}},
+			},
+			Nodes: map[string]*cpb.NodeInfo{
+				ticket:                    {Facts: map[string][]byte{"/kythe/node/kind": []byte("function")}},
+				kytheuri.ToString(param0): {Facts: map[string][]byte{"/kythe/node/kind": []byte("variable")}},
+				kytheuri.ToString(caller): {Facts: map[string][]byte{"/some/fact": []byte("value")}},
+			},
+			TotalEdgesByKind: map[string]int64{
+				"%/some/edge":       1,
+				"/kythe/edge/param": 2,
+			},
+		}
+		if err := testutil.DeepEqual(expected, reply); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		reply, err := gs.Edges(ctx, &gpb.EdgesRequest{
+			Ticket:   []string{ticket},
+			Kind:     []string{"/kythe/edge/param"},
+			PageSize: 1,
+		})
+		testutil.FatalOnErrT(t, "EdgesRequest error: %v", err)
+		if reply.NextPageToken == "" {
+			t.Fatalf("Missing next_page_token: {%v}", reply)
+		}
+
+		reply, err = gs.Edges(ctx, &gpb.EdgesRequest{
+			Ticket:    []string{ticket},
+			Kind:      []string{"/kythe/edge/param"},
+			PageSize:  1,
+			PageToken: reply.NextPageToken,
+		})
+		testutil.FatalOnErrT(t, "EdgesRequest error: %v", err)
+
+		expected := &gpb.EdgesReply{
+			EdgeSets: map[string]*gpb.EdgeSet{
+				ticket: {Groups: map[string]*gpb.EdgeSet_Group{
+					"/kythe/edge/param": {Edge: []*gpb.EdgeSet_Group_Edge{{
+						TargetTicket: kytheuri.ToString(param1),
+						Ordinal:      1,
+					}}},
+				}},
+			},
+			Nodes:            map[string]*cpb.NodeInfo{},
+			TotalEdgesByKind: map[string]int64{"/kythe/edge/param": 2},
+		}
+		if err := testutil.DeepEqual(expected, reply); err != nil {
+			t.Error(err)
+		}
+	})
+}
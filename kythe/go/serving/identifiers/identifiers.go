@@ -18,6 +18,12 @@
 // identifiers.Service.
 // The table is structured as:
 // 		qualifed_name -> IdentifierMatch
+// When stored in a combined serving table, each key is prefixed by
+// IdentifierTablePrefix.  A secondary index used to search for identifiers by
+// partial names is structured as:
+// 		"identifierNgrams:" + ngram + "." + chunk -> IdentifierNgram
+// where each chunk of an n-gram's posting list is numbered by a zero-padded,
+// 10-digit decimal integer.
 package identifiers
 
 import (
@@ -41,42 +47,73 @@ type Service interface {
 	Find(context.Context, *ipb.FindRequest) (*ipb.FindReply, error)
 }
 
+// IdentifierTablePrefix is used as the prefix of the keys of a combined
+// serving table.  IdentifierKey uses this prefix to construct its keys.  Table
+// uses this prefix when PrefixedKeys is true.
+const IdentifierTablePrefix = "identifiers:"
+
+// IdentifierKey returns the combined serving table key for the
+// srvpb.IdentifierMatch of the given qualified name.
+func IdentifierKey(qname string) []byte {
+	return []byte(IdentifierTablePrefix + qname)
+}
+
 // Table wraps around a table.Proto to provide the Service interface
 type Table struct {
 	table.Proto
+
+	// PrefixedKeys indicates whether all keys are prefixed by
+	// IdentifierTablePrefix (i.e. the table is part of a combined serving table).
+	PrefixedKeys bool
 }
 
 // Find implements the Service interface for Table
 func (it *Table) Find(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
+	if req.GetSearch() {
+		return it.search(ctx, req)
+	}
+
 	var (
-		qname     = req.GetIdentifier()
-		corpora   = req.GetCorpus()
-		languages = req.GetLanguages()
-		match     srvpb.IdentifierMatch
-		reply     ipb.FindReply
+		qname = req.GetIdentifier()
+		match srvpb.IdentifierMatch
+		reply ipb.FindReply
 	)
 
-	if err := it.Lookup(ctx, []byte(qname), &match); err != nil {
+	if err := it.Lookup(ctx, it.key(qname), &match); err != nil {
 		return &reply, nil
 	}
 
+	reply.Matches = filterMatches(req, &match)
+	return &reply, nil
+}
+
+func (it *Table) key(qname string) []byte {
+	if it.PrefixedKeys {
+		return IdentifierKey(qname)
+	}
+	return []byte(qname)
+}
+
+// filterMatches returns a FindReply_Match for each of match's nodes allowed by
+// the request's corpus, language, and node kind restrictions.
+func filterMatches(req *ipb.FindRequest, match *srvpb.IdentifierMatch) []*ipb.FindReply_Match {
+	var matches []*ipb.FindReply_Match
 	for _, node := range match.GetNode() {
-		if !validCorpusAndLang(corpora, languages, node) {
+		if !validCorpusAndLang(req.GetCorpus(), req.GetLanguages(), node) {
+			continue
+		} else if kinds := req.GetNodeKind(); len(kinds) > 0 && !contains(kinds, node.GetNodeKind()) {
 			continue
 		}
 
-		matchNode := ipb.FindReply_Match{
+		matches = append(matches, &ipb.FindReply_Match{
 			Ticket:        node.GetTicket(),
 			NodeKind:      node.GetNodeKind(),
 			NodeSubkind:   node.GetNodeSubkind(),
 			BaseName:      match.GetBaseName(),
 			QualifiedName: match.GetQualifiedName(),
-		}
-
-		reply.Matches = append(reply.GetMatches(), &matchNode)
+		})
 	}
-
-	return &reply, nil
+	return matches
 }
 
 func validCorpusAndLang(corpora, langs []string, node *srvpb.IdentifierMatch_Node) bool {
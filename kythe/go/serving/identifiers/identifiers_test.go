@@ -29,7 +29,7 @@ import (
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
 )
 
-var matchTable = Table{testProtoTable{
+var matchTable = Table{Proto: testProtoTable{
 	"foo::bar": &srvpb.IdentifierMatch{
 		Node: []*srvpb.IdentifierMatch_Node{
 			node("kythe://corpus?lang=c++", "record", "class"),
@@ -87,6 +87,25 @@ func TestFind(t *testing.T) {
 	}
 }
 
+func TestFind_prefixedKeys(t *testing.T) {
+	prefixed := make(testProtoTable)
+	for qname, m := range matchTable.Proto.(testProtoTable) {
+		prefixed[string(IdentifierKey(qname))] = m
+	}
+	tbl := &Table{Proto: prefixed, PrefixedKeys: true}
+
+	for _, test := range tests {
+		reply, err := tbl.Find(context.TODO(), &test.FindRequest)
+		if err != nil {
+			t.Errorf("unexpected error for request %v: %v", test.FindRequest, err)
+		}
+
+		if err := testutil.DeepEqual(test.Matches, reply.Matches); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func findRequest(qname string, corpora, langs []string) ipb.FindRequest {
 	return ipb.FindRequest{
 		Identifier: qname,
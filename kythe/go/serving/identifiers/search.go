@@ -0,0 +1,330 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identifiers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"kythe.io/kythe/go/storage/table"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
+	itpb "kythe.io/kythe/proto/internal_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+)
+
+// IdentifierNgramPrefix is used as the prefix of the keys of the secondary
+// identifier n-gram index.  NgramKey uses this prefix to construct its keys.
+const IdentifierNgramPrefix = "identifierNgrams:"
+
+// NgramChunkSize is the maximum number of identifiers in each
+// srvpb.IdentifierNgram.  Longer posting lists are split into consecutive
+// chunks of this size.
+const NgramChunkSize = 1000
+
+// NgramKey returns the serving table key for the given chunk of the
+// srvpb.IdentifierNgram posting list of an n-gram.  The keys of an n-gram's
+// chunks sort in chunk order.
+func NgramKey(ngram string, chunk int) []byte {
+	return []byte(fmt.Sprintf("%s%s.%.10d", IdentifierNgramPrefix, ngram, chunk))
+}
+
+const (
+	defaultSearchPageSize = 100
+	maxSearchPageSize     = 1000
+
+	// ngramSize is the number of runes in each of the n-grams indexing an
+	// identifier's names.
+	ngramSize = 3
+
+	// prefixMarker begins each n-gram marking the start of a name component.
+	prefixMarker = "^"
+)
+
+// maxSearchCandidates is the maximum number of identifiers returned as
+// candidates for a single search.  N-grams common to many names can have very
+// large posting lists; once this many candidates are found, further
+// identifiers are ignored unless they match the query exactly or by prefix.
+var maxSearchCandidates = 10000
+
+// Ngrams returns the distinct n-grams used to index the given base or
+// qualified name for searching.  These include each trigram of the lowercased
+// name along with the first one and two runes of each of its components
+// (following the prefixMarker) so that short queries can match by prefix.
+func Ngrams(name string) []string {
+	name = strings.ToLower(name)
+	set := make(map[string]bool)
+	runes := []rune(name)
+	for i := 0; i+ngramSize <= len(runes); i++ {
+		set[string(runes[i:i+ngramSize])] = true
+	}
+	for _, c := range componentStarts(runes) {
+		for n := 1; n < ngramSize && c+n <= len(runes); n++ {
+			set[prefixMarker+string(runes[c:c+n])] = true
+		}
+	}
+
+	ngrams := make([]string, 0, len(set))
+	for g := range set {
+		ngrams = append(ngrams, g)
+	}
+	sort.Strings(ngrams)
+	return ngrams
+}
+
+// queryNgrams returns the distinct n-grams to look up for the given lowercased
+// search query.  Queries shorter than ngramSize are matched by prefix.
+func queryNgrams(query string) []string {
+	runes := []rune(query)
+	if len(runes) == 0 {
+		return nil
+	} else if len(runes) < ngramSize {
+		return []string{prefixMarker + query}
+	}
+	set := make(map[string]bool)
+	var ngrams []string
+	for i := 0; i+ngramSize <= len(runes); i++ {
+		if g := string(runes[i : i+ngramSize]); !set[g] {
+			set[g] = true
+			ngrams = append(ngrams, g)
+		}
+	}
+	return ngrams
+}
+
+// componentStarts returns the indices of each rune beginning a component of a
+// name (the first rune and each rune following a delimiter).
+func componentStarts(runes []rune) []int {
+	var starts []int
+	for i, r := range runes {
+		if isDelimiter(r) {
+			continue
+		} else if i == 0 || isDelimiter(runes[i-1]) {
+			starts = append(starts, i)
+		}
+	}
+	return starts
+}
+
+// isDelimiter reports whether r separates the components of a qualified name.
+func isDelimiter(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+}
+
+// Search match ranks, from best to worst.
+const (
+	exactRank     = iota // the base or qualified name equals the query
+	prefixRank           // the base name or a qualified name component starts with the query
+	substringRank        // the base or qualified name contains the query
+	fuzzyRank            // the names only share some of the query's n-grams
+)
+
+type searchCandidate struct {
+	qualifiedName string
+	rank          int
+	shared        int // number of query n-grams shared with the identifier
+}
+
+// search implements Find for a FindRequest with search set.
+func (it *Table) search(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
+	pageSize := int(req.GetPageSize())
+	if pageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size: %d", req.GetPageSize())
+	} else if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	} else if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	var pageToken itpb.PageToken
+	if req.GetPageToken() != "" {
+		rec, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.GetPageToken())
+		} else if err := proto.Unmarshal(rec, &pageToken); err != nil || pageToken.Index < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.GetPageToken())
+		}
+	}
+
+	candidates, err := it.searchCandidates(ctx, strings.ToLower(req.GetIdentifier()))
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &ipb.FindReply{}
+	for i := int(pageToken.Index); i < len(candidates); i++ {
+		var match srvpb.IdentifierMatch
+		if err := it.Lookup(ctx, it.key(candidates[i].qualifiedName), &match); err == table.ErrNoSuchKey {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		matches := filterMatches(req, &match)
+		if len(reply.Matches) > 0 && len(reply.Matches)+len(matches) > pageSize {
+			rec, err := proto.Marshal(&itpb.PageToken{Index: int32(i)})
+			if err != nil {
+				return nil, err
+			}
+			reply.NextPageToken = base64.StdEncoding.EncodeToString(rec)
+			break
+		}
+		reply.Matches = append(reply.Matches, matches...)
+	}
+	return reply, nil
+}
+
+// searchCandidates returns the qualified names of the identifiers matching the
+// given lowercased query, in ranked order.  An identifier is a candidate if
+// its names share at least half of the query's n-grams.
+//
+// The n-gram posting lists are intersected from the shortest to the longest.
+// An identifier missing from all but minShared-1 lists cannot be a candidate,
+// so the longest lists only add to the counts of identifiers already found.
+// At most maxSearchCandidates of the best ranked identifiers are returned.
+func (it *Table) searchCandidates(ctx context.Context, query string) ([]*searchCandidate, error) {
+	ngrams := queryNgrams(query)
+	minShared := (len(ngrams) + 1) / 2
+
+	lists := make([]*postingList, len(ngrams))
+	for i, g := range ngrams {
+		first, err := it.ngramChunk(ctx, g, 0)
+		if err != nil {
+			return nil, err
+		}
+		lists[i] = &postingList{ngram: g, first: first}
+	}
+	// A list whose first chunk is full may have any number of further chunks,
+	// so it sorts after all of the single-chunk lists.
+	sort.SliceStable(lists, func(i, j int) bool { return len(lists[i].first) < len(lists[j].first) })
+
+	found := make(map[string]*searchCandidate)
+	for i, list := range lists {
+		// Only the first len(lists)-minShared+1 lists may add new candidates.
+		open := len(lists)-i >= minShared
+		chunk := list.first
+		for n := 1; ; n++ {
+			for _, id := range chunk {
+				c, ok := found[id.QualifiedName]
+				if !ok {
+					if !open {
+						continue
+					}
+					rank := searchRank(query, id)
+					if rank > prefixRank && len(found) >= maxSearchCandidates {
+						continue
+					}
+					c = &searchCandidate{
+						qualifiedName: id.QualifiedName,
+						rank:          rank,
+					}
+					found[id.QualifiedName] = c
+				}
+				c.shared++
+			}
+			if len(chunk) < NgramChunkSize {
+				break
+			}
+			var err error
+			if chunk, err = it.ngramChunk(ctx, list.ngram, n); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var candidates []*searchCandidate
+	for _, c := range found {
+		if c.shared >= minShared {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		} else if a.shared != b.shared {
+			return a.shared > b.shared
+		} else if len(a.qualifiedName) != len(b.qualifiedName) {
+			return len(a.qualifiedName) < len(b.qualifiedName)
+		}
+		return a.qualifiedName < b.qualifiedName
+	})
+	if len(candidates) > maxSearchCandidates {
+		candidates = candidates[:maxSearchCandidates]
+	}
+	return candidates, nil
+}
+
+// A postingList is the posting list of a query n-gram, of which only the
+// first chunk is read up front.
+type postingList struct {
+	ngram string
+	first []*srvpb.IdentifierNgram_Identifier
+}
+
+// ngramChunk returns the identifiers in the given chunk of the posting list of
+// ngram, or nil if there is no such chunk.
+func (it *Table) ngramChunk(ctx context.Context, ngram string, chunk int) ([]*srvpb.IdentifierNgram_Identifier, error) {
+	var idx srvpb.IdentifierNgram
+	if err := it.Lookup(ctx, NgramKey(ngram, chunk), &idx); err == table.ErrNoSuchKey {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return idx.Identifier, nil
+}
+
+// searchRank returns the rank of the given identifier as a match for the
+// lowercased query.
+func searchRank(query string, id *srvpb.IdentifierNgram_Identifier) int {
+	base, qname := strings.ToLower(id.BaseName), strings.ToLower(id.QualifiedName)
+	switch {
+	case base == query || qname == query:
+		return exactRank
+	case strings.HasPrefix(base, query) || hasComponentPrefix(qname, query):
+		return prefixRank
+	case strings.Contains(base, query) || strings.Contains(qname, query):
+		return substringRank
+	default:
+		return fuzzyRank
+	}
+}
+
+// hasComponentPrefix reports whether the suffix of name beginning at any of
+// its components starts with prefix.
+func hasComponentPrefix(name, prefix string) bool {
+	for {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+		i := strings.IndexFunc(name, isDelimiter)
+		if i < 0 {
+			return false
+		}
+		_, size := utf8.DecodeRuneInString(name[i:])
+		name = name[i+size:]
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ * Copyright 2018 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identifiers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"kythe.io/kythe/go/test/testutil"
+
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+)
+
+var searchMatches = []*srvpb.IdentifierMatch{{
+	QualifiedName: "xrefs.CrossReferences",
+	BaseName:      "CrossReferences",
+	Node:          []*srvpb.IdentifierMatch_Node{node("kythe://go?lang=go#xrefs.CrossReferences", "function", "")},
+}, {
+	QualifiedName: "xrefs.CrossReferencesReply",
+	BaseName:      "CrossReferencesReply",
+	Node:          []*srvpb.IdentifierMatch_Node{node("kythe://go?lang=go#xrefs.CrossReferencesReply", "record", "struct")},
+}, {
+	QualifiedName: "kythe.proto.CrossReferencesRequest",
+	BaseName:      "CrossReferencesRequest",
+	Node: []*srvpb.IdentifierMatch_Node{
+		node("kythe://java?lang=java#CrossReferencesRequest", "record", "class"),
+		node("kythe://go?lang=go#CrossReferencesRequest", "record", "struct"),
+	},
+}, {
+	QualifiedName: "graph.Edges",
+	BaseName:      "Edges",
+	Node:          []*srvpb.IdentifierMatch_Node{node("kythe://go?lang=go#graph.Edges", "function", "")},
+}, {
+	QualifiedName: "graph.AllEdges",
+	BaseName:      "AllEdges",
+	Node:          []*srvpb.IdentifierMatch_Node{node("kythe://go?lang=go#graph.AllEdges", "function", "")},
+}}
+
+// searchTable returns a Table with the given matches and their n-gram index.
+func searchTable(ms []*srvpb.IdentifierMatch) *Table {
+	t := make(testProtoTable)
+	index := make(map[string]*srvpb.IdentifierNgram)
+	for _, m := range ms {
+		t[string(IdentifierKey(m.QualifiedName))] = m
+		grams := make(map[string]bool)
+		for _, g := range append(Ngrams(m.BaseName), Ngrams(m.QualifiedName)...) {
+			grams[g] = true
+		}
+		for g := range grams {
+			if index[g] == nil {
+				index[g] = &srvpb.IdentifierNgram{}
+			}
+			index[g].Identifier = append(index[g].Identifier, &srvpb.IdentifierNgram_Identifier{
+				QualifiedName: m.QualifiedName,
+				BaseName:      m.BaseName,
+			})
+		}
+	}
+	for g, idx := range index {
+		ids := idx.Identifier
+		sort.Slice(ids, func(i, j int) bool { return ids[i].QualifiedName < ids[j].QualifiedName })
+		for chunk := 0; len(ids) > 0; chunk++ {
+			n := NgramChunkSize
+			if n > len(ids) {
+				n = len(ids)
+			}
+			t[string(NgramKey(g, chunk))] = &srvpb.IdentifierNgram{Identifier: ids[:n]}
+			ids = ids[n:]
+		}
+	}
+	return &Table{Proto: t, PrefixedKeys: true}
+}
+
+func TestNgrams(t *testing.T) {
+	expected := []string{
+		".ba", "^b", "^ba", "^f", "^fo",
+		"bar", "foo", "o.b", "oo.",
+	}
+	found := Ngrams("Foo.BAR")
+	if err := testutil.DeepEqual(expected, found); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tbl := searchTable(searchMatches)
+
+	tests := []struct {
+		req      *ipb.FindRequest
+		expected []string
+	}{{
+		// Case-insensitive exact base name.
+		req:      &ipb.FindRequest{Identifier: "crossreferences"},
+		expected: []string{"xrefs.CrossReferences", "xrefs.CrossReferencesReply", "kythe.proto.CrossReferencesRequest", "kythe.proto.CrossReferencesRequest"},
+	}, {
+		// Qualified name component prefix; similar names are ranked last.
+		req:      &ipb.FindRequest{Identifier: "xrefs.Cross"},
+		expected: []string{"xrefs.CrossReferences", "xrefs.CrossReferencesReply", "kythe.proto.CrossReferencesRequest", "kythe.proto.CrossReferencesRequest"},
+	}, {
+		// Exact matches rank before substring matches.
+		req:      &ipb.FindRequest{Identifier: "edges"},
+		expected: []string{"graph.Edges", "graph.AllEdges"},
+	}, {
+		// Fuzzy match with a typo.
+		req:      &ipb.FindRequest{Identifier: "crossrefernces"},
+		expected: []string{"xrefs.CrossReferences", "xrefs.CrossReferencesReply", "kythe.proto.CrossReferencesRequest", "kythe.proto.CrossReferencesRequest"},
+	}, {
+		// Short queries match by component prefix.
+		req:      &ipb.FindRequest{Identifier: "Al"},
+		expected: []string{"graph.AllEdges"},
+	}, {
+		req: &ipb.FindRequest{
+			Identifier: "CrossReferences",
+			Languages:  []string{"java"},
+		},
+		expected: []string{"kythe.proto.CrossReferencesRequest"},
+	}, {
+		req: &ipb.FindRequest{
+			Identifier: "CrossReferences",
+			Corpus:     []string{"go"},
+			NodeKind:   []string{"record"},
+		},
+		expected: []string{"xrefs.CrossReferencesReply", "kythe.proto.CrossReferencesRequest"},
+	}, {
+		req: &ipb.FindRequest{Identifier: "nothing"},
+	}}
+
+	for _, test := range tests {
+		test.req.Search = true
+		reply, err := tbl.Find(context.Background(), test.req)
+		if err != nil {
+			t.Errorf("Find(%v) error: %v", test.req, err)
+			continue
+		}
+		var found []string
+		for _, m := range reply.Matches {
+			found = append(found, m.QualifiedName)
+		}
+		if err := testutil.DeepEqual(test.expected, found); err != nil {
+			t.Errorf("Find(%v): %v", test.req, err)
+		}
+		if reply.NextPageToken != "" {
+			t.Errorf("Find(%v): unexpected next_page_token: %q", test.req, reply.NextPageToken)
+		}
+	}
+}
+
+func TestSearch_paging(t *testing.T) {
+	tbl := searchTable(searchMatches)
+	ctx := context.Background()
+
+	req := &ipb.FindRequest{
+		Identifier: "CrossRef",
+		Search:     true,
+		PageSize:   1,
+	}
+	var pages [][]string
+	for {
+		reply, err := tbl.Find(ctx, req)
+		if err != nil {
+			t.Fatalf("Find(%v) error: %v", req, err)
+		}
+		var page []string
+		for _, m := range reply.Matches {
+			page = append(page, m.Ticket)
+		}
+		pages = append(pages, page)
+		if reply.NextPageToken == "" {
+			break
+		} else if len(pages) > len(searchMatches) {
+			t.Fatalf("Too many pages: %v", pages)
+		}
+		req.PageToken = reply.NextPageToken
+	}
+
+	// The two CrossReferencesRequest nodes share the last page.
+	expected := [][]string{
+		{"kythe://go?lang=go#xrefs.CrossReferences"},
+		{"kythe://go?lang=go#xrefs.CrossReferencesReply"},
+		{"kythe://java?lang=java#CrossReferencesRequest", "kythe://go?lang=go#CrossReferencesRequest"},
+	}
+	if err := testutil.DeepEqual(expected, pages); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSearch_maxCandidates(t *testing.T) {
+	defer func(orig int) { maxSearchCandidates = orig }(maxSearchCandidates)
+	maxSearchCandidates = 3
+
+	var ms []*srvpb.IdentifierMatch
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("pkg.Widget%d", i)
+		ms = append(ms, &srvpb.IdentifierMatch{
+			QualifiedName: name,
+			BaseName:      name[len("pkg."):],
+			Node:          []*srvpb.IdentifierMatch_Node{node("kythe://go?lang=go#"+name, "record", "struct")},
+		})
+	}
+	tbl := searchTable(ms)
+	ctx := context.Background()
+
+	candidates, err := tbl.searchCandidates(ctx, "widget")
+	if err != nil {
+		t.Fatalf("searchCandidates error: %v", err)
+	} else if len(candidates) != maxSearchCandidates {
+		t.Errorf("searchCandidates: got %d candidates, want %d", len(candidates), maxSearchCandidates)
+	}
+
+	// A rarer n-gram of the query is read first, so the limit does not hide
+	// the identifiers sharing it.
+	candidates, err = tbl.searchCandidates(ctx, "widget7")
+	if err != nil {
+		t.Fatalf("searchCandidates error: %v", err)
+	} else if len(candidates) == 0 || candidates[0].qualifiedName != "pkg.Widget7" {
+		t.Errorf("searchCandidates: got %v, want pkg.Widget7 first", candidates)
+	}
+}
+
+func TestSearch_chunkedPostingLists(t *testing.T) {
+	defer func(orig int) { maxSearchCandidates = orig }(maxSearchCandidates)
+	maxSearchCandidates = 3
+
+	// Every n-gram of the query has a posting list of several chunks, and the
+	// only exact match is in the last of them.
+	var ms []*srvpb.IdentifierMatch
+	for i := 0; i < 2*NgramChunkSize; i++ {
+		name := fmt.Sprintf("pkg.Xgadget%04d", i)
+		ms = append(ms, &srvpb.IdentifierMatch{
+			QualifiedName: name,
+			BaseName:      name[len("pkg."):],
+		})
+	}
+	ms = append(ms, &srvpb.IdentifierMatch{
+		QualifiedName: "zz.Gadget",
+		BaseName:      "Gadget",
+	})
+	tbl := searchTable(ms)
+
+	candidates, err := tbl.searchCandidates(context.Background(), "gadget")
+	if err != nil {
+		t.Fatalf("searchCandidates error: %v", err)
+	}
+	var found []string
+	for _, c := range candidates {
+		found = append(found, c.qualifiedName)
+	}
+	expected := []string{"zz.Gadget", "pkg.Xgadget0000", "pkg.Xgadget0001"}
+	if err := testutil.DeepEqual(expected, found); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSearch_invalidPageToken(t *testing.T) {
+	tbl := searchTable(searchMatches)
+	if reply, err := tbl.Find(context.Background(), &ipb.FindRequest{
+		Identifier: "CrossRef",
+		Search:     true,
+		PageToken:  "!invalid!",
+	}); err == nil {
+		t.Errorf("Expected error for invalid page_token; found: %v", reply)
+	}
+}
+
+func TestHasComponentPrefix(t *testing.T) {
+	tests := []struct {
+		name, prefix string
+		expected     bool
+	}{
+		{"xrefs.crossreferences", "xrefs.cross", true},
+		{"kythe.io/go/xrefs.crossreferences", "xrefs.cross", true},
+		{"kythe.io/go/xrefs.crossreferences", "crossref", true},
+		{"kythe.io/go/xrefs.crossreferences", "refs", false},
+		{"foo::bar", "bar", true},
+	}
+	for _, test := range tests {
+		if found := hasComponentPrefix(test.name, test.prefix); found != test.expected {
+			t.Errorf("hasComponentPrefix(%q, %q): expected %v; found %v", test.name, test.prefix, test.expected, found)
+		}
+	}
+}
@@ -22,6 +22,9 @@ import (
 	"sort"
 	"strconv"
 
+	"kythe.io/kythe/go/services/xrefs"
+	esrv "kythe.io/kythe/go/serving/explore"
+	"kythe.io/kythe/go/serving/identifiers"
 	"kythe.io/kythe/go/serving/pipeline/nodes"
 	"kythe.io/kythe/go/serving/xrefs/assemble"
 	"kythe.io/kythe/go/util/compare"
@@ -40,24 +43,45 @@ import (
 	scpb "kythe.io/kythe/proto/schema_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
+	xspb "kythe.io/kythe/proto/xref_serving_go_proto"
 )
 
 func init() {
+	beam.RegisterFunction(completeCallers)
 	beam.RegisterFunction(completeDocument)
+	beam.RegisterFunction(completeRelations)
 	beam.RegisterFunction(defToDecorPiece)
+	beam.RegisterFunction(expandCallsites)
 	beam.RegisterFunction(fileToDecorPiece)
+	beam.RegisterFunction(groupCallgraph)
 	beam.RegisterFunction(groupCrossRefs)
 	beam.RegisterFunction(groupEdges)
+	beam.RegisterFunction(groupIdentifierNgrams)
+	beam.RegisterFunction(groupIdentifiers)
+	beam.RegisterFunction(groupParameters)
+	beam.RegisterFunction(groupRelatives)
+	beam.RegisterFunction(groupTypeHierarchy)
+	beam.RegisterFunction(keyByCaller)
 	beam.RegisterFunction(keyByPath)
+	beam.RegisterFunction(keyCrossRef)
 	beam.RegisterFunction(keyNode)
 	beam.RegisterFunction(keyRef)
 	beam.RegisterFunction(moveSourceToKey)
+	beam.RegisterFunction(nodeToCallgraphs)
 	beam.RegisterFunction(nodeToChildren)
 	beam.RegisterFunction(nodeToDecorPiece)
 	beam.RegisterFunction(nodeToDocs)
 	beam.RegisterFunction(nodeToEdges)
+	beam.RegisterFunction(nodeToIdentifier)
+	beam.RegisterFunction(nodeToMerges)
+	beam.RegisterFunction(nodeToOverriders)
+	beam.RegisterFunction(nodeToParamEdges)
+	beam.RegisterFunction(nodeToRelations)
+	beam.RegisterFunction(nodeToRelatives)
 	beam.RegisterFunction(nodeToReverseEdges)
+	beam.RegisterFunction(nodeToTypeHierarchy)
 	beam.RegisterFunction(parseMarkedSource)
+	beam.RegisterFunction(refToCallsite)
 	beam.RegisterFunction(refToDecorPiece)
 	beam.RegisterFunction(reverseEdge)
 	beam.RegisterFunction(toDefinition)
@@ -75,6 +99,7 @@ func init() {
 	beam.RegisterType(reflect.TypeOf((*ppb.Reference)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*spb.Entry)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*spb.VName)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.Callgraph)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.CorpusRoots)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.Document)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.EdgePage)(nil)).Elem())
@@ -82,9 +107,16 @@ func init() {
 	beam.RegisterType(reflect.TypeOf((*srvpb.File)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.FileDecorations)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.FileDirectory)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.FunctionParameters)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.IdentifierMatch)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.IdentifierNgram)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.IdentifierNgram_Identifier)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.PagedCrossReferences)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.PagedCrossReferences_Page)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*srvpb.PagedEdgeSet)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.Relatives)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.TypeHierarchy)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*xspb.CrossReferences_Callsite)(nil)).Elem())
 }
 
 // KytheBeam controls the lifetime and generation of PCollections in the Kythe
@@ -98,6 +130,7 @@ type KytheBeam struct {
 	refs       beam.PCollection // *ppb.Reference
 
 	markedSources beam.PCollection // KV<*spb.VName, *cpb.MarkedSource>
+	merges        beam.PCollection // KV<*spb.VName, *spb.VName>
 }
 
 // FromNodes creates a KytheBeam pipeline from an input collection of
@@ -122,59 +155,392 @@ func (k *KytheBeam) SplitCrossReferences() beam.PCollection {
 	idx := beam.ParDo(s, nodeToCrossRef, beam.CoGroupByKey(s,
 		beam.ParDo(s, keyNode, k.Nodes()),
 		k.getMarkedSources(),
-		// TODO(schroederc): merge_with
+		k.getMerges(),
 	))
 
 	return beam.ParDo(s, encodeCrossRef, beam.Flatten(s,
 		idx,
 		refs,
-		// TODO(schroederc): related nodes
-		// TODO(schroederc): callers
+		k.relatedNodes(s),
+		k.callers(s),
 	))
 }
 
 // CrossReferences returns a Kythe file decorations table derived from the Kythe
 // input graph.  The beam.PCollections have elements of type
-// KV<string, *srvpb.PagedCrossReferences> and
-// KV<string, *srvpb.PagedCrossReferences_Page>, respectively.
+// KV<string, *srvpb.PagedCrossReferences> and
+// KV<string, *srvpb.PagedCrossReferences_Page>, respectively.
 func (k *KytheBeam) CrossReferences() (sets, pages beam.PCollection) {
 	s := k.s.Scope("CrossReferences")
-	refs := beam.GroupByKey(s, beam.ParDo(s, keyRef, k.References()))
-	// TODO(schroederc): related nodes
-	// TODO(schroederc): callers
+	refs := beam.ParDo(s, keyRef, k.References())
+	entries := beam.ParDo(s, keyCrossRef, beam.Flatten(s, k.relatedNodes(s), k.callers(s)))
 	// TODO(schroederc): MarkedSource
 	// TODO(schroederc): source_node
-	return beam.ParDo2(s, groupCrossRefs, refs)
+	return beam.ParDo2(s, groupCrossRefs, beam.CoGroupByKey(s, refs, entries, k.getMerges()))
 }
 
 // groupCrossRefs emits *srvpb.PagedCrossReferences and *srvpb.PagedCrossReferences_Pages for a
-// single node's collection of *ppb.References.
-func groupCrossRefs(key *spb.VName, refStream func(**ppb.Reference) bool, emitSet func(string, *srvpb.PagedCrossReferences), emitPage func(string, *srvpb.PagedCrossReferences_Page)) {
+// single node's collection of *ppb.References, its related node and caller
+// *xspb.CrossReferences entries, and the nodes with which it is merged.
+func groupCrossRefs(key *spb.VName, refStream func(**ppb.Reference) bool, entryStream func(**xspb.CrossReferences) bool, mergeStream func(**spb.VName) bool, emitSet func(string, *srvpb.PagedCrossReferences), emitPage func(string, *srvpb.PagedCrossReferences_Page)) {
 	set := &srvpb.PagedCrossReferences{SourceTicket: kytheuri.ToString(key)}
 	// TODO(schroederc): add paging
 
-	groups := make(map[string]*srvpb.PagedCrossReferences_Group)
+	for _, m := range readMerges(mergeStream) {
+		set.MergeWith = append(set.MergeWith, kytheuri.ToString(m))
+	}
 
-	var ref *ppb.Reference
-	for refStream(&ref) {
-		kind := refKind(ref)
+	groups := make(map[string]*srvpb.PagedCrossReferences_Group)
+	group := func(kind string) *srvpb.PagedCrossReferences_Group {
 		g, ok := groups[kind]
 		if !ok {
 			g = &srvpb.PagedCrossReferences_Group{Kind: kind}
 			groups[kind] = g
 			set.Group = append(set.Group, g)
 		}
+		return g
+	}
+
+	var ref *ppb.Reference
+	for refStream(&ref) {
+		g := group(refKind(ref))
 		g.Anchor = append(g.Anchor, ref.Anchor)
 	}
 
+	var (
+		relations []*xspb.CrossReferences_Relation
+		callsites []*xspb.CrossReferences_Callsite
+
+		relatedNodes = make(map[string]*xspb.CrossReferences_RelatedNode)
+		callers      = make(map[string]*xspb.CrossReferences_Caller)
+	)
+	var e *xspb.CrossReferences
+	for entryStream(&e) {
+		switch e := e.Entry.(type) {
+		case *xspb.CrossReferences_Relation_:
+			relations = append(relations, e.Relation)
+		case *xspb.CrossReferences_RelatedNode_:
+			relatedNodes[kytheuri.ToString(e.RelatedNode.Node.Source)] = e.RelatedNode
+		case *xspb.CrossReferences_Caller_:
+			callers[kytheuri.ToString(e.Caller.Caller)] = e.Caller
+		case *xspb.CrossReferences_Callsite_:
+			callsites = append(callsites, e.Callsite)
+		}
+	}
+
+	for _, r := range relations {
+		ticket := kytheuri.ToString(r.Node)
+		n := &srvpb.Node{Ticket: ticket}
+		if rn := relatedNodes[ticket]; rn != nil {
+			n = convertPipelineNode(rn.Node)
+			n.DefinitionLocation = rn.DefinitionLocation
+		}
+		g := group(relationKind(r))
+		g.RelatedNode = append(g.RelatedNode, &srvpb.PagedCrossReferences_RelatedNode{
+			Node:    n,
+			Ordinal: r.Ordinal,
+		})
+	}
+
+	groupCallers := make(map[string]*srvpb.PagedCrossReferences_Caller)
+	for _, site := range callsites {
+		kind := xrefs.InternalCallerKindDirect
+		if site.Kind == xspb.CrossReferences_Callsite_OVERRIDE {
+			kind = xrefs.InternalCallerKindOverride
+		}
+		ticket := kytheuri.ToString(site.Caller)
+		c, ok := groupCallers[kind+"\n"+ticket]
+		if !ok {
+			c = &srvpb.PagedCrossReferences_Caller{SemanticCaller: ticket}
+			if caller := callers[ticket]; caller != nil {
+				c.Caller = caller.Location
+				c.MarkedSource = caller.MarkedSource
+			}
+			groupCallers[kind+"\n"+ticket] = c
+			g := group(kind)
+			g.Caller = append(g.Caller, c)
+		}
+		c.Callsite = append(c.Callsite, site.Location)
+	}
+
+	if len(set.Group) == 0 && len(set.MergeWith) == 0 {
+		return
+	}
+
 	sort.Slice(set.Group, func(i, j int) bool { return set.Group[i].Kind < set.Group[j].Kind })
 	for _, g := range set.Group {
 		sort.Slice(g.Anchor, func(i, j int) bool { return g.Anchor[i].Ticket < g.Anchor[j].Ticket })
+		sort.Slice(g.RelatedNode, func(i, j int) bool {
+			if a, b := g.RelatedNode[i], g.RelatedNode[j]; a.Node.Ticket != b.Node.Ticket {
+				return a.Node.Ticket < b.Node.Ticket
+			}
+			return g.RelatedNode[i].Ordinal < g.RelatedNode[j].Ordinal
+		})
+		sort.Slice(g.Caller, func(i, j int) bool { return g.Caller[i].SemanticCaller < g.Caller[j].SemanticCaller })
+		for _, c := range g.Caller {
+			sort.Slice(c.Callsite, func(i, j int) bool { return c.Callsite[i].Ticket < c.Callsite[j].Ticket })
+		}
 	}
 
 	emitSet("xrefs:"+set.SourceTicket, set)
 }
 
+// getMerges returns a beam.PCollection of KV<*spb.VName, *spb.VName> pairs of
+// nodes whose cross-references should be merged when served.
+func (k *KytheBeam) getMerges() beam.PCollection {
+	if !k.merges.IsValid() {
+		s := k.s.Scope("Merges")
+		k.merges = beam.ParDo(s, nodeToMerges, k.nodes)
+	}
+	return k.merges
+}
+
+// nodeToMerges emits each pair of nodes related by one of n's merge edges,
+// keyed by one another.
+func nodeToMerges(n *scpb.Node, emit func(*spb.VName, *spb.VName)) {
+	for _, e := range n.Edge {
+		if isMergeEdge(schema.GetEdgeKind(e)) {
+			emit(n.Source, e.Target)
+			emit(e.Target, n.Source)
+		}
+	}
+}
+
+// isMergeEdge reports whether the nodes related by the given edge kind should
+// have their cross-references merged.  Generated nodes are merged with the
+// nodes that generate them.
+func isMergeEdge(kind string) bool {
+	return edges.IsVariant(edges.Canonical(kind), edges.Generates)
+}
+
+// readMerges returns the distinct VNames read from the given stream of merge
+// nodes, sorted by their tickets.
+func readMerges(mergeStream func(**spb.VName) bool) []*spb.VName {
+	merges := make(map[string]*spb.VName)
+	var m *spb.VName
+	for mergeStream(&m) {
+		merges[kytheuri.ToString(m)] = m
+	}
+	tickets := make([]string, 0, len(merges))
+	for t := range merges {
+		tickets = append(tickets, t)
+	}
+	sort.Strings(tickets)
+	var res []*spb.VName
+	for _, t := range tickets {
+		res = append(res, merges[t])
+	}
+	return res
+}
+
+// keyCrossRef returns the given *xspb.CrossReferences entry keyed by its
+// Source.
+func keyCrossRef(xr *xspb.CrossReferences) (*spb.VName, *xspb.CrossReferences) {
+	return xr.Source, xr
+}
+
+// relationKind returns the edge kind of the given relation, prefixed with "%"
+// if it is a reverse edge.
+func relationKind(r *xspb.CrossReferences_Relation) string {
+	kind := r.GetGenericKind()
+	if kind == "" {
+		kind = schema.EdgeKindString(r.GetKytheKind())
+	}
+	if r.Reverse {
+		kind = "%" + kind
+	}
+	return kind
+}
+
+// relatedNodes returns the *xspb.CrossReferences Relation and RelatedNode
+// entries for each semantic node's forward and reverse edges.
+func (k *KytheBeam) relatedNodes(s beam.Scope) beam.PCollection {
+	s = s.Scope("RelatedNodes")
+	rels := beam.ParDo(s, nodeToRelations, k.nodes)
+	return beam.ParDo(s, completeRelations, beam.CoGroupByKey(s,
+		rels,
+		beam.ParDo(s, moveSourceToKey, k.nodes),
+		k.directDefinitions(),
+	))
+}
+
+// nodeToRelations emits a forward and reverse *xspb.CrossReferences Relation
+// entry for each of a non-anchor node's edges.  Each entry is keyed by the
+// related node's VName.
+func nodeToRelations(n *scpb.Node, emit func(*spb.VName, *xspb.CrossReferences)) {
+	if schema.GetNodeKind(n) == kinds.Anchor {
+		return
+	}
+	for _, e := range n.Edge {
+		if edges.IsAnchorEdge(schema.GetEdgeKind(e)) {
+			continue
+		}
+		fwd := &xspb.CrossReferences_Relation{Node: e.Target, Ordinal: e.Ordinal}
+		rev := &xspb.CrossReferences_Relation{Node: n.Source, Ordinal: e.Ordinal, Reverse: true}
+		if k := e.GetGenericKind(); k != "" {
+			fwd.Kind = &xspb.CrossReferences_Relation_GenericKind{k}
+			rev.Kind = &xspb.CrossReferences_Relation_GenericKind{k}
+		} else {
+			fwd.Kind = &xspb.CrossReferences_Relation_KytheKind{e.GetKytheKind()}
+			rev.Kind = &xspb.CrossReferences_Relation_KytheKind{e.GetKytheKind()}
+		}
+		emit(e.Target, &xspb.CrossReferences{
+			Source: n.Source,
+			Entry:  &xspb.CrossReferences_Relation_{fwd},
+		})
+		emit(n.Source, &xspb.CrossReferences{
+			Source: e.Target,
+			Entry:  &xspb.CrossReferences_Relation_{rev},
+		})
+	}
+}
+
+// completeRelations emits each Relation entry to the keyed node along with a
+// single RelatedNode entry, holding the node's facts and definition, for each
+// distinct source of those relations.
+func completeRelations(key *spb.VName, relStream func(**xspb.CrossReferences) bool, nodeStream func(**scpb.Node) bool, defStream func(**srvpb.ExpandedAnchor) bool, emit func(*xspb.CrossReferences)) {
+	node := &scpb.Node{Source: key}
+	var n *scpb.Node
+	if nodeStream(&n) {
+		node.Kind = n.Kind
+		node.Subkind = n.Subkind
+		for _, f := range n.Fact {
+			switch schema.GetFactName(f) {
+			case facts.Text, facts.TextEncoding:
+				// Skip large text facts for related nodes
+			default:
+				node.Fact = append(node.Fact, f)
+			}
+		}
+	}
+
+	var def *srvpb.ExpandedAnchor
+	for defStream(&def) {
+		// TODO(schroederc): select ambiguous definition better
+		break // pick first known definition
+	}
+
+	related := &xspb.CrossReferences_RelatedNode_{&xspb.CrossReferences_RelatedNode{
+		Node:               node,
+		DefinitionLocation: def,
+	}}
+	sources := make(map[string]bool)
+	var rel *xspb.CrossReferences
+	for relStream(&rel) {
+		emit(rel)
+		if src := kytheuri.ToString(rel.Source); !sources[src] {
+			sources[src] = true
+			emit(&xspb.CrossReferences{Source: rel.Source, Entry: related})
+		}
+	}
+}
+
+// callers returns the *xspb.CrossReferences Caller and Callsite entries for
+// each node's direct callsites and the callsites of the nodes it overrides.
+func (k *KytheBeam) callers(s beam.Scope) beam.PCollection {
+	s = s.Scope("Callers")
+	callsites := beam.ParDo(s, expandCallsites, beam.CoGroupByKey(s,
+		beam.ParDo(s, refToCallsite, k.References()),
+		beam.ParDo(s, nodeToOverriders, k.nodes),
+	))
+	return beam.ParDo(s, completeCallers, beam.CoGroupByKey(s,
+		beam.ParDo(s, keyByCaller, callsites),
+		k.directDefinitions(),
+		k.getMarkedSources(),
+	))
+}
+
+// refToCallsite emits a direct *xspb.CrossReferences_Callsite for each call
+// reference within a caller's scope.  Each callsite is keyed by its callee.
+func refToCallsite(r *ppb.Reference, emit func(*spb.VName, *xspb.CrossReferences_Callsite)) {
+	if r.Scope == nil || !edges.IsCall(refKind(r)) {
+		return
+	}
+	emit(r.Source, &xspb.CrossReferences_Callsite{
+		Caller:   r.Scope,
+		Location: r.Anchor,
+		Kind:     xspb.CrossReferences_Callsite_DIRECT,
+	})
+}
+
+// nodeToOverriders emits the VName of n keyed by each node that it overrides.
+func nodeToOverriders(n *scpb.Node, emit func(*spb.VName, *spb.VName)) {
+	for _, e := range n.Edge {
+		if edges.IsVariant(schema.GetEdgeKind(e), edges.Overrides) {
+			emit(e.Target, n.Source)
+		}
+	}
+}
+
+// expandCallsites emits a Callsite entry for each of a callee's direct
+// callsites along with an OVERRIDE Callsite entry for each of the callee's
+// overriders.
+func expandCallsites(callee *spb.VName, siteStream func(**xspb.CrossReferences_Callsite) bool, overriderStream func(**spb.VName) bool, emit func(*xspb.CrossReferences)) {
+	var overriders []*spb.VName
+	var o *spb.VName
+	for overriderStream(&o) {
+		overriders = append(overriders, o)
+	}
+
+	var site *xspb.CrossReferences_Callsite
+	for siteStream(&site) {
+		emit(&xspb.CrossReferences{
+			Source: callee,
+			Entry:  &xspb.CrossReferences_Callsite_{site},
+		})
+		for _, o := range overriders {
+			emit(&xspb.CrossReferences{
+				Source: o,
+				Entry: &xspb.CrossReferences_Callsite_{&xspb.CrossReferences_Callsite{
+					Caller:   site.Caller,
+					Location: site.Location,
+					Kind:     xspb.CrossReferences_Callsite_OVERRIDE,
+				}},
+			})
+		}
+	}
+}
+
+// keyByCaller returns the given Callsite entry keyed by its caller.
+func keyByCaller(xr *xspb.CrossReferences) (*spb.VName, *xspb.CrossReferences) {
+	return xr.GetCallsite().Caller, xr
+}
+
+// completeCallers emits each distinct Callsite entry of the keyed caller along
+// with a single Caller entry, holding the caller's definition and
+// MarkedSource, for each of its callees.
+func completeCallers(caller *spb.VName, siteStream func(**xspb.CrossReferences) bool, defStream func(**srvpb.ExpandedAnchor) bool, msStream func(**cpb.MarkedSource) bool, emit func(*xspb.CrossReferences)) {
+	var def *srvpb.ExpandedAnchor
+	for defStream(&def) {
+		// TODO(schroederc): select ambiguous definition better
+		break // pick first known definition
+	}
+	var ms *cpb.MarkedSource
+	msStream(&ms)
+
+	c := &xspb.CrossReferences_Caller_{&xspb.CrossReferences_Caller{
+		Caller:       caller,
+		Location:     def,
+		MarkedSource: ms,
+	}}
+	callees := make(map[string]bool)
+	sites := make(map[string]bool)
+	var site *xspb.CrossReferences
+	for siteStream(&site) {
+		callee := kytheuri.ToString(site.Source)
+		key := fmt.Sprintf("%s\n%s\n%s", callee, site.GetCallsite().Kind, site.GetCallsite().Location.Ticket)
+		if sites[key] {
+			continue
+		}
+		sites[key] = true
+		emit(site)
+		if !callees[callee] {
+			callees[callee] = true
+			emit(&xspb.CrossReferences{Source: site.Source, Entry: c})
+		}
+	}
+}
+
 func keyRef(r *ppb.Reference) (*spb.VName, *ppb.Reference) {
 	return r.Source, &ppb.Reference{
 		Kind:   r.Kind,
@@ -570,6 +936,19 @@ func (k *KytheBeam) Edges() (beam.PCollection, beam.PCollection) {
 	return beam.ParDo2(s, groupEdges, beam.CoGroupByKey(s, nodes, edges, rev))
 }
 
+// SplitEdges returns a columnar Kythe edges table derived from the Kythe input
+// graph.  The beam.PCollection has elements of type KV<[]byte, []byte>.
+func (k *KytheBeam) SplitEdges() beam.PCollection {
+	s := k.s.Scope("SplitEdges")
+
+	nodes := beam.ParDo(s, moveSourceToKey, k.nodes)
+	idx := beam.ParDo(s, nodeToEdgesIndex, k.nodes)
+	edges := beam.ParDo(s, targetToEdges, beam.CoGroupByKey(s, nodes, beam.ParDo(s, nodeToEdges, k.nodes)))
+	rev := beam.ParDo(s, reverseEdgeToEdges, beam.ParDo(s, nodeToReverseEdges, k.nodes))
+
+	return beam.ParDo(s, encodeEdges, beam.Flatten(s, idx, edges, rev))
+}
+
 // nodeToReverseEdges emits an *scpb.Edge with its SourceNode populated for each of n's edges.  The
 // key for each *scpb.Edge is its Target VName.
 func nodeToReverseEdges(n *scpb.Node, emit func(*spb.VName, *scpb.Edge)) {
@@ -669,6 +1048,272 @@ func groupEdges(src *spb.VName, nodeStream func(**scpb.Node) bool, edgeStream, r
 	emitSet("edgeSets:"+set.Source.Ticket, set)
 }
 
+// TypeHierarchies returns a Kythe type hierarchy table derived from the Kythe
+// input graph.  The beam.PCollection has elements of type
+// KV<string, *srvpb.TypeHierarchy>.
+func (k *KytheBeam) TypeHierarchies() beam.PCollection {
+	s := k.s.Scope("TypeHierarchies")
+	return beam.ParDo(s, groupTypeHierarchy, beam.GroupByKey(s, beam.ParDo(s, nodeToTypeHierarchy, k.nodes)))
+}
+
+// nodeToTypeHierarchy emits a partial *srvpb.TypeHierarchy for both ends of
+// each of n's type hierarchy edges.
+func nodeToTypeHierarchy(n *scpb.Node, emit func(*spb.VName, *srvpb.TypeHierarchy)) {
+	for _, e := range n.Edge {
+		if !isTypeHierarchyEdge(schema.GetEdgeKind(e)) {
+			continue
+		}
+		emit(n.Source, &srvpb.TypeHierarchy{Supertypes: []string{kytheuri.ToString(e.Target)}})
+		emit(e.Target, &srvpb.TypeHierarchy{Subtypes: []string{kytheuri.ToString(n.Source)}})
+	}
+}
+
+// groupTypeHierarchy merges each partial *srvpb.TypeHierarchy for a node into
+// a single *srvpb.TypeHierarchy.
+func groupTypeHierarchy(src *spb.VName, hs func(**srvpb.TypeHierarchy) bool, emit func(string, *srvpb.TypeHierarchy)) {
+	th := &srvpb.TypeHierarchy{}
+	var h *srvpb.TypeHierarchy
+	for hs(&h) {
+		th.Supertypes = append(th.Supertypes, h.Supertypes...)
+		th.Subtypes = append(th.Subtypes, h.Subtypes...)
+	}
+	th.Supertypes = dedupTickets(th.Supertypes)
+	th.Subtypes = dedupTickets(th.Subtypes)
+	emit(string(esrv.TypeHierarchyKey(kytheuri.ToString(src))), th)
+}
+
+// Callgraphs returns Kythe callers and callees tables derived from the Kythe
+// input graph.  Each anchor with a ref/call edge is considered a call from the
+// anchor's parent(s) to the edge's target.  The beam.PCollections both have
+// elements of type KV<string, *srvpb.Callgraph>.
+func (k *KytheBeam) Callgraphs() (callers, callees beam.PCollection) {
+	s := k.s.Scope("Callgraphs")
+	callers, callees = beam.ParDo2(s, nodeToCallgraphs, k.nodes)
+	return beam.ParDo(s, groupCallgraph, beam.GroupByKey(s, callers)),
+		beam.ParDo(s, groupCallgraph, beam.GroupByKey(s, callees))
+}
+
+// nodeToCallgraphs emits a partial CALLER *srvpb.Callgraph for each callee and
+// a partial CALLEE *srvpb.Callgraph for each caller of n's ref/call edges.
+func nodeToCallgraphs(n *scpb.Node, emitCallers, emitCallees func(*spb.VName, *srvpb.Callgraph)) {
+	var parents, targets []*spb.VName
+	for _, e := range n.Edge {
+		kind := schema.GetEdgeKind(e)
+		if kind == edges.ChildOf {
+			parents = append(parents, e.Target)
+		} else if edges.IsCall(kind) {
+			targets = append(targets, e.Target)
+		}
+	}
+
+	for _, caller := range parents {
+		for _, callee := range targets {
+			emitCallers(callee, &srvpb.Callgraph{
+				Type:    srvpb.Callgraph_CALLER,
+				Tickets: []string{kytheuri.ToString(caller)},
+			})
+			emitCallees(caller, &srvpb.Callgraph{
+				Type:    srvpb.Callgraph_CALLEE,
+				Tickets: []string{kytheuri.ToString(callee)},
+			})
+		}
+	}
+}
+
+// groupCallgraph merges each partial *srvpb.Callgraph for a function into a
+// single *srvpb.Callgraph.
+func groupCallgraph(src *spb.VName, cs func(**srvpb.Callgraph) bool, emit func(string, *srvpb.Callgraph)) {
+	cg := &srvpb.Callgraph{}
+	var c *srvpb.Callgraph
+	for cs(&c) {
+		cg.Type = c.Type
+		cg.Tickets = append(cg.Tickets, c.Tickets...)
+	}
+	cg.Tickets = dedupTickets(cg.Tickets)
+
+	key := esrv.CalleesKey
+	if cg.Type == srvpb.Callgraph_CALLER {
+		key = esrv.CallersKey
+	}
+	emit(string(key(kytheuri.ToString(src))), cg)
+}
+
+// Relatives returns Kythe parents and children tables derived from the Kythe
+// input graph.  The beam.PCollections both have elements of type
+// KV<string, *srvpb.Relatives>.
+func (k *KytheBeam) Relatives() (parents, children beam.PCollection) {
+	s := k.s.Scope("Relatives")
+	parents, children = beam.ParDo2(s, nodeToRelatives, k.nodes)
+	return beam.ParDo(s, groupRelatives, beam.GroupByKey(s, parents)),
+		beam.ParDo(s, groupRelatives, beam.GroupByKey(s, children))
+}
+
+// nodeToRelatives emits a partial PARENTS *srvpb.Relatives for n and a partial
+// CHILDREN *srvpb.Relatives for each of n's parents, unless n is an anchor.
+func nodeToRelatives(n *scpb.Node, emitParents, emitChildren func(*spb.VName, *srvpb.Relatives)) {
+	if n.GetKytheKind() == scpb.NodeKind_ANCHOR {
+		return // anchors are not considered relatives of semantic nodes
+	}
+	for _, e := range n.Edge {
+		if e.GetKytheKind() == scpb.EdgeKind_CHILD_OF {
+			emitParents(n.Source, &srvpb.Relatives{
+				Type:    srvpb.Relatives_PARENTS,
+				Tickets: []string{kytheuri.ToString(e.Target)},
+			})
+			emitChildren(e.Target, &srvpb.Relatives{
+				Type:    srvpb.Relatives_CHILDREN,
+				Tickets: []string{kytheuri.ToString(n.Source)},
+			})
+		}
+	}
+}
+
+// groupRelatives merges each partial *srvpb.Relatives for a node into a single
+// *srvpb.Relatives.
+func groupRelatives(src *spb.VName, rs func(**srvpb.Relatives) bool, emit func(string, *srvpb.Relatives)) {
+	rel := &srvpb.Relatives{}
+	var r *srvpb.Relatives
+	for rs(&r) {
+		rel.Type = r.Type
+		rel.Tickets = append(rel.Tickets, r.Tickets...)
+	}
+	rel.Tickets = dedupTickets(rel.Tickets)
+
+	key := esrv.ChildrenKey
+	if rel.Type == srvpb.Relatives_PARENTS {
+		key = esrv.ParentsKey
+	}
+	emit(string(key(kytheuri.ToString(src))), rel)
+}
+
+// FunctionParameters returns a Kythe function parameters table derived from the
+// Kythe input graph.  The beam.PCollection has elements of type
+// KV<string, *srvpb.FunctionParameters>.
+func (k *KytheBeam) FunctionParameters() beam.PCollection {
+	s := k.s.Scope("FunctionParameters")
+
+	nodes := beam.ParDo(s, moveSourceToKey, k.nodes)
+	params := beam.ParDo(s, reverseEdge, beam.CoGroupByKey(s, nodes, beam.ParDo(s, nodeToParamEdges, k.nodes)))
+	return beam.ParDo(s, groupParameters, beam.CoGroupByKey(s, nodes, params))
+}
+
+// nodeToParamEdges emits an *scpb.Edge for each of n's param.N edges.  The key
+// for each *scpb.Edge is its Target VName.
+func nodeToParamEdges(n *scpb.Node, emit func(*spb.VName, *scpb.Edge)) {
+	for _, e := range n.Edge {
+		if e.GetKytheKind() == scpb.EdgeKind_PARAM {
+			emit(e.Target, &scpb.Edge{
+				Source:  n.Source,
+				Target:  e.Target,
+				Kind:    e.Kind,
+				Ordinal: e.Ordinal,
+			})
+		}
+	}
+}
+
+// groupParameters emits a *srvpb.FunctionParameters for each function with at
+// least one parameter.
+func groupParameters(src *spb.VName, nodeStream func(**scpb.Node) bool, paramStream func(**scpb.Edge) bool, emit func(string, *srvpb.FunctionParameters)) {
+	var params []*scpb.Edge
+	var e *scpb.Edge
+	for paramStream(&e) {
+		params = append(params, e)
+	}
+	if len(params) == 0 {
+		return
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return compare.Compare(params[i].Ordinal, params[j].Ordinal).
+			AndThen(kytheuri.ToString(params[i].TargetNode.Source), kytheuri.ToString(params[j].TargetNode.Source)) == compare.LT
+	})
+
+	fp := &srvpb.FunctionParameters{}
+	var node *scpb.Node
+	if nodeStream(&node) {
+		node.Source = src
+		fp.Function = filterExploreFacts(convertPipelineNode(node))
+	} else {
+		fp.Function = &srvpb.Node{Ticket: kytheuri.ToString(src)}
+	}
+	for _, p := range params {
+		fp.Parameters = append(fp.Parameters, filterExploreFacts(convertPipelineNode(p.TargetNode)))
+	}
+
+	emit(string(esrv.ParametersKey(fp.Function.Ticket)), fp)
+}
+
+// Identifiers returns a Kythe identifiers table derived from the Kythe input
+// graph along with its n-gram search index.  Each node's qualified name is
+// rendered from its /kythe/code fact.  The beam.PCollections have elements of
+// type KV<string, *srvpb.IdentifierMatch> and KV<string,
+// *srvpb.IdentifierNgram>, respectively.
+func (k *KytheBeam) Identifiers() (matches, ngrams beam.PCollection) {
+	s := k.s.Scope("Identifiers")
+	matches, entries := beam.ParDo2(s, groupIdentifiers, beam.GroupByKey(s, beam.ParDo(s, nodeToIdentifier, k.nodes)))
+	return matches, beam.ParDo(s, groupIdentifierNgrams, beam.GroupByKey(s, entries))
+}
+
+// nodeToIdentifier emits a partial *srvpb.IdentifierMatch, keyed by its
+// qualified name, for each node with a /kythe/code fact.
+func nodeToIdentifier(n *scpb.Node, emit func(string, *srvpb.IdentifierMatch)) error {
+	for _, f := range n.Fact {
+		if f.GetKytheName() == scpb.FactName_CODE {
+			var ms cpb.MarkedSource
+			if err := proto.Unmarshal(f.Value, &ms); err != nil {
+				return err
+			}
+			if m := identifierMatch(kytheuri.ToString(n.Source), schema.GetNodeKind(n), schema.GetSubkind(n), &ms); m != nil {
+				emit(m.QualifiedName, m)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// groupIdentifiers merges each partial *srvpb.IdentifierMatch for a qualified
+// name into a single *srvpb.IdentifierMatch and emits an n-gram index entry
+// for each of its n-grams.
+func groupIdentifiers(qname string, ms func(**srvpb.IdentifierMatch) bool, emit func(string, *srvpb.IdentifierMatch), emitNgram func(string, *srvpb.IdentifierNgram_Identifier)) {
+	im := &srvpb.IdentifierMatch{QualifiedName: qname}
+	var m *srvpb.IdentifierMatch
+	for ms(&m) {
+		if im.BaseName == "" {
+			im.BaseName = m.BaseName
+		}
+		im.Node = append(im.Node, m.Node...)
+	}
+	sort.Slice(im.Node, func(i, j int) bool { return im.Node[i].Ticket < im.Node[j].Ticket })
+	emit(string(identifiers.IdentifierKey(qname)), im)
+
+	for _, g := range identifierNgrams(im) {
+		emitNgram(g, &srvpb.IdentifierNgram_Identifier{
+			QualifiedName: im.QualifiedName,
+			BaseName:      im.BaseName,
+		})
+	}
+}
+
+// groupIdentifierNgrams emits the chunks of the *srvpb.IdentifierNgram posting
+// list for each n-gram.
+func groupIdentifierNgrams(ngram string, ids func(**srvpb.IdentifierNgram_Identifier) bool, emit func(string, *srvpb.IdentifierNgram)) {
+	var list []*srvpb.IdentifierNgram_Identifier
+	var id *srvpb.IdentifierNgram_Identifier
+	for ids(&id) {
+		list = append(list, id)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].QualifiedName < list[j].QualifiedName })
+	for chunk := 0; len(list) > 0; chunk++ {
+		n := identifiers.NgramChunkSize
+		if n > len(list) {
+			n = len(list)
+		}
+		emit(string(identifiers.NgramKey(ngram, chunk)), &srvpb.IdentifierNgram{Identifier: list[:n]})
+		list = list[n:]
+	}
+}
+
 func (k *KytheBeam) getMarkedSources() beam.PCollection {
 	if !k.markedSources.IsValid() {
 		s := k.s.Scope("MarkedSources")
@@ -685,11 +1330,22 @@ func (k *KytheBeam) getMarkedSources() beam.PCollection {
 // *srvpb.Document>.
 func (k *KytheBeam) Documents() beam.PCollection {
 	s := k.s.Scope("Documents")
+	return k.documents(s)
+}
 
+// SplitDocuments returns a columnar Kythe documentation table derived from the
+// Kythe input graph.  The beam.PCollection has elements of type
+// KV<[]byte, []byte>.
+func (k *KytheBeam) SplitDocuments() beam.PCollection {
+	s := k.s.Scope("SplitDocuments")
+	return beam.ParDo(s, encodeDocument, k.documents(s))
+}
+
+func (k *KytheBeam) documents(s beam.Scope) beam.PCollection {
 	docs := beam.Seq(s, k.nodes, &nodes.Filter{
 		FilterByKind: []string{kinds.Doc},
 		IncludeFacts: []string{facts.Text},
-		IncludeEdges: []string{edges.Documents},
+		IncludeEdges: []string{edges.Documents, edges.Param},
 	}, nodeToDocs)
 	markedSources := k.getMarkedSources()
 	children := beam.Seq(s, k.nodes, &nodes.Filter{
@@ -731,6 +1387,19 @@ func nodeToDocs(n *scpb.Node, emit func(*spb.VName, *srvpb.Document)) {
 		}
 	}
 
+	// The ith link in the document text refers to the target of the doc node's
+	// param edge with ordinal i.
+	var params []*scpb.Edge
+	for _, e := range n.Edge {
+		if e.GetKytheKind() == scpb.EdgeKind_PARAM {
+			params = append(params, e)
+		}
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i].Ordinal < params[j].Ordinal })
+	for _, e := range params {
+		d.Link = append(d.Link, &cpb.Link{Definition: []string{kytheuri.ToString(e.Target)}})
+	}
+
 	for _, e := range n.Edge {
 		if e.GetKytheKind() == scpb.EdgeKind_DOCUMENTS {
 			emit(e.Target, d)
@@ -479,6 +479,12 @@ func TestCrossReferences(t *testing.T) {
 	}, {
 		SourceTicket: "kythe:#node2",
 		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind: "#internal/ref/call/direct",
+			Caller: []*srvpb.PagedCrossReferences_Caller{{
+				SemanticCaller: "kythe:?path=path#anchor2_parent",
+				Callsite:       []*srvpb.ExpandedAnchor{testRefs[2].Anchor},
+			}},
+		}, {
 			Kind: "/kythe/edge/ref/call",
 			Anchor: []*srvpb.ExpandedAnchor{{
 				Ticket: "kythe:?path=path#anchor2",
@@ -511,7 +517,267 @@ func TestCrossReferences(t *testing.T) {
 	}}
 
 	p, s, refs := ptest.CreateList(testRefs)
-	k := &KytheBeam{s: s, refs: refs}
+	nodes := beam.CreateList(s, []*scpb.Node{{Source: &spb.VName{Signature: "node1"}}})
+	k := &KytheBeam{s: s, refs: refs, nodes: nodes}
+	sets, _ := k.CrossReferences()
+	debug.Print(s, sets)
+	passert.Equals(s, beam.DropKey(s, sets), beam.CreateList(s, expectedSets))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestCrossReferences_relatedNodes(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "method"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "class"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "class"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_RECORD},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_TEXT},
+			Value: []byte("large text"),
+		}, {
+			Name:  &scpb.Fact_GenericName{"/kythe/custom"},
+			Value: []byte("value"),
+		}},
+	}, {
+		Source: &spb.VName{Path: "path", Signature: "anchor"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_ANCHOR},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "class"},
+		}},
+	}}
+	classDef := &srvpb.ExpandedAnchor{
+		Ticket: "kythe:?path=path#classDef",
+		Span: &cpb.Span{
+			Start: &cpb.Point{ByteOffset: 6},
+			End:   &cpb.Point{ByteOffset: 11},
+		},
+	}
+	testRefs := []*ppb.Reference{{
+		Source: &spb.VName{Signature: "class"},
+		Kind:   &ppb.Reference_KytheKind{scpb.EdgeKind_DEFINES_BINDING},
+		Anchor: classDef,
+	}}
+
+	expectedSets := []*srvpb.PagedCrossReferences{{
+		SourceTicket: "kythe:#class",
+		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind: "%/kythe/edge/childof",
+			RelatedNode: []*srvpb.PagedCrossReferences_RelatedNode{{
+				Node: &srvpb.Node{
+					Ticket: "kythe:#method",
+					Fact: []*cpb.Fact{{
+						Name:  "/kythe/node/kind",
+						Value: []byte("function"),
+					}},
+				},
+			}},
+		}, {
+			Kind:   "/kythe/edge/defines/binding",
+			Anchor: []*srvpb.ExpandedAnchor{classDef},
+		}},
+	}, {
+		SourceTicket: "kythe:#method",
+		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind: "/kythe/edge/childof",
+			RelatedNode: []*srvpb.PagedCrossReferences_RelatedNode{{
+				Node: &srvpb.Node{
+					Ticket: "kythe:#class",
+					Fact: []*cpb.Fact{{
+						Name:  "/kythe/custom",
+						Value: []byte("value"),
+					}, {
+						Name:  "/kythe/node/kind",
+						Value: []byte("record"),
+					}},
+					DefinitionLocation: classDef,
+				},
+			}},
+		}},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	k := &KytheBeam{s: s, nodes: nodes, refs: beam.CreateList(s, testRefs)}
+	sets, _ := k.CrossReferences()
+	debug.Print(s, sets)
+	passert.Equals(s, beam.DropKey(s, sets), beam.CreateList(s, expectedSets))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestCrossReferences_callers(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "callee"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+	}, {
+		Source: &spb.VName{Signature: "overrider"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_OVERRIDES},
+			Target: &spb.VName{Signature: "callee"},
+		}},
+	}}
+	callerDef := &srvpb.ExpandedAnchor{
+		Ticket: "kythe:?path=path#callerDef",
+		Span: &cpb.Span{
+			Start: &cpb.Point{ByteOffset: 5},
+			End:   &cpb.Point{ByteOffset: 11},
+		},
+	}
+	callsite := &srvpb.ExpandedAnchor{
+		Ticket: "kythe:?path=path#callsite",
+		Span: &cpb.Span{
+			Start: &cpb.Point{ByteOffset: 16},
+			End:   &cpb.Point{ByteOffset: 24},
+		},
+	}
+	testRefs := []*ppb.Reference{{
+		Source: &spb.VName{Signature: "caller"},
+		Kind:   &ppb.Reference_KytheKind{scpb.EdgeKind_DEFINES_BINDING},
+		Anchor: callerDef,
+	}, {
+		Source: &spb.VName{Signature: "callee"},
+		Kind:   &ppb.Reference_KytheKind{scpb.EdgeKind_REF_CALL},
+		Scope:  &spb.VName{Signature: "caller"},
+		Anchor: callsite,
+	}}
+
+	expectedSets := []*srvpb.PagedCrossReferences{{
+		SourceTicket: "kythe:#callee",
+		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind: "#internal/ref/call/direct",
+			Caller: []*srvpb.PagedCrossReferences_Caller{{
+				Caller:         callerDef,
+				SemanticCaller: "kythe:#caller",
+				Callsite:       []*srvpb.ExpandedAnchor{callsite},
+			}},
+		}, {
+			Kind: "%/kythe/edge/overrides",
+			RelatedNode: []*srvpb.PagedCrossReferences_RelatedNode{{
+				Node: &srvpb.Node{
+					Ticket: "kythe:#overrider",
+					Fact: []*cpb.Fact{{
+						Name:  "/kythe/node/kind",
+						Value: []byte("function"),
+					}},
+				},
+			}},
+		}, {
+			Kind:   "/kythe/edge/ref/call",
+			Anchor: []*srvpb.ExpandedAnchor{callsite},
+		}},
+	}, {
+		SourceTicket: "kythe:#caller",
+		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind:   "/kythe/edge/defines/binding",
+			Anchor: []*srvpb.ExpandedAnchor{callerDef},
+		}},
+	}, {
+		SourceTicket: "kythe:#overrider",
+		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind: "#internal/ref/call/override",
+			Caller: []*srvpb.PagedCrossReferences_Caller{{
+				Caller:         callerDef,
+				SemanticCaller: "kythe:#caller",
+				Callsite:       []*srvpb.ExpandedAnchor{callsite},
+			}},
+		}, {
+			Kind: "/kythe/edge/overrides",
+			RelatedNode: []*srvpb.PagedCrossReferences_RelatedNode{{
+				Node: &srvpb.Node{
+					Ticket: "kythe:#callee",
+					Fact: []*cpb.Fact{{
+						Name:  "/kythe/node/kind",
+						Value: []byte("function"),
+					}},
+				},
+			}},
+		}},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	k := &KytheBeam{s: s, nodes: nodes, refs: beam.CreateList(s, testRefs)}
+	sets, _ := k.CrossReferences()
+	debug.Print(s, sets)
+	passert.Equals(s, beam.DropKey(s, sets), beam.CreateList(s, expectedSets))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestCrossReferences_mergeWith(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "message", Language: "protobuf"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_RECORD},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_GENERATES},
+			Target: &spb.VName{Signature: "struct", Language: "go"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "struct", Language: "go"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_RECORD},
+	}}
+	ref := &srvpb.ExpandedAnchor{
+		Ticket: "kythe:?path=path#ref",
+		Span: &cpb.Span{
+			Start: &cpb.Point{ByteOffset: 6},
+			End:   &cpb.Point{ByteOffset: 12},
+		},
+	}
+	testRefs := []*ppb.Reference{{
+		Source: &spb.VName{Signature: "struct", Language: "go"},
+		Kind:   &ppb.Reference_KytheKind{scpb.EdgeKind_REF},
+		Anchor: ref,
+	}}
+
+	expectedSets := []*srvpb.PagedCrossReferences{{
+		SourceTicket: "kythe:?lang=go#struct",
+		MergeWith:    []string{"kythe:?lang=protobuf#message"},
+		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind: "%/kythe/edge/generates",
+			RelatedNode: []*srvpb.PagedCrossReferences_RelatedNode{{
+				Node: &srvpb.Node{
+					Ticket: "kythe:?lang=protobuf#message",
+					Fact: []*cpb.Fact{{
+						Name:  "/kythe/node/kind",
+						Value: []byte("record"),
+					}},
+				},
+			}},
+		}, {
+			Kind:   "/kythe/edge/ref",
+			Anchor: []*srvpb.ExpandedAnchor{ref},
+		}},
+	}, {
+		SourceTicket: "kythe:?lang=protobuf#message",
+		MergeWith:    []string{"kythe:?lang=go#struct"},
+		Group: []*srvpb.PagedCrossReferences_Group{{
+			Kind: "/kythe/edge/generates",
+			RelatedNode: []*srvpb.PagedCrossReferences_RelatedNode{{
+				Node: &srvpb.Node{
+					Ticket: "kythe:?lang=go#struct",
+					Fact: []*cpb.Fact{{
+						Name:  "/kythe/node/kind",
+						Value: []byte("record"),
+					}},
+				},
+			}},
+		}},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	k := &KytheBeam{s: s, nodes: nodes, refs: beam.CreateList(s, testRefs)}
 	sets, _ := k.CrossReferences()
 	debug.Print(s, sets)
 	passert.Equals(s, beam.DropKey(s, sets), beam.CreateList(s, expectedSets))
@@ -643,6 +909,47 @@ func TestDocuments_text(t *testing.T) {
 	}
 }
 
+func TestDocuments_links(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "doc1"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_DOC},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_TEXT},
+			Value: []byte("see [first] and [second]"),
+		}},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_DOCUMENTS},
+			Target: &spb.VName{Signature: "node1"},
+		}, {
+			Kind:    &scpb.Edge_KytheKind{scpb.EdgeKind_PARAM},
+			Target:  &spb.VName{Signature: "second"},
+			Ordinal: 1,
+		}, {
+			Kind:    &scpb.Edge_KytheKind{scpb.EdgeKind_PARAM},
+			Target:  &spb.VName{Signature: "first"},
+			Ordinal: 0,
+		}},
+	}}
+	expectedDocs := []*srvpb.Document{{
+		Ticket:  "kythe:#node1",
+		RawText: "see [first] and [second]",
+		Link: []*cpb.Link{{
+			Definition: []string{"kythe:#first"},
+		}, {
+			Definition: []string{"kythe:#second"},
+		}},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	docs := FromNodes(s, nodes).Documents()
+	debug.Print(s, docs)
+	passert.Equals(s, beam.DropKey(s, docs), beam.CreateList(s, expectedDocs))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
 func TestDocuments_children(t *testing.T) {
 	testNodes := []*scpb.Node{{
 		Source: &spb.VName{Signature: "child1"},
@@ -712,6 +1019,333 @@ func TestDocuments_markedSource(t *testing.T) {
 	}
 }
 
+func TestTypeHierarchies(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "impl"},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_SATISFIES},
+			Target: &spb.VName{Signature: "iface"},
+		}, {
+			Kind:   &scpb.Edge_GenericKind{"/kythe/edge/extends/public"},
+			Target: &spb.VName{Signature: "base"},
+		}, {
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "pkg"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "base"},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_SATISFIES},
+			Target: &spb.VName{Signature: "iface"},
+		}},
+	}}
+	expected := []*srvpb.TypeHierarchy{{
+		Supertypes: []string{"kythe:#base", "kythe:#iface"},
+	}, {
+		Supertypes: []string{"kythe:#iface"},
+		Subtypes:   []string{"kythe:#impl"},
+	}, {
+		Subtypes: []string{"kythe:#base", "kythe:#impl"},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	ths := FromNodes(s, nodes).TypeHierarchies()
+	debug.Print(s, ths)
+	passert.Equals(s, beam.DropKey(s, ths), beam.CreateList(s, expected))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestCallgraphs(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "anchor1"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_ANCHOR},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "caller"},
+		}, {
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_REF_CALL},
+			Target: &spb.VName{Signature: "callee1"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "anchor2"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_ANCHOR},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "caller"},
+		}, {
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_REF_CALL_IMPLICIT},
+			Target: &spb.VName{Signature: "callee2"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "anchor3"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_ANCHOR},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "caller"},
+		}, {
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_REF},
+			Target: &spb.VName{Signature: "notCalled"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "anchor4"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_ANCHOR},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "caller"},
+		}, {
+			Kind:   &scpb.Edge_GenericKind{"/kythe/edge/ref/call/dynamic"},
+			Target: &spb.VName{Signature: "possiblyCalled"},
+		}},
+	}}
+	expectedCallers := []*srvpb.Callgraph{{
+		Type:    srvpb.Callgraph_CALLER,
+		Tickets: []string{"kythe:#caller"},
+	}, {
+		Type:    srvpb.Callgraph_CALLER,
+		Tickets: []string{"kythe:#caller"},
+	}}
+	expectedCallees := []*srvpb.Callgraph{{
+		Type:    srvpb.Callgraph_CALLEE,
+		Tickets: []string{"kythe:#callee1", "kythe:#callee2"},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	callers, callees := FromNodes(s, nodes).Callgraphs()
+	debug.Print(s, callers)
+	debug.Print(s, callees)
+	passert.Equals(s, beam.DropKey(s, callers), beam.CreateList(s, expectedCallers))
+	passert.Equals(s, beam.DropKey(s, callees), beam.CreateList(s, expectedCallees))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestRelatives(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "child1"},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "parent"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "child2"},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "parent"},
+		}},
+	}, {
+		Source: &spb.VName{Signature: "anchor"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_ANCHOR},
+		Edge: []*scpb.Edge{{
+			Kind:   &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF},
+			Target: &spb.VName{Signature: "parent"},
+		}},
+	}}
+	expectedParents := []*srvpb.Relatives{{
+		Type:    srvpb.Relatives_PARENTS,
+		Tickets: []string{"kythe:#parent"},
+	}, {
+		Type:    srvpb.Relatives_PARENTS,
+		Tickets: []string{"kythe:#parent"},
+	}}
+	expectedChildren := []*srvpb.Relatives{{
+		Type:    srvpb.Relatives_CHILDREN,
+		Tickets: []string{"kythe:#child1", "kythe:#child2"},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	parents, children := FromNodes(s, nodes).Relatives()
+	debug.Print(s, parents)
+	debug.Print(s, children)
+	passert.Equals(s, beam.DropKey(s, parents), beam.CreateList(s, expectedParents))
+	passert.Equals(s, beam.DropKey(s, children), beam.CreateList(s, expectedChildren))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestFunctionParameters(t *testing.T) {
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "func"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_TEXT},
+			Value: []byte("dropped text"),
+		}},
+		Edge: []*scpb.Edge{{
+			Kind:    &scpb.Edge_KytheKind{scpb.EdgeKind_PARAM},
+			Target:  &spb.VName{Signature: "param1"},
+			Ordinal: 1,
+		}, {
+			Kind:    &scpb.Edge_KytheKind{scpb.EdgeKind_PARAM},
+			Target:  &spb.VName{Signature: "param0"},
+			Ordinal: 0,
+		}},
+	}, {
+		Source: &spb.VName{Signature: "param0"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_VARIABLE},
+	}}
+	expected := []*srvpb.FunctionParameters{{
+		Function: &srvpb.Node{
+			Ticket: "kythe:#func",
+			Fact: []*cpb.Fact{{
+				Name:  "/kythe/node/kind",
+				Value: []byte("function"),
+			}},
+		},
+		Parameters: []*srvpb.Node{{
+			Ticket: "kythe:#param0",
+			Fact: []*cpb.Fact{{
+				Name:  "/kythe/node/kind",
+				Value: []byte("variable"),
+			}},
+		}, {
+			Ticket: "kythe:#param1",
+		}},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	params := FromNodes(s, nodes).FunctionParameters()
+	debug.Print(s, params)
+	passert.Equals(s, beam.DropKey(s, params), beam.CreateList(s, expected))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	code := func(pkg, name string) []byte {
+		rec, err := proto.Marshal(&cpb.MarkedSource{
+			Kind: cpb.MarkedSource_BOX,
+			Child: []*cpb.MarkedSource{{
+				Kind:          cpb.MarkedSource_CONTEXT,
+				PostChildText: ".",
+				Child: []*cpb.MarkedSource{{
+					Kind:    cpb.MarkedSource_IDENTIFIER,
+					PreText: pkg,
+				}},
+			}, {
+				Kind:    cpb.MarkedSource_IDENTIFIER,
+				PreText: name,
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "func", Language: "go"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_CODE},
+			Value: code("pkg", "Foo"),
+		}},
+	}, {
+		Source:  &spb.VName{Signature: "type", Language: "go"},
+		Kind:    &scpb.Node_KytheKind{scpb.NodeKind_RECORD},
+		Subkind: &scpb.Node_KytheSubkind{scpb.Subkind_CLASS},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_CODE},
+			Value: code("pkg", "Foo"),
+		}},
+	}, {
+		Source: &spb.VName{Signature: "bar"},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_CODE},
+			Value: code("other", "Bar"),
+		}},
+	}, {
+		Source: &spb.VName{Signature: "no_code"},
+		Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+	}}
+	expected := []*srvpb.IdentifierMatch{{
+		QualifiedName: "pkg.Foo",
+		BaseName:      "Foo",
+		Node: []*srvpb.IdentifierMatch_Node{{
+			Ticket:   "kythe:?lang=go#func",
+			NodeKind: "function",
+		}, {
+			Ticket:      "kythe:?lang=go#type",
+			NodeKind:    "record",
+			NodeSubkind: "class",
+		}},
+	}, {
+		QualifiedName: "other.Bar",
+		BaseName:      "Bar",
+		Node: []*srvpb.IdentifierMatch_Node{{
+			Ticket: "kythe:#bar",
+		}},
+	}}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	ids, _ := FromNodes(s, nodes).Identifiers()
+	debug.Print(s, ids)
+	passert.Equals(s, beam.DropKey(s, ids), beam.CreateList(s, expected))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestIdentifiers_ngrams(t *testing.T) {
+	rec, err := proto.Marshal(&cpb.MarkedSource{
+		Kind: cpb.MarkedSource_BOX,
+		Child: []*cpb.MarkedSource{{
+			Kind:          cpb.MarkedSource_CONTEXT,
+			PostChildText: ".",
+			Child: []*cpb.MarkedSource{{
+				Kind:    cpb.MarkedSource_IDENTIFIER,
+				PreText: "p",
+			}},
+		}, {
+			Kind:    cpb.MarkedSource_IDENTIFIER,
+			PreText: "Ab",
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testNodes := []*scpb.Node{{
+		Source: &spb.VName{Signature: "node1"},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_CODE},
+			Value: rec,
+		}},
+	}, {
+		Source: &spb.VName{Signature: "node2"},
+		Fact: []*scpb.Fact{{
+			Name:  &scpb.Fact_KytheName{scpb.FactName_CODE},
+			Value: rec,
+		}},
+	}}
+	idx := &srvpb.IdentifierNgram{
+		Identifier: []*srvpb.IdentifierNgram_Identifier{{
+			QualifiedName: "p.Ab",
+			BaseName:      "Ab",
+		}},
+	}
+	// ".ab", "^a", "^ab", "^p", "p.a"
+	expected := []*srvpb.IdentifierNgram{idx, idx, idx, idx, idx}
+
+	p, s, nodes := ptest.CreateList(testNodes)
+	_, ngrams := FromNodes(s, nodes).Identifiers()
+	debug.Print(s, ngrams)
+	passert.Equals(s, beam.DropKey(s, ngrams), beam.CreateList(s, expected))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
 func TestFileTree_registrations(t *testing.T) {
 	testNodes := []*scpb.Node{{}}
 	p, s, nodes := ptest.CreateList(testNodes)
@@ -752,3 +1386,38 @@ func TestDocuments_registrations(t *testing.T) {
 	FromNodes(s, nodes).Documents()
 	beamtest.CheckRegistrations(t, p)
 }
+
+func TestTypeHierarchies_registrations(t *testing.T) {
+	testNodes := []*scpb.Node{{}}
+	p, s, nodes := ptest.CreateList(testNodes)
+	FromNodes(s, nodes).TypeHierarchies()
+	beamtest.CheckRegistrations(t, p)
+}
+
+func TestCallgraphs_registrations(t *testing.T) {
+	testNodes := []*scpb.Node{{}}
+	p, s, nodes := ptest.CreateList(testNodes)
+	FromNodes(s, nodes).Callgraphs()
+	beamtest.CheckRegistrations(t, p)
+}
+
+func TestRelatives_registrations(t *testing.T) {
+	testNodes := []*scpb.Node{{}}
+	p, s, nodes := ptest.CreateList(testNodes)
+	FromNodes(s, nodes).Relatives()
+	beamtest.CheckRegistrations(t, p)
+}
+
+func TestFunctionParameters_registrations(t *testing.T) {
+	testNodes := []*scpb.Node{{}}
+	p, s, nodes := ptest.CreateList(testNodes)
+	FromNodes(s, nodes).FunctionParameters()
+	beamtest.CheckRegistrations(t, p)
+}
+
+func TestIdentifiers_registrations(t *testing.T) {
+	testNodes := []*scpb.Node{{}}
+	p, s, nodes := ptest.CreateList(testNodes)
+	FromNodes(s, nodes).Identifiers()
+	beamtest.CheckRegistrations(t, p)
+}
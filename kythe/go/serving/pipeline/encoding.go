@@ -36,9 +36,15 @@ import (
 func init() {
 	beam.RegisterFunction(encodeCrossRef)
 	beam.RegisterFunction(encodeDecorPiece)
-	beam.RegisterFunction(refToCrossRef)
+	beam.RegisterFunction(encodeDocument)
+	beam.RegisterFunction(encodeEdges)
 	beam.RegisterFunction(nodeToCrossRef)
+	beam.RegisterFunction(nodeToEdgesIndex)
+	beam.RegisterFunction(refToCrossRef)
+	beam.RegisterFunction(reverseEdgeToEdges)
+	beam.RegisterFunction(targetToEdges)
 	beam.RegisterType(reflect.TypeOf((*xspb.CrossReferences)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*xspb.Edges)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*xspb.FileDecorations)(nil)).Elem())
 }
 
@@ -64,7 +70,7 @@ func refToCrossRef(r *ppb.Reference) *xspb.CrossReferences {
 	}
 }
 
-func nodeToCrossRef(key *spb.VName, nodeStream func(**scpb.Node) bool, msStream func(**cpb.MarkedSource) bool) *xspb.CrossReferences {
+func nodeToCrossRef(key *spb.VName, nodeStream func(**scpb.Node) bool, msStream func(**cpb.MarkedSource) bool, mergeStream func(**spb.VName) bool) *xspb.CrossReferences {
 	var n *scpb.Node
 	var ms *cpb.MarkedSource
 	nodeStream(&n)
@@ -74,6 +80,7 @@ func nodeToCrossRef(key *spb.VName, nodeStream func(**scpb.Node) bool, msStream
 		Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
 			Node:         n,
 			MarkedSource: ms,
+			MergeWith:    readMerges(mergeStream),
 		}},
 	}
 }
@@ -190,3 +197,130 @@ func encodeDecorDef(file *spb.VName, def *ppb.DecorationPiece_Definition, emit f
 	emit(e.Key, e.Value)
 	return nil
 }
+
+func encodeDocument(_ string, d *srvpb.Document, emit func([]byte, []byte)) error {
+	// TODO(schroederc): use VNames throughout pipeline
+	src, err := kytheuri.ToVName(d.Ticket)
+	if err != nil {
+		return err
+	}
+	idx := &xspb.Documentation_Index{
+		MarkedSource: d.MarkedSource,
+		RawText:      d.RawText,
+		Link:         d.Link,
+	}
+	if d.DocumentedBy != "" {
+		idx.DocumentedBy, err = kytheuri.ToVName(d.DocumentedBy)
+		if err != nil {
+			return err
+		}
+	}
+	entries := []*xspb.Documentation{{
+		Source: src,
+		Entry:  &xspb.Documentation_Index_{idx},
+	}}
+	for _, child := range d.ChildTicket {
+		c, err := kytheuri.ToVName(child)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, &xspb.Documentation{
+			Source: src,
+			Entry:  &xspb.Documentation_Child_{&xspb.Documentation_Child{Child: c}},
+		})
+	}
+	for _, n := range d.Node {
+		entries = append(entries, &xspb.Documentation{
+			Source: src,
+			Entry:  &xspb.Documentation_LinkNode_{&xspb.Documentation_LinkNode{Node: n}},
+		})
+	}
+
+	for _, doc := range entries {
+		kv, err := columnar.EncodeDocumentationEntry(columnar.DocumentationKeyPrefix, doc)
+		if err != nil {
+			return err
+		}
+		emit(kv.Key, kv.Value)
+	}
+	return nil
+}
+
+func encodeEdges(e *xspb.Edges, emit func([]byte, []byte)) error {
+	kv, err := columnar.EncodeEdgesEntry(columnar.EdgesKeyPrefix, e)
+	if err != nil {
+		return err
+	}
+	emit(kv.Key, kv.Value)
+	return nil
+}
+
+// nodeToEdgesIndex returns the columnar Edges index for the given node.
+func nodeToEdgesIndex(n *scpb.Node) *xspb.Edges {
+	return &xspb.Edges{
+		Source: n.Source,
+		Entry:  &xspb.Edges_Index_{&xspb.Edges_Index{Node: withoutEdges(n)}},
+	}
+}
+
+// targetToEdges emits a columnar Edges edge and target node for each
+// *scpb.Edge to the given target.
+func targetToEdges(target *spb.VName, nodeStream func(**scpb.Node) bool, edgeStream func(**scpb.Edge) bool, emit func(*xspb.Edges)) {
+	var node *scpb.Node
+	if nodeStream(&node) {
+		node = withoutEdges(node)
+		node.Source = target
+	} else {
+		node = &scpb.Node{Source: target}
+	}
+
+	var e *scpb.Edge
+	for edgeStream(&e) {
+		emit(&xspb.Edges{
+			Source: e.Source,
+			Entry:  &xspb.Edges_Edge_{columnarEdge(e, target, false)},
+		})
+		emit(&xspb.Edges{
+			Source: e.Source,
+			Entry:  &xspb.Edges_Target_{&xspb.Edges_Target{Node: node}},
+		})
+	}
+}
+
+// reverseEdgeToEdges emits a columnar Edges reverse edge and target node for
+// the given *scpb.Edge with its SourceNode populated.
+func reverseEdgeToEdges(target *spb.VName, e *scpb.Edge, emit func(*xspb.Edges)) {
+	src := withoutEdges(e.SourceNode)
+	emit(&xspb.Edges{
+		Source: target,
+		Entry:  &xspb.Edges_Edge_{columnarEdge(e, src.Source, true)},
+	})
+	emit(&xspb.Edges{
+		Source: target,
+		Entry:  &xspb.Edges_Target_{&xspb.Edges_Target{Node: src}},
+	})
+}
+
+func columnarEdge(e *scpb.Edge, target *spb.VName, reverse bool) *xspb.Edges_Edge {
+	ce := &xspb.Edges_Edge{
+		Target:  target,
+		Ordinal: e.Ordinal,
+		Reverse: reverse,
+	}
+	if k := e.GetGenericKind(); k != "" {
+		ce.Kind = &xspb.Edges_Edge_GenericKind{k}
+	} else {
+		ce.Kind = &xspb.Edges_Edge_KytheKind{e.GetKytheKind()}
+	}
+	return ce
+}
+
+// withoutEdges returns a copy of n without its edges.
+func withoutEdges(n *scpb.Node) *scpb.Node {
+	return &scpb.Node{
+		Source:  n.Source,
+		Kind:    n.Kind,
+		Subkind: n.Subkind,
+		Fact:    n.Fact,
+	}
+}
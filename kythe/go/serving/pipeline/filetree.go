@@ -23,16 +23,21 @@ import (
 	"sort"
 
 	"kythe.io/kythe/go/serving/pipeline/nodes"
+	"kythe.io/kythe/go/serving/xrefs/columnar"
 	"kythe.io/kythe/go/util/kytheuri"
 
 	"github.com/apache/beam/sdks/go/pkg/beam"
+	"github.com/golang/protobuf/proto"
 
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
+	xspb "kythe.io/kythe/proto/xref_serving_go_proto"
 )
 
 func init() {
 	beam.RegisterFunction(addCorpusRootsKey)
+	beam.RegisterFunction(encodeCorpusRoots)
+	beam.RegisterFunction(fileToColumnarDirectories)
 	beam.RegisterFunction(fileToCorpusRoot)
 	beam.RegisterFunction(fileToDirectories)
 
@@ -71,6 +76,19 @@ func (k *KytheBeam) Directories() beam.PCollection {
 	return beam.CombinePerKey(s, &combineDirectories{}, beam.ParDo(s, fileToDirectories, files))
 }
 
+// SplitDirectories returns a columnar Kythe FileTree table, including the
+// table's corpus roots, derived from the Kythe input graph.  The
+// beam.PCollection has elements of type KV<[]byte, []byte>.
+func (k *KytheBeam) SplitDirectories() beam.PCollection {
+	s := k.s.Scope("SplitDirectories")
+	files := k.getFileVNames()
+	roots := beam.Combine(s, &combineCorpusRoots{}, beam.ParDo(s, fileToCorpusRoot, files))
+	return beam.Flatten(s,
+		beam.ParDo(s, encodeCorpusRoots, roots),
+		beam.ParDo(s, fileToColumnarDirectories, files),
+	)
+}
+
 // addCorpusRootsKey returns the given value with the Kythe corpus roots key constant.
 func addCorpusRootsKey(val beam.T) (string, beam.T) { return "dirs:corpusRoots", val }
 
@@ -97,6 +115,46 @@ func fileToDirectories(file *spb.VName, emit func(string, *srvpb.FileDirectory))
 	}
 }
 
+// fileToColumnarDirectories emits a columnar FileDirectory entry for each path
+// component in the given file VName.
+func fileToColumnarDirectories(file *spb.VName, emit func([]byte, []byte)) error {
+	// Clean the file path and remove any leading slash.
+	path := filepath.Clean(filepath.Join("/", file.GetPath()))[1:]
+
+	dir := columnar.DirectoryVName(file.Corpus, file.Root, currentAsEmpty(filepath.Dir(path)))
+	entries := []*xspb.FileDirectory{{
+		Directory: dir,
+		Entry:     &xspb.FileDirectory_File_{&xspb.FileDirectory_File{File: file}},
+	}}
+	for dir.Path != "" {
+		parent := columnar.DirectoryVName(dir.Corpus, dir.Root, currentAsEmpty(filepath.Dir(dir.Path)))
+		entries = append(entries, &xspb.FileDirectory{
+			Directory: parent,
+			Entry:     &xspb.FileDirectory_Subdirectory_{&xspb.FileDirectory_Subdirectory{Subdirectory: dir}},
+		})
+		dir = parent
+	}
+
+	for _, e := range entries {
+		kv, err := columnar.EncodeDirectoryEntry(columnar.DirectoryKeyPrefix, e)
+		if err != nil {
+			return err
+		}
+		emit(kv.Key, kv.Value)
+	}
+	return nil
+}
+
+// encodeCorpusRoots returns the columnar key-value entry for the given
+// CorpusRoots.
+func encodeCorpusRoots(cr *srvpb.CorpusRoots) ([]byte, []byte, error) {
+	val, err := proto.Marshal(cr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return columnar.CorpusRootsKey, val, nil
+}
+
 func currentAsEmpty(p string) string {
 	if p == "." {
 		return ""
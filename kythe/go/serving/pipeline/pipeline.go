@@ -29,22 +29,27 @@ import (
 
 	"kythe.io/kythe/go/services/filetree"
 	"kythe.io/kythe/go/services/graphstore"
+	esrv "kythe.io/kythe/go/serving/explore"
 	ftsrv "kythe.io/kythe/go/serving/filetree"
 	gsrv "kythe.io/kythe/go/serving/graph"
+	"kythe.io/kythe/go/serving/identifiers"
 	xsrv "kythe.io/kythe/go/serving/xrefs"
 	"kythe.io/kythe/go/serving/xrefs/assemble"
 	"kythe.io/kythe/go/storage/keyvalue"
 	"kythe.io/kythe/go/storage/stream"
 	"kythe.io/kythe/go/storage/table"
 	"kythe.io/kythe/go/util/disksort"
+	"kythe.io/kythe/go/util/markedsource"
 	"kythe.io/kythe/go/util/schema/edges"
 	"kythe.io/kythe/go/util/schema/facts"
 	"kythe.io/kythe/go/util/schema/nodes"
 	"kythe.io/kythe/go/util/sortutil"
 	"kythe.io/kythe/go/util/span"
 
+	"bitbucket.org/creachadair/stringset"
 	"github.com/golang/protobuf/proto"
 
+	cpb "kythe.io/kythe/proto/common_go_proto"
 	ftpb "kythe.io/kythe/proto/filetree_go_proto"
 	ipb "kythe.io/kythe/proto/internal_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
@@ -117,8 +122,10 @@ func Run(ctx context.Context, rd stream.EntryReader, db keyvalue.DB, opts *Optio
 	}
 
 	pesIn, dIn := make(chan *srvpb.Edge, chBuf), make(chan *srvpb.Edge, chBuf)
-	var pErr, fErr error
-	wg.Add(2)
+	exIn, cgIn := make(chan *srvpb.Edge, chBuf), make(chan *srvpb.Edge, chBuf)
+	idIn := make(chan *srvpb.Edge, chBuf)
+	var pErr, fErr, eErr, gErr, iErr error
+	wg.Add(5)
 	go func() {
 		defer wg.Done()
 		if err := writePagedEdges(ctx, pesIn, out.xs, opts); err != nil {
@@ -131,15 +138,39 @@ func Run(ctx context.Context, rd stream.EntryReader, db keyvalue.DB, opts *Optio
 			fErr = fmt.Errorf("error writing file decorations: %v", err)
 		}
 	}()
+	go func() {
+		defer wg.Done()
+		if err := writeExploreTables(ctx, exIn, out.xs); err != nil {
+			eErr = fmt.Errorf("error writing explore tables: %v", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := writeCallgraphs(ctx, opts, cgIn, out.xs); err != nil {
+			gErr = fmt.Errorf("error writing callgraphs: %v", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := writeIdentifiers(ctx, opts, idIn, out.xs); err != nil {
+			iErr = fmt.Errorf("error writing identifiers: %v", err)
+		}
+	}()
 
 	err := sortedEdges.Read(func(x interface{}) error {
 		e := x.(*srvpb.Edge)
 		pesIn <- e
 		dIn <- e
+		exIn <- e
+		cgIn <- e
+		idIn <- e
 		return nil
 	})
 	close(pesIn)
 	close(dIn)
+	close(exIn)
+	close(cgIn)
+	close(idIn)
 	if err != nil {
 		return fmt.Errorf("error reading edges table: %v", err)
 	}
@@ -147,6 +178,12 @@ func Run(ctx context.Context, rd stream.EntryReader, db keyvalue.DB, opts *Optio
 	wg.Wait()
 	if pErr != nil {
 		return pErr
+	} else if eErr != nil {
+		return eErr
+	} else if gErr != nil {
+		return gErr
+	} else if iErr != nil {
+		return iErr
 	}
 	return fErr
 }
@@ -333,6 +370,431 @@ func writePagedEdges(ctx context.Context, edges <-chan *srvpb.Edge, out table.Pr
 	return buffer.Flush(ctx)
 }
 
+// isTypeHierarchyEdge reports whether kind relates a node to one of its
+// direct supertypes (or overridden methods).
+func isTypeHierarchyEdge(kind string) bool {
+	return edges.IsVariant(kind, edges.Extends) || kind == edges.Satisfies || kind == edges.Overrides
+}
+
+// writeExploreTables writes the relatives, type hierarchy, and function
+// parameters explore tables for each edge source.  The given edges are expected
+// to be grouped by their source ticket.
+func writeExploreTables(ctx context.Context, edgesIn <-chan *srvpb.Edge, out table.Proto) error {
+	buffer := out.Buffered()
+	log.Println("Writing explore tables")
+
+	var n *exploreNode
+	for e := range edgesIn {
+		if n == nil || e.Source.Ticket != n.ticket {
+			if err := n.write(ctx, buffer); err != nil {
+				for range edgesIn {
+				} // drain input channel
+				return err
+			}
+			n = &exploreNode{ticket: e.Source.Ticket}
+		}
+		n.add(e)
+	}
+
+	if err := n.write(ctx, buffer); err != nil {
+		return err
+	}
+	return buffer.Flush(ctx)
+}
+
+// exploreNode accumulates the explore table data for a single edge source.
+type exploreNode struct {
+	ticket string
+	node   *srvpb.Node
+
+	parents, children    []string
+	supertypes, subtypes []string
+	params               []*srvpb.Node
+}
+
+func (n *exploreNode) add(e *srvpb.Edge) {
+	if e.Target == nil {
+		n.node = e.Source
+		return
+	}
+
+	kind := edges.Canonical(e.Kind)
+	switch {
+	case kind == edges.ChildOf:
+		// Anchors are not considered relatives of semantic nodes.
+		if edges.IsForward(e.Kind) {
+			if !isAnchor(n.node) {
+				n.parents = append(n.parents, e.Target.Ticket)
+			}
+		} else if !isAnchor(e.Target) {
+			n.children = append(n.children, e.Target.Ticket)
+		}
+	case isTypeHierarchyEdge(kind):
+		if edges.IsForward(e.Kind) {
+			n.supertypes = append(n.supertypes, e.Target.Ticket)
+		} else {
+			n.subtypes = append(n.subtypes, e.Target.Ticket)
+		}
+	case kind == edges.Param && edges.IsForward(e.Kind):
+		n.params = append(n.params, filterExploreFacts(e.Target))
+	}
+}
+
+func (n *exploreNode) write(ctx context.Context, t table.BufferedProto) error {
+	if n == nil {
+		return nil
+	}
+
+	if len(n.parents) > 0 {
+		if err := t.Put(ctx, esrv.ParentsKey(n.ticket), &srvpb.Relatives{
+			Tickets: dedupTickets(n.parents),
+			Type:    srvpb.Relatives_PARENTS,
+		}); err != nil {
+			return err
+		}
+	}
+	if len(n.children) > 0 {
+		if err := t.Put(ctx, esrv.ChildrenKey(n.ticket), &srvpb.Relatives{
+			Tickets: dedupTickets(n.children),
+			Type:    srvpb.Relatives_CHILDREN,
+		}); err != nil {
+			return err
+		}
+	}
+	if len(n.supertypes) > 0 || len(n.subtypes) > 0 {
+		if err := t.Put(ctx, esrv.TypeHierarchyKey(n.ticket), &srvpb.TypeHierarchy{
+			Supertypes: dedupTickets(n.supertypes),
+			Subtypes:   dedupTickets(n.subtypes),
+		}); err != nil {
+			return err
+		}
+	}
+	if len(n.params) > 0 {
+		fn := &srvpb.Node{Ticket: n.ticket}
+		if n.node != nil {
+			fn = filterExploreFacts(n.node)
+		}
+		if err := t.Put(ctx, esrv.ParametersKey(n.ticket), &srvpb.FunctionParameters{
+			Function:   fn,
+			Parameters: n.params,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isAnchor reports whether n is known to be an anchor node.
+func isAnchor(n *srvpb.Node) bool {
+	for _, f := range n.GetFact() {
+		if f.Name == facts.NodeKind {
+			return string(f.Value) == nodes.Anchor
+		}
+	}
+	return false
+}
+
+// filterExploreFacts returns a copy of n with only the facts needed by the
+// explore service.
+func filterExploreFacts(n *srvpb.Node) *srvpb.Node {
+	res := &srvpb.Node{Ticket: n.Ticket}
+	for _, f := range n.Fact {
+		switch f.Name {
+		case facts.NodeKind, facts.Subkind, facts.Code:
+			res.Fact = append(res.Fact, f)
+		}
+	}
+	return res
+}
+
+// writeCallgraphs writes a *srvpb.Callgraph of callers and a *srvpb.Callgraph
+// of callees for each function that calls, or is called by, another function.
+// Each anchor with a ref/call edge is considered a call from the anchor's
+// parent(s) to the edge's target.  The given edges are expected to be grouped
+// by their source ticket.
+func writeCallgraphs(ctx context.Context, opts *Options, edgesIn <-chan *srvpb.Edge, out table.Proto) error {
+	defer func() {
+		for range edgesIn {
+		} // drain input channel
+	}()
+
+	log.Println("Writing Callgraphs")
+
+	calls, err := opts.diskSorter(edgeLesser{}, edgeMarshaler{})
+	if err != nil {
+		return err
+	}
+
+	var (
+		curTicket        string
+		parents, targets []string
+	)
+	addCalls := func() error {
+		for _, caller := range parents {
+			for _, callee := range targets {
+				if err := calls.Add(&srvpb.Edge{
+					Source: &srvpb.Node{Ticket: caller},
+					Kind:   edges.RefCall,
+					Target: &srvpb.Node{Ticket: callee},
+				}); err != nil {
+					return err
+				}
+				if err := calls.Add(&srvpb.Edge{
+					Source: &srvpb.Node{Ticket: callee},
+					Kind:   edges.Mirror(edges.RefCall),
+					Target: &srvpb.Node{Ticket: caller},
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		parents, targets = nil, nil
+		return nil
+	}
+
+	for e := range edgesIn {
+		if e.Source.Ticket != curTicket {
+			if err := addCalls(); err != nil {
+				return err
+			}
+			curTicket = e.Source.Ticket
+		}
+
+		if e.Target == nil {
+			continue
+		} else if e.Kind == edges.ChildOf {
+			parents = append(parents, e.Target.Ticket)
+		} else if edges.IsForward(e.Kind) && edges.IsCall(e.Kind) {
+			targets = append(targets, e.Target.Ticket)
+		}
+	}
+	if err := addCalls(); err != nil {
+		return err
+	}
+
+	buffer := out.Buffered()
+	var callers, callees *srvpb.Callgraph
+	flush := func() error {
+		if callers != nil {
+			callers.Tickets = dedupTickets(callers.Tickets)
+			if err := buffer.Put(ctx, esrv.CallersKey(curTicket), callers); err != nil {
+				return err
+			}
+		}
+		if callees != nil {
+			callees.Tickets = dedupTickets(callees.Tickets)
+			if err := buffer.Put(ctx, esrv.CalleesKey(curTicket), callees); err != nil {
+				return err
+			}
+		}
+		callers, callees = nil, nil
+		return nil
+	}
+
+	curTicket = ""
+	if err := calls.Read(func(x interface{}) error {
+		e := x.(*srvpb.Edge)
+		if e.Source.Ticket != curTicket {
+			if err := flush(); err != nil {
+				return err
+			}
+			curTicket = e.Source.Ticket
+		}
+
+		if edges.IsForward(e.Kind) {
+			if callees == nil {
+				callees = &srvpb.Callgraph{Type: srvpb.Callgraph_CALLEE}
+			}
+			callees.Tickets = append(callees.Tickets, e.Target.Ticket)
+		} else {
+			if callers == nil {
+				callers = &srvpb.Callgraph{Type: srvpb.Callgraph_CALLER}
+			}
+			callers.Tickets = append(callers.Tickets, e.Target.Ticket)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	return buffer.Flush(ctx)
+}
+
+// writeIdentifiers writes a *srvpb.IdentifierMatch for each qualified name
+// rendered from the /kythe/code facts of the given edge sources along with the
+// *srvpb.IdentifierNgram search index over their names.  The given edges are
+// expected to be grouped by their source ticket.
+func writeIdentifiers(ctx context.Context, opts *Options, edgesIn <-chan *srvpb.Edge, out table.Proto) error {
+	defer func() {
+		for range edgesIn {
+		} // drain input channel
+	}()
+
+	log.Println("Writing Identifiers")
+
+	matches, err := opts.diskSorter(identifierLesser{}, identifierMarshaler{})
+	if err != nil {
+		return err
+	}
+
+	for e := range edgesIn {
+		if e.Target != nil {
+			continue
+		}
+		m, err := nodeToIdentifierMatch(e.Source)
+		if err != nil {
+			return err
+		} else if m != nil {
+			if err := matches.Add(m); err != nil {
+				return err
+			}
+		}
+	}
+
+	ngrams, err := opts.diskSorter(ngramLesser{}, ngramMarshaler{})
+	if err != nil {
+		return err
+	}
+
+	buffer := out.Buffered()
+	var cur *srvpb.IdentifierMatch
+	flush := func() error {
+		if cur == nil {
+			return nil
+		}
+		for _, g := range identifierNgrams(cur) {
+			if err := ngrams.Add(&identifierNgram{
+				ngram: g,
+				identifier: &srvpb.IdentifierNgram_Identifier{
+					QualifiedName: cur.QualifiedName,
+					BaseName:      cur.BaseName,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		return buffer.Put(ctx, identifiers.IdentifierKey(cur.QualifiedName), cur)
+	}
+	if err := matches.Read(func(x interface{}) error {
+		m := x.(*srvpb.IdentifierMatch)
+		if cur != nil && cur.QualifiedName == m.QualifiedName {
+			cur.Node = append(cur.Node, m.Node...)
+			return nil
+		} else if err := flush(); err != nil {
+			return err
+		}
+		cur = m
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	var (
+		curNgram string
+		chunk    int
+		idx      *srvpb.IdentifierNgram
+	)
+	if err := ngrams.Read(func(x interface{}) error {
+		n := x.(*identifierNgram)
+		if idx != nil && n.ngram == curNgram && len(idx.Identifier) < identifiers.NgramChunkSize {
+			idx.Identifier = append(idx.Identifier, n.identifier)
+			return nil
+		} else if idx != nil {
+			if err := buffer.Put(ctx, identifiers.NgramKey(curNgram, chunk), idx); err != nil {
+				return err
+			}
+		}
+		if idx != nil && n.ngram == curNgram {
+			chunk++
+		} else {
+			curNgram, chunk = n.ngram, 0
+		}
+		idx = &srvpb.IdentifierNgram{Identifier: []*srvpb.IdentifierNgram_Identifier{n.identifier}}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if idx != nil {
+		if err := buffer.Put(ctx, identifiers.NgramKey(curNgram, chunk), idx); err != nil {
+			return err
+		}
+	}
+	return buffer.Flush(ctx)
+}
+
+// identifierNgrams returns the distinct n-grams indexing the base and qualified
+// names of m.
+func identifierNgrams(m *srvpb.IdentifierMatch) []string {
+	return dedupTickets(append(identifiers.Ngrams(m.BaseName), identifiers.Ngrams(m.QualifiedName)...))
+}
+
+// nodeToIdentifierMatch returns a *srvpb.IdentifierMatch for n based on its
+// /kythe/code fact.  nil is returned if n has no code fact or no identifier
+// can be rendered from it.
+func nodeToIdentifierMatch(n *srvpb.Node) (*srvpb.IdentifierMatch, error) {
+	var kind, subkind string
+	var ms *cpb.MarkedSource
+	for _, f := range n.Fact {
+		switch f.Name {
+		case facts.NodeKind:
+			kind = string(f.Value)
+		case facts.Subkind:
+			subkind = string(f.Value)
+		case facts.Code:
+			ms = new(cpb.MarkedSource)
+			if err := proto.Unmarshal(f.Value, ms); err != nil {
+				return nil, fmt.Errorf("error unmarshaling code for %q: %v", n.Ticket, err)
+			}
+		}
+	}
+	return identifierMatch(n.Ticket, kind, subkind, ms), nil
+}
+
+// identifierMatch returns a *srvpb.IdentifierMatch for the given node using
+// the qualified name rendered from ms.  Nodes without a rendered context are
+// matched by their base name alone.  nil is returned if ms is nil or has no
+// identifier.
+func identifierMatch(ticket, kind, subkind string, ms *cpb.MarkedSource) *srvpb.IdentifierMatch {
+	if ms == nil {
+		return nil
+	}
+	info := markedsource.RenderQualifiedName(ms)
+	if info.BaseName == "" {
+		return nil
+	}
+	qname := info.QualifiedName
+	if qname == "" {
+		qname = info.BaseName
+	}
+	return &srvpb.IdentifierMatch{
+		QualifiedName: qname,
+		BaseName:      info.BaseName,
+		Node: []*srvpb.IdentifierMatch_Node{{
+			Ticket:      ticket,
+			NodeKind:    kind,
+			NodeSubkind: subkind,
+		}},
+	}
+}
+
+// dedupTickets sorts and removes duplicates from the given tickets.
+func dedupTickets(tickets []string) []string {
+	sort.Strings(tickets)
+	var j int
+	for i, t := range tickets {
+		if i == 0 || t != tickets[j-1] {
+			tickets[j] = t
+			j++
+		}
+	}
+	return tickets[:j]
+}
+
 func e2e(e *srvpb.Edge) *srvpb.EdgeGroup_Edge {
 	return &srvpb.EdgeGroup_Edge{
 		Target:  e.Target,
@@ -359,7 +821,10 @@ func (fragmentLesser) Less(a, b interface{}) bool {
 	return x.fileTicket < y.fileTicket
 }
 
-func createDecorationFragments(ctx context.Context, edges <-chan *srvpb.Edge, fragments disksort.Interface) error {
+// createDecorationFragments adds the decoration fragments for each of the
+// given edges to fragments.  The targets of each source node's merge edges are
+// added to merges.
+func createDecorationFragments(ctx context.Context, edges <-chan *srvpb.Edge, fragments disksort.Interface, merges map[string]stringset.Set) error {
 	fdb := &assemble.DecorationFragmentBuilder{
 		Output: func(ctx context.Context, file string, fragment *srvpb.FileDecorations) error {
 			return fragments.Add(&decorationFragment{fileTicket: file, decoration: fragment})
@@ -367,6 +832,11 @@ func createDecorationFragments(ctx context.Context, edges <-chan *srvpb.Edge, fr
 	}
 
 	for e := range edges {
+		if e.Target != nil && isMergeEdge(e.Kind) {
+			m := merges[e.Source.Ticket]
+			m.Add(e.Target.Ticket)
+			merges[e.Source.Ticket] = m
+		}
 		if err := fdb.AddEdge(ctx, e); err != nil {
 			for range edges { // drain input channel
 			}
@@ -384,7 +854,13 @@ func writeDecorAndRefs(ctx context.Context, opts *Options, edges <-chan *srvpb.E
 	}
 
 	log.Println("Writing decoration fragments")
-	if err := createDecorationFragments(ctx, edges, fragments); err != nil {
+	// merges holds the merge_with targets of each node in memory until its
+	// cross-references are written, unlike the edges and fragments, which are
+	// spilled to disk.  Its size is proportional to the number of merge edges,
+	// which only a few nodes in a typical corpus have.  Entries are removed as
+	// their sets are written.
+	merges := make(map[string]stringset.Set)
+	if err := createDecorationFragments(ctx, edges, fragments, merges); err != nil {
 		return err
 	}
 
@@ -472,6 +948,8 @@ func writeDecorAndRefs(ctx context.Context, opts *Options, edges <-chan *srvpb.E
 	xb := &assemble.CrossReferencesBuilder{
 		MaxPageSize: opts.MaxPageSize,
 		Output: func(ctx context.Context, s *srvpb.PagedCrossReferences) error {
+			s.MergeWith = merges[s.SourceTicket].Elements()
+			delete(merges, s.SourceTicket)
 			return buffer.Put(ctx, xsrv.CrossReferencesKey(s.SourceTicket), s)
 		},
 		OutputPage: func(ctx context.Context, p *srvpb.PagedCrossReferences_Page) error {
@@ -506,6 +984,16 @@ func writeDecorAndRefs(ctx context.Context, opts *Options, edges <-chan *srvpb.E
 		return fmt.Errorf("error flushing cross-references: %v", err)
 	}
 
+	// Write the sets of merged nodes without any cross-references of their own.
+	for _, ticket := range stringset.FromKeys(merges).Elements() {
+		if err := buffer.Put(ctx, xsrv.CrossReferencesKey(ticket), &srvpb.PagedCrossReferences{
+			SourceTicket: ticket,
+			MergeWith:    merges[ticket].Elements(),
+		}); err != nil {
+			return err
+		}
+	}
+
 	return buffer.Flush(ctx)
 }
 
@@ -547,6 +1035,69 @@ func (edgeMarshaler) Unmarshal(rec []byte) (interface{}, error) {
 	return &e, proto.Unmarshal(rec, &e)
 }
 
+type identifierLesser struct{}
+
+func (identifierLesser) Less(a, b interface{}) bool {
+	x, y := a.(*srvpb.IdentifierMatch), b.(*srvpb.IdentifierMatch)
+	if x.QualifiedName == y.QualifiedName {
+		return x.Node[0].Ticket < y.Node[0].Ticket
+	}
+	return x.QualifiedName < y.QualifiedName
+}
+
+type identifierMarshaler struct{}
+
+func (identifierMarshaler) Marshal(x interface{}) ([]byte, error) {
+	return proto.Marshal(x.(proto.Message))
+}
+
+func (identifierMarshaler) Unmarshal(rec []byte) (interface{}, error) {
+	var m srvpb.IdentifierMatch
+	return &m, proto.Unmarshal(rec, &m)
+}
+
+// identifierNgram is a single entry in the posting list of an n-gram.
+type identifierNgram struct {
+	ngram      string
+	identifier *srvpb.IdentifierNgram_Identifier
+}
+
+type ngramLesser struct{}
+
+func (ngramLesser) Less(a, b interface{}) bool {
+	x, y := a.(*identifierNgram), b.(*identifierNgram)
+	if x.ngram == y.ngram {
+		return x.identifier.QualifiedName < y.identifier.QualifiedName
+	}
+	return x.ngram < y.ngram
+}
+
+type ngramMarshaler struct{}
+
+func (ngramMarshaler) Marshal(x interface{}) ([]byte, error) {
+	n := x.(*identifierNgram)
+	rec, err := proto.Marshal(n.identifier)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Join([][]byte{[]byte(n.ngram), rec}, []byte("\000")), nil
+}
+
+func (ngramMarshaler) Unmarshal(rec []byte) (interface{}, error) {
+	ss := bytes.SplitN(rec, []byte("\000"), 2)
+	if len(ss) != 2 {
+		return nil, errors.New("invalid identifierNgram encoding")
+	}
+	var id srvpb.IdentifierNgram_Identifier
+	if err := proto.Unmarshal(ss[1], &id); err != nil {
+		return nil, err
+	}
+	return &identifierNgram{
+		ngram:      string(ss[0]),
+		identifier: &id,
+	}, nil
+}
+
 type fragmentMarshaler struct{}
 
 func (fragmentMarshaler) Marshal(x interface{}) ([]byte, error) {
@@ -14,8 +14,8 @@
  * limitations under the License.
  */
 
-// Binary http_server exposes HTTP interfaces for the xrefs and filetree
-// services backed by a combined serving table.
+// Binary http_server exposes HTTP interfaces for the xrefs, graph, filetree,
+// identifiers, and explore services backed by a combined serving table.
 package main
 
 import (
@@ -26,11 +26,14 @@ import (
 	"os"
 	"path/filepath"
 
+	"kythe.io/kythe/go/services/explore"
 	"kythe.io/kythe/go/services/filetree"
 	"kythe.io/kythe/go/services/graph"
 	"kythe.io/kythe/go/services/xrefs"
+	esrv "kythe.io/kythe/go/serving/explore"
 	ftsrv "kythe.io/kythe/go/serving/filetree"
 	gsrv "kythe.io/kythe/go/serving/graph"
+	"kythe.io/kythe/go/serving/identifiers"
 	xsrv "kythe.io/kythe/go/serving/xrefs"
 	"kythe.io/kythe/go/storage/leveldb"
 	"kythe.io/kythe/go/storage/table"
@@ -57,7 +60,7 @@ var (
 )
 
 func init() {
-	flag.Usage = flagutil.SimpleUsage("Exposes HTTP interfaces for the xrefs and filetree services",
+	flag.Usage = flagutil.SimpleUsage("Exposes HTTP interfaces for the xrefs, graph, filetree, identifiers, and explore services",
 		"(--graphstore spec | --serving_table path) [--listen addr] [--public_resources dir]")
 }
 
@@ -77,6 +80,8 @@ func main() {
 		xs xrefs.Service
 		gs graph.Service
 		ft filetree.Service
+		id identifiers.Service
+		es explore.Service
 	)
 
 	ctx := context.Background()
@@ -86,8 +91,9 @@ func main() {
 	}
 	defer db.Close(ctx)
 	xs = xsrv.NewService(ctx, db)
+	gs = gsrv.NewService(ctx, db)
 	tbl := &table.KVProto{db}
-	gs = gsrv.NewCombinedTable(tbl)
+	es = esrv.NewCombinedTable(tbl)
 	if *maxTicketsPerRequest > 0 {
 		xs = xrefs.BoundedRequests{
 			Service:    xs,
@@ -97,8 +103,13 @@ func main() {
 			Service:    gs,
 			MaxTickets: *maxTicketsPerRequest,
 		}
+		es = explore.BoundedRequests{
+			Service:    es,
+			MaxTickets: *maxTicketsPerRequest,
+		}
 	}
-	ft = &ftsrv.Table{Proto: tbl, PrefixedKeys: true}
+	ft = ftsrv.NewService(ctx, db)
+	id = &identifiers.Table{Proto: tbl, PrefixedKeys: true}
 
 	if *httpListeningAddr != "" || *tlsListeningAddr != "" {
 		apiMux := http.NewServeMux()
@@ -112,6 +123,8 @@ func main() {
 		xrefs.RegisterHTTPHandlers(ctx, xs, apiMux)
 		graph.RegisterHTTPHandlers(ctx, gs, apiMux)
 		filetree.RegisterHTTPHandlers(ctx, ft, apiMux)
+		identifiers.RegisterHTTPHandlers(ctx, id, apiMux)
+		explore.RegisterHTTPHandlers(ctx, es, apiMux)
 		if *publicResources != "" {
 			log.Println("Serving public resources at", *publicResources)
 			if s, err := os.Stat(*publicResources); err != nil {
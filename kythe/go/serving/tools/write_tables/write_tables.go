@@ -142,20 +142,26 @@ func runExperimentalBeamPipeline(ctx context.Context) error {
 	entries := beamio.ReadEntries(s, *entriesFile)
 	k := pipeline.FromEntries(s, entries)
 	shards := 8 // TODO(schroederc): better determine number of shards
-	edgeSets, edgePages := k.Edges()
+	callers, callees := k.Callgraphs()
+	parents, children := k.Relatives()
+	identifiers, ngrams := k.Identifiers()
 	if *experimentalColumnarData {
 		beamio.WriteLevelDB(s, *tablePath, shards,
 			createColumnarMetadata(s),
 			k.SplitCrossReferences(),
 			k.SplitDecorations(),
-			k.CorpusRoots(),
-			k.Directories(),
-			k.Documents(),
-			// TODO(schroederc): replace edges with columnar format
-			edgeSets, edgePages,
+			k.SplitDirectories(),
+			k.SplitDocuments(),
+			k.SplitEdges(),
+			k.TypeHierarchies(),
+			callers, callees,
+			parents, children,
+			k.FunctionParameters(),
+			identifiers, ngrams,
 		)
 	} else {
 		xrefSets, xrefPages := k.CrossReferences()
+		edgeSets, edgePages := k.Edges()
 		beamio.WriteLevelDB(s, *tablePath, shards,
 			k.CorpusRoots(),
 			k.Decorations(),
@@ -163,6 +169,11 @@ func runExperimentalBeamPipeline(ctx context.Context) error {
 			k.Documents(),
 			xrefSets, xrefPages,
 			edgeSets, edgePages,
+			k.TypeHierarchies(),
+			callers, callees,
+			parents, children,
+			k.FunctionParameters(),
+			identifiers, ngrams,
 		)
 	}
 
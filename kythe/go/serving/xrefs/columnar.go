@@ -19,10 +19,12 @@ package xrefs
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"log"
 	"regexp"
+	"strings"
 
 	"kythe.io/kythe/go/services/xrefs"
 	"kythe.io/kythe/go/serving/xrefs/columnar"
@@ -36,18 +38,22 @@ import (
 
 	"bitbucket.org/creachadair/stringset"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	cpb "kythe.io/kythe/proto/common_go_proto"
+	ipb "kythe.io/kythe/proto/internal_go_proto"
 	scpb "kythe.io/kythe/proto/schema_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
 	xpb "kythe.io/kythe/proto/xref_go_proto"
 	xspb "kythe.io/kythe/proto/xref_serving_go_proto"
 )
 
 // ColumnarTableKeyMarker is stored within a Kythe columnar table to
 // differentiate it from the legacy combined table format.
-const ColumnarTableKeyMarker = "kythe:columnar"
+const ColumnarTableKeyMarker = columnar.TableKeyMarker
 
 // NewService returns an xrefs.Service backed by the given table.  The format of
 // the table with be automatically detected.
@@ -62,14 +68,83 @@ func NewService(ctx context.Context, t keyvalue.DB) xrefs.Service {
 
 // NewColumnarTable returns a table for the given columnar xrefs lookup table.
 func NewColumnarTable(t keyvalue.DB) *ColumnarTable {
-	return &ColumnarTable{t, NewCombinedTable(&table.KVProto{t})}
+	return &ColumnarTable{t, &Table{&columnarLookupTables{t, &combinedTable{&table.KVProto{t}}}}}
 }
 
 // ColumnarTable implements an xrefs.Service backed by a columnar serving table.
 type ColumnarTable struct {
 	keyvalue.DB
 
-	*Table // fallback non-columnar documentation
+	*Table // columnar documentation with non-columnar fallback
+}
+
+// columnarLookupTables implements staticLookupTables by reading documentation
+// from a columnar table.  All other lookups, and documentation missing from the
+// columnar table, use the legacy combined table format.
+type columnarLookupTables struct {
+	db keyvalue.DB
+	*combinedTable
+}
+
+func (c *columnarLookupTables) documentation(ctx context.Context, ticket string) (*srvpb.Document, error) {
+	src, err := kytheuri.ToVName(ticket)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := keys.Append(columnar.DocumentationKeyPrefix, src)
+	if err != nil {
+		return nil, err
+	}
+	it, err := c.db.ScanPrefix(ctx, prefix, &keyvalue.Options{LargeRead: true})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	k, val, err := it.Next()
+	if err == io.EOF || (err == nil && !bytes.Equal(k, prefix)) {
+		return c.combinedTable.documentation(ctx, ticket)
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Decode Documentation Index
+	var idx xspb.Documentation_Index
+	if err := proto.Unmarshal(val, &idx); err != nil {
+		return nil, fmt.Errorf("error decoding index: %v", err)
+	}
+	d := &srvpb.Document{
+		Ticket:       ticket,
+		MarkedSource: idx.MarkedSource,
+		RawText:      idx.RawText,
+		Link:         idx.Link,
+	}
+	if idx.DocumentedBy != nil {
+		d.DocumentedBy = kytheuri.ToString(idx.DocumentedBy)
+	}
+
+	for {
+		k, val, err := it.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		e, err := columnar.DecodeDocumentationEntry(src, string(k[len(prefix):]), val)
+		if err != nil {
+			return nil, err
+		}
+		switch e := e.Entry.(type) {
+		case *xspb.Documentation_Child_:
+			d.ChildTicket = append(d.ChildTicket, kytheuri.ToString(e.Child.Child))
+		case *xspb.Documentation_LinkNode_:
+			d.Node = append(d.Node, e.LinkNode.Node)
+		default:
+			return nil, fmt.Errorf("unexpected Documentation entry: %T", e)
+		}
+	}
+	return d, nil
 }
 
 // Decorations implements part of the xrefs.Service interface.
@@ -81,9 +156,6 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 		return nil, status.Errorf(codes.InvalidArgument, "missing requested Location span: %v", req.Location)
 	}
 
-	// TODO(schroederc): handle SPAN requests
-	// TODO(schroederc): handle dirty buffers
-
 	fileURI, err := kytheuri.Parse(ticket)
 	if err != nil {
 		return nil, err
@@ -93,10 +165,11 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 	if err != nil {
 		return nil, err
 	}
-	it, err := c.DB.ScanPrefix(ctx, prefix, &keyvalue.Options{LargeRead: true})
+	kvit, err := c.DB.ScanPrefix(ctx, prefix, &keyvalue.Options{LargeRead: true})
 	if err != nil {
 		return nil, err
 	}
+	it := seeker(kvit)
 
 	k, val, err := it.Next()
 	if err == io.EOF || !bytes.Equal(k, prefix) {
@@ -122,12 +195,32 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 
 	// Setup scanning state for constructing reply
 	var norm *span.Normalizer                                          // span normalizer for references
+	var patcher *span.Patcher                                          // patcher for dirty buffer spans
+	var startBoundary, endBoundary int32                               // span constraining returned references
+	spanKind := req.SpanKind                                           // how references are constrained by the span
 	refsByTarget := make(map[string][]*xpb.DecorationsReply_Reference) // target -> set<Reference>
 	defs := stringset.New()                                            // set<needed definition tickets>
 	patterns := xrefs.ConvertFilters(req.Filter)
 	emitSnippets := req.Snippets != xpb.SnippetsKind_NONE
 
+	// Groups of entries needed to construct the reply; all others are skipped.
+	wantGroup := func(g columnar.DecorGroup) bool {
+		switch g {
+		case columnar.DecorTextGroup:
+			return true
+		case columnar.DecorTargetGroup:
+			return req.References
+		case columnar.DecorTargetNodeGroup:
+			return req.References && len(patterns) > 0
+		case columnar.DecorTargetDefinitionGroup, columnar.DecorDefinitionLocationGroup:
+			return req.References && req.TargetDefinitions
+		default:
+			return false
+		}
+	}
+
 	// Main loop to scan over each columnar kv entry.
+scan:
 	for {
 		k, val, err := it.Next()
 		if err == io.EOF {
@@ -137,16 +230,43 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 		}
 		key := string(k[len(prefix):])
 
-		// TODO(schroederc): only parse needed entries
+		// Only entries of the needed groups are decoded; the group of any other
+		// entry is read from its key.
+		if g, err := columnar.DecodeDecorationsGroup(key); err != nil {
+			return nil, err
+		} else if !wantGroup(g) {
+			// Seek to the next needed group of entries, if any.
+			next := columnar.DecorIndexGroup
+			for _, ng := range decorGroups {
+				if ng > g && wantGroup(ng) {
+					next = ng
+					break
+				}
+			}
+			if next == columnar.DecorIndexGroup {
+				break scan
+			}
+			key, err := columnar.DecorationsGroupKey(columnar.DecorationsKeyPrefix, file, next)
+			if err != nil {
+				return nil, err
+			} else if err := it.Seek(key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		e, err := columnar.DecodeDecorationsEntry(file, key, val)
 		if err != nil {
 			return nil, err
 		}
-
 		switch e := e.Entry.(type) {
 		case *xspb.FileDecorations_Text_:
-			file := e.Text
-			norm = span.NewNormalizer(file.Text)
+			text := e.Text.Text
+			if len(req.DirtyBuffer) > 0 {
+				patcher = span.NewPatcher(text, req.DirtyBuffer)
+				text = req.DirtyBuffer
+			}
+			norm = span.NewNormalizer(text)
 
 			loc, err := norm.Location(req.GetLocation())
 			if err != nil {
@@ -157,17 +277,26 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 			if req.SourceText {
 				reply.Encoding = idx.TextEncoding
 				if loc.Kind == xpb.Location_FILE {
-					reply.SourceText = file.Text
+					reply.SourceText = text
 				} else {
-					reply.SourceText = file.Text[loc.Span.Start.ByteOffset:loc.Span.End.ByteOffset]
+					reply.SourceText = text[loc.Span.Start.ByteOffset:loc.Span.End.ByteOffset]
 				}
 			}
+
+			if loc.Kind == xpb.Location_FILE {
+				startBoundary, endBoundary = 0, int32(len(text))
+				spanKind = xpb.DecorationsRequest_WITHIN_SPAN
+			} else {
+				startBoundary, endBoundary = loc.Span.Start.ByteOffset, loc.Span.End.ByteOffset
+			}
 		case *xspb.FileDecorations_Target_:
-			if !req.References {
-				// TODO(schroederc): seek to next group
+			t := e.Target
+			start, end, exists := patcher.Patch(t.StartOffset, t.EndOffset)
+			// Filter non-existent anchor.  Anchors can no longer exist if we were
+			// given a dirty buffer and the anchor was inside a changed region.
+			if !exists || !span.InBounds(spanKind, start, end, startBoundary, endBoundary) {
 				continue
 			}
-			t := e.Target
 			kind := t.GetGenericKind()
 			if kind == "" {
 				kind = schema.EdgeKindString(t.GetKytheKind())
@@ -175,26 +304,24 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 			ref := &xpb.DecorationsReply_Reference{
 				TargetTicket: kytheuri.ToString(t.Target),
 				Kind:         kind,
-				Span:         norm.SpanOffsets(t.StartOffset, t.EndOffset),
+				Span:         norm.SpanOffsets(start, end),
 			}
 			refsByTarget[ref.TargetTicket] = append(refsByTarget[ref.TargetTicket], ref)
 			reply.Reference = append(reply.Reference, ref)
 		case *xspb.FileDecorations_TargetOverride_:
 			// TODO(schroederc): handle
 		case *xspb.FileDecorations_TargetNode_:
-			if len(patterns) == 0 {
-				// TODO(schroederc): seek to next group
+			n := e.TargetNode.Node
+			ticket := kytheuri.ToString(n.Source)
+			if len(refsByTarget[ticket]) == 0 {
+				// Skip nodes only targeted by references outside of the requested span.
 				continue
 			}
-			n := e.TargetNode.Node
 			c := filterNode(patterns, n)
 			if c != nil && len(c.Facts) > 0 {
-				reply.Nodes[kytheuri.ToString(n.Source)] = c
+				reply.Nodes[ticket] = c
 			}
 		case *xspb.FileDecorations_TargetDefinition_:
-			if !req.TargetDefinitions {
-				continue
-			}
 			def := e.TargetDefinition
 			// refsByTarget will be populated by now due to our chosen key ordering
 			// See: kythe/proto/xref_serving.proto
@@ -208,9 +335,6 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 				ref.TargetDefinition = defTicket
 			}
 		case *xspb.FileDecorations_DefinitionLocation_:
-			if !req.TargetDefinitions {
-				continue
-			}
 			def := e.DefinitionLocation
 			if !defs.Contains(def.Location.Ticket) {
 				continue
@@ -231,6 +355,19 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 	return reply, nil
 }
 
+// decorGroups are the columnar FileDecorations groups following each file's
+// Index, in key order.
+var decorGroups = []columnar.DecorGroup{
+	columnar.DecorTextGroup,
+	columnar.DecorTargetGroup,
+	columnar.DecorTargetOverrideGroup,
+	columnar.DecorTargetNodeGroup,
+	columnar.DecorTargetDefinitionGroup,
+	columnar.DecorDefinitionLocationGroup,
+	columnar.DecorOverrideGroup,
+	columnar.DecorDiagnosticGroup,
+}
+
 func addXRefNode(reply *xpb.CrossReferencesReply, patterns []*regexp.Regexp, n *scpb.Node) {
 	if len(patterns) == 0 {
 		return
@@ -246,149 +383,533 @@ func addXRefNode(reply *xpb.CrossReferencesReply, patterns []*regexp.Regexp, n *
 
 // CrossReferences implements part of the xrefs.Service interface.
 func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest) (*xpb.CrossReferencesReply, error) {
+	pageSize := int(req.PageSize)
+	if pageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size: %d", req.PageSize)
+	} else if pageSize == 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var pageToken ipb.PageToken
+	if req.PageToken != "" {
+		rec, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err == nil {
+			rec, err = snappy.Decode(nil, rec)
+		}
+		if err == nil {
+			err = proto.Unmarshal(rec, &pageToken)
+		}
+		if err != nil || pageToken.Index < 0 || int(pageToken.Index) >= len(req.Ticket) || len(pageToken.Key) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.PageToken)
+		}
+	}
+
 	reply := &xpb.CrossReferencesReply{
 		CrossReferences: make(map[string]*xpb.CrossReferencesReply_CrossReferenceSet),
+		Total:           &xpb.CrossReferencesReply_Total{},
 	}
-
-	relatedNodes := stringset.New()
 	patterns := xrefs.ConvertFilters(req.Filter)
-	relatedKinds := stringset.New(req.RelatedNodeKind...)
 	if len(patterns) > 0 {
 		reply.Nodes = make(map[string]*cpb.NodeInfo)
+		reply.Total.RelatedNodesByRelation = make(map[string]int64)
+	}
+	if req.NodeDefinitions {
+		reply.DefinitionLocations = make(map[string]*xpb.Anchor)
 	}
-	emitSnippets := req.Snippets != xpb.SnippetsKind_NONE
 
-	// TODO(schroederc): implement paging xrefs in large CrossReferencesReply messages
+	s := &xrefsScan{
+		req:          req,
+		reply:        reply,
+		patterns:     patterns,
+		relatedKinds: stringset.New(req.RelatedNodeKind...),
+		relatedNodes: stringset.New(),
+		emitSnippets: req.Snippets != xpb.SnippetsKind_NONE,
+		pageSize:     pageSize,
+		countTotals:  req.PageToken == "",
+	}
+	if !s.countTotals {
+		// Totals are counted while scanning for the first page and passed along
+		// in each subsequent page token.  Counting reads the keys of every
+		// wanted entry, since the table records no counts, but only the entries
+		// on the page have their values decoded.  Later pages stop reading at
+		// the end of the page.
+		readTokenTotals(&pageToken, reply.Total)
+	}
 
-	for _, ticket := range req.Ticket {
+	for i := int(pageToken.Index); i < len(req.Ticket) && !s.done(); i++ {
+		ticket := req.Ticket[i]
 		uri, err := kytheuri.Parse(ticket)
 		if err != nil {
 			return nil, err
 		}
-		prefix, err := keys.Append(columnar.CrossReferencesKeyPrefix, uri.VName())
+		idx, srcs, err := c.crossReferencesSources(ctx, uri.VName())
 		if err != nil {
 			return nil, err
+		} else if idx == nil {
+			continue
+		}
+
+		set := &xpb.CrossReferencesReply_CrossReferenceSet{
+			Ticket:       ticket,
+			MarkedSource: idx.MarkedSource,
+		}
+		for _, src := range srcs[1:] {
+			set.MergedTicket = append(set.MergedTicket, kytheuri.ToString(src))
 		}
-		it, err := c.DB.ScanPrefix(ctx, prefix, &keyvalue.Options{LargeRead: true})
+		if !s.full() {
+			if idx.Node == nil {
+				idx.Node = &scpb.Node{}
+			}
+			idx.Node.Source = uri.VName()
+			addXRefNode(reply, patterns, idx.Node)
+			reply.CrossReferences[ticket] = set
+		}
+
+		// Scan the requested node's cross-references followed by those of each
+		// node (transitively) merged into it.
+		var (
+			first    int
+			firstKey []byte
+		)
+		if i == int(pageToken.Index) && !s.countTotals {
+			first, firstKey = int(pageToken.Indices["source"]), pageToken.Key
+			if first >= len(srcs) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.PageToken)
+			}
+		}
+		for j := first; j < len(srcs) && !s.done(); j++ {
+			var start []byte
+			if j == first {
+				start = firstKey
+			}
+			if err := s.scanSource(ctx, c.DB, set, i, j, srcs[j], start); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if s.next != nil {
+		writeTokenTotals(s.next, reply.Total)
+		rec, err := proto.Marshal(s.next)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("internal error: error marshalling page token: %v", err)
 		}
+		reply.NextPageToken = base64.StdEncoding.EncodeToString(snappy.Encode(nil, rec))
+	}
 
-		k, val, err := it.Next()
-		if err == io.EOF || !bytes.Equal(k, prefix) {
+	return reply, nil
+}
+
+// crossReferencesSources returns the columnar CrossReferences Index of the
+// given node along with the node itself and each node (transitively) merged
+// into it that has an Index.  If the given node has no Index, nil is returned.
+func (c *ColumnarTable) crossReferencesSources(ctx context.Context, src *spb.VName) (*xspb.CrossReferences_Index, []*spb.VName, error) {
+	var (
+		root *xspb.CrossReferences_Index
+		srcs []*spb.VName
+	)
+	merged := stringset.New(kytheuri.ToString(src))
+	for queue := []*spb.VName{src}; len(queue) > 0; queue = queue[1:] {
+		key, err := columnar.CrossReferencesGroupKey(columnar.CrossReferencesKeyPrefix, queue[0], columnar.XRefsIndexGroup)
+		if err != nil {
+			return nil, nil, err
+		}
+		val, err := c.DB.Get(ctx, key, nil)
+		if err == io.EOF {
+			if root == nil {
+				return nil, nil, nil
+			}
 			continue
 		} else if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		// Decode CrossReferences Index
 		var idx xspb.CrossReferences_Index
 		if err := proto.Unmarshal(val, &idx); err != nil {
-			return nil, fmt.Errorf("error decoding index: %v", err)
+			return nil, nil, fmt.Errorf("error decoding index: %v", err)
 		}
-		idx.Node.Source = uri.VName()
-		addXRefNode(reply, patterns, idx.Node)
+		if root == nil {
+			root = &idx
+		}
+		srcs = append(srcs, queue[0])
 
-		// TODO(schroederc): handle merge_with
+		if *mergeCrossReferences {
+			for _, m := range idx.MergeWith {
+				if t := kytheuri.ToString(m); !merged.Contains(t) {
+					merged.Add(t)
+					queue = append(queue, m)
+				}
+			}
+		}
+	}
+	return root, srcs, nil
+}
 
-		set := &xpb.CrossReferencesReply_CrossReferenceSet{
-			Ticket:       ticket,
-			MarkedSource: idx.MarkedSource,
+// xrefsGroups are the columnar CrossReferences groups scanned for each source
+// node, in key order.
+var xrefsGroups = []columnar.XRefsGroup{
+	columnar.XRefsReferenceGroup,
+	columnar.XRefsRelationGroup,
+	columnar.XRefsCallerGroup,
+	columnar.XRefsRelatedNodeGroup,
+}
+
+// xrefsScan holds the state of a ColumnarTable.CrossReferences request.
+type xrefsScan struct {
+	req          *xpb.CrossReferencesRequest
+	reply        *xpb.CrossReferencesReply
+	patterns     []*regexp.Regexp
+	relatedKinds stringset.Set
+	relatedNodes stringset.Set // related nodes added to the reply
+	emitSnippets bool
+
+	pageSize    int            // maximum number of cross-references in the reply
+	count       int            // number of cross-references added to the reply
+	countTotals bool           // whether to count each matching cross-reference in reply.Total
+	next        *ipb.PageToken // position of the first cross-reference past the page
+}
+
+// full reports whether the reply's page of cross-references is full.
+func (s *xrefsScan) full() bool { return s.count >= s.pageSize }
+
+// done reports whether the scan has no more work to do.
+func (s *xrefsScan) done() bool { return s.next != nil && !s.countTotals }
+
+// add reports whether the cross-reference at the given key, belonging to the
+// jth source node of the ith requested ticket, fits within the reply's page.
+// If not, the position of the first such cross-reference is recorded.
+func (s *xrefsScan) add(key []byte, i, j int) bool {
+	if !s.full() {
+		s.count++
+		return true
+	}
+	if s.next == nil {
+		s.next = &ipb.PageToken{
+			Index:   int32(i),
+			Indices: map[string]int32{"source": int32(j)},
+			Key:     append([]byte(nil), key...),
 		}
-		reply.CrossReferences[ticket] = set
+	}
+	return false
+}
 
-		// TODO remove callers without callsites
-		callers := make(map[string]*xpb.CrossReferencesReply_RelatedAnchor)
+// wantGroup reports whether the scan requires the given group of a source
+// node's entries, given the number of relations added for that node.
+func (s *xrefsScan) wantGroup(g columnar.XRefsGroup, relations int) bool {
+	switch g {
+	case columnar.XRefsReferenceGroup:
+		return s.req.DefinitionKind != xpb.CrossReferencesRequest_NO_DEFINITIONS ||
+			s.req.DeclarationKind != xpb.CrossReferencesRequest_NO_DECLARATIONS ||
+			s.req.ReferenceKind != xpb.CrossReferencesRequest_NO_REFERENCES
+	case columnar.XRefsRelationGroup:
+		return len(s.patterns) > 0
+	case columnar.XRefsCallerGroup:
+		return s.req.CallerKind != xpb.CrossReferencesRequest_NO_CALLERS
+	case columnar.XRefsRelatedNodeGroup:
+		// RelatedNodes are only needed to populate the nodes added on this page.
+		return relations > 0
+	default:
+		return false
+	}
+}
 
-		// Main loop to scan over each columnar kv entry.
-		for {
-			k, val, err := it.Next()
-			if err == io.EOF {
-				break
-			} else if err != nil {
-				return nil, err
-			}
-			key := string(k[len(prefix):])
+// seekGroup positions it at the first wanted group of src's entries following
+// the given group.  If there is no such group, false is returned.
+func (s *xrefsScan) seekGroup(it keyvalue.SeekIterator, src *spb.VName, after columnar.XRefsGroup, relations int) (bool, error) {
+	for _, g := range xrefsGroups {
+		if g <= after || !s.wantGroup(g, relations) {
+			continue
+		}
+		key, err := columnar.CrossReferencesGroupKey(columnar.CrossReferencesKeyPrefix, src, g)
+		if err != nil {
+			return false, err
+		}
+		return true, it.Seek(key)
+	}
+	return false, nil
+}
 
-			// TODO(schroederc): only parse needed entries
-			e, err := columnar.DecodeCrossReferencesEntry(uri.VName(), key, val)
-			if err != nil {
-				return nil, err
+// scanSource adds the cross-references of src, the jth source node of the ith
+// requested ticket, to set.  If start is non-nil, the scan begins at the given
+// key.  Only the entries added to the reply are fully decoded; all others are
+// only counted (when necessary) using their keys.
+func (s *xrefsScan) scanSource(ctx context.Context, db keyvalue.DB, set *xpb.CrossReferencesReply_CrossReferenceSet, i, j int, src *spb.VName, start []byte) error {
+	prefix, err := keys.Append(columnar.CrossReferencesKeyPrefix, src)
+	if err != nil {
+		return err
+	} else if start != nil && !bytes.HasPrefix(start, prefix) {
+		return status.Errorf(codes.InvalidArgument, "invalid page_token: %q", s.req.PageToken)
+	}
+	kvit, err := db.ScanPrefix(ctx, prefix, &keyvalue.Options{LargeRead: true})
+	if err != nil {
+		return err
+	}
+	defer kvit.Close()
+	it := seeker(kvit)
+
+	var relations int // number of relations added to the reply for src
+	if start != nil {
+		if err := it.Seek(start); err != nil {
+			return err
+		}
+	} else if ok, err := s.seekGroup(it, src, columnar.XRefsIndexGroup, relations); err != nil || !ok {
+		return err
+	}
+
+	var (
+		// Each Caller entry precedes the entries for its callsites.  Callers are
+		// only added to the reply once they have a requested callsite.
+		caller         *xpb.CrossReferencesReply_RelatedAnchor
+		callerTicket   string
+		callerKey      []byte
+		callerAccepted bool
+	)
+
+	// Main loop to scan over each columnar kv entry.
+	for {
+		k, val, err := it.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		key := string(k[len(prefix):])
+
+		// Decode only the entry's key; its value is decoded only if needed.
+		e, err := columnar.DecodeCrossReferencesEntry(src, key, nil)
+		if err != nil {
+			return err
+		}
+		if g := columnar.CrossReferencesGroup(e); !s.wantGroup(g, relations) {
+			if ok, err := s.seekGroup(it, src, g, relations); err != nil || !ok {
+				return err
 			}
+			continue
+		}
 
-			switch e := e.Entry.(type) {
-			case *xspb.CrossReferences_Reference_:
-				ref := e.Reference
-				if xrefs.IsRefKind(req.ReferenceKind, getRefKind(ref)) {
-					a := a2a(ref.Location, emitSnippets).Anchor
-					a.Ticket = ""
-					set.Reference = append(set.Reference, &xpb.CrossReferencesReply_RelatedAnchor{
-						Anchor: a,
-					})
-				}
-			case *xspb.CrossReferences_Relation_:
-				if len(patterns) == 0 {
-					continue
-				}
-				rel := e.Relation
-				kind := rel.GetGenericKind()
-				if kind == "" {
-					kind = schema.EdgeKindString(rel.GetKytheKind())
+		switch e := e.Entry.(type) {
+		case *xspb.CrossReferences_Reference_:
+			kind := getRefKind(e.Reference)
+			var (
+				total   *int64
+				anchors *[]*xpb.CrossReferencesReply_RelatedAnchor
+			)
+			switch {
+			case xrefs.IsDefKind(s.req.DefinitionKind, kind, false):
+				total, anchors = &s.reply.Total.Definitions, &set.Definition
+			case xrefs.IsDeclKind(s.req.DeclarationKind, kind, false):
+				total, anchors = &s.reply.Total.Declarations, &set.Declaration
+			case xrefs.IsRefKind(s.req.ReferenceKind, kind):
+				total, anchors = &s.reply.Total.References, &set.Reference
+			default:
+				continue
+			}
+			if s.countTotals {
+				*total++
+			}
+			if !s.add(k, i, j) {
+				if s.done() {
+					return nil
 				}
-				if rel.Reverse {
-					kind = "%" + kind
+				continue
+			}
+
+			full, err := columnar.DecodeCrossReferencesEntry(src, key, val)
+			if err != nil {
+				return err
+			}
+			a := a2a(full.GetReference().Location, s.emitSnippets).Anchor
+			a.Ticket = ""
+			*anchors = append(*anchors, &xpb.CrossReferencesReply_RelatedAnchor{Anchor: a})
+		case *xspb.CrossReferences_Relation_:
+			rel := e.Relation
+			kind := rel.GetGenericKind()
+			if kind == "" {
+				kind = schema.EdgeKindString(rel.GetKytheKind())
+			}
+			if rel.Reverse {
+				kind = "%" + kind
+			}
+			if !xrefs.IsRelatedNodeKind(s.relatedKinds, kind) {
+				continue
+			}
+			if s.countTotals {
+				s.reply.Total.RelatedNodesByRelation[kind]++
+			}
+			if !s.add(k, i, j) {
+				if s.done() {
+					return nil
 				}
-				if xrefs.IsRelatedNodeKind(relatedKinds, kind) {
-					relatedNode := kytheuri.ToString(rel.Node)
-					relatedNodes.Add(relatedNode)
-					set.RelatedNode = append(set.RelatedNode, &xpb.CrossReferencesReply_RelatedNode{
-						Ticket:       relatedNode,
-						RelationKind: kind,
-						Ordinal:      rel.Ordinal,
-					})
+				continue
+			}
+
+			relatedNode := kytheuri.ToString(rel.Node)
+			s.relatedNodes.Add(relatedNode)
+			relations++
+			set.RelatedNode = append(set.RelatedNode, &xpb.CrossReferencesReply_RelatedNode{
+				Ticket:       relatedNode,
+				RelationKind: kind,
+				Ordinal:      rel.Ordinal,
+			})
+		case *xspb.CrossReferences_RelatedNode_:
+			// Only the nodes related on this page are decoded.
+			relatedNode := kytheuri.ToString(e.RelatedNode.Node.Source)
+			if !s.relatedNodes.Contains(relatedNode) {
+				continue
+			}
+			full, err := columnar.DecodeCrossReferencesEntry(src, key, val)
+			if err != nil {
+				return err
+			}
+			rn := full.GetRelatedNode()
+			addXRefNode(s.reply, s.patterns, rn.Node)
+			if def := rn.DefinitionLocation; def != nil && s.reply.DefinitionLocations != nil {
+				if info := s.reply.Nodes[relatedNode]; info != nil {
+					info.Definition = def.Ticket
+					s.reply.DefinitionLocations[def.Ticket] = a2a(def, false).Anchor
 				}
-			case *xspb.CrossReferences_RelatedNode_:
-				relatedNode := kytheuri.ToString(e.RelatedNode.Node.Source)
-				if relatedNodes.Contains(relatedNode) {
-					addXRefNode(reply, patterns, e.RelatedNode.Node)
+			}
+		case *xspb.CrossReferences_Caller_:
+			caller, callerAccepted = nil, false
+			callerTicket = kytheuri.ToString(e.Caller.Caller)
+			callerKey = append(callerKey[:0], k...)
+			if s.full() {
+				continue
+			}
+
+			full, err := columnar.DecodeCrossReferencesEntry(src, key, val)
+			if err != nil {
+				return err
+			}
+			c := full.GetCaller()
+			caller = &xpb.CrossReferencesReply_RelatedAnchor{
+				MarkedSource: c.MarkedSource,
+				Ticket:       callerTicket,
+			}
+			if c.Location != nil {
+				caller.Anchor = a2a(c.Location, s.emitSnippets).Anchor
+				caller.Anchor.Ticket = ""
+			}
+		case *xspb.CrossReferences_Callsite_:
+			c := e.Callsite
+			if s.req.CallerKind == xpb.CrossReferencesRequest_DIRECT_CALLERS && c.Kind == xspb.CrossReferences_Callsite_OVERRIDE {
+				continue
+			} else if kytheuri.ToString(c.Caller) != callerTicket {
+				log.Printf("WARNING: missing Caller for callsite: %+v", c)
+				continue
+			}
+			if !callerAccepted {
+				callerAccepted = true
+				if s.countTotals {
+					s.reply.Total.Callers++
 				}
-			case *xspb.CrossReferences_Caller_:
-				if req.CallerKind == xpb.CrossReferencesRequest_NO_CALLERS {
+				if !s.add(callerKey, i, j) {
+					caller = nil
+					if s.done() {
+						return nil
+					}
 					continue
 				}
-				c := e.Caller
-				a := a2a(c.Location, emitSnippets).Anchor
-				a.Ticket = ""
-				callerTicket := kytheuri.ToString(c.Caller)
-				caller := &xpb.CrossReferencesReply_RelatedAnchor{
-					Anchor:       a,
-					MarkedSource: c.MarkedSource,
-					Ticket:       callerTicket,
-				}
-				callers[callerTicket] = caller
 				set.Caller = append(set.Caller, caller)
-			case *xspb.CrossReferences_Callsite_:
-				c := e.Callsite
-				if req.CallerKind == xpb.CrossReferencesRequest_NO_CALLERS ||
-					(req.CallerKind == xpb.CrossReferencesRequest_DIRECT_CALLERS && c.Kind == xspb.CrossReferences_Callsite_OVERRIDE) {
-					continue
-				}
-				caller := callers[kytheuri.ToString(c.Caller)]
-				if caller == nil {
-					log.Printf("WARNING: missing Caller for callsite: %+v", c)
-					continue
-				}
-				a := a2a(c.Location, emitSnippets).Anchor
-				a.Ticket = ""
-				// TODO(schroederc): set anchor kind to differentiate kinds?
-				caller.Site = append(caller.Site, a)
-			default:
-				return nil, fmt.Errorf("unhandled internal serving type: %T", e)
 			}
+			if caller == nil {
+				continue
+			}
+
+			full, err := columnar.DecodeCrossReferencesEntry(src, key, val)
+			if err != nil {
+				return err
+			}
+			a := a2a(full.GetCallsite().Location, s.emitSnippets).Anchor
+			a.Ticket = ""
+			// TODO(schroederc): set anchor kind to differentiate kinds?
+			caller.Site = append(caller.Site, a)
+		default:
+			return fmt.Errorf("unhandled internal serving type: %T", e)
 		}
 	}
+}
 
-	return reply, nil
+// Page token counts for each CrossReferencesReply_Total count.
+const (
+	tokenDefinitionsTotal   = "definitions"
+	tokenDeclarationsTotal  = "declarations"
+	tokenReferencesTotal    = "references"
+	tokenCallersTotal       = "callers"
+	tokenRelatedNodesPrefix = "related:"
+)
+
+// writeTokenTotals records the given reply totals in the page token.
+func writeTokenTotals(t *ipb.PageToken, total *xpb.CrossReferencesReply_Total) {
+	t.Counts = map[string]int64{
+		tokenDefinitionsTotal:  total.Definitions,
+		tokenDeclarationsTotal: total.Declarations,
+		tokenReferencesTotal:   total.References,
+		tokenCallersTotal:      total.Callers,
+	}
+	for kind, n := range total.RelatedNodesByRelation {
+		t.Counts[tokenRelatedNodesPrefix+kind] = n
+	}
+}
+
+// readTokenTotals populates the reply totals recorded in the page token.
+func readTokenTotals(t *ipb.PageToken, total *xpb.CrossReferencesReply_Total) {
+	total.Definitions = t.Counts[tokenDefinitionsTotal]
+	total.Declarations = t.Counts[tokenDeclarationsTotal]
+	total.References = t.Counts[tokenReferencesTotal]
+	total.Callers = t.Counts[tokenCallersTotal]
+	for k, n := range t.Counts {
+		if kind := strings.TrimPrefix(k, tokenRelatedNodesPrefix); kind != k && total.RelatedNodesByRelation != nil {
+			total.RelatedNodesByRelation[kind] = n
+		}
+	}
+}
+
+// seeker returns it as a keyvalue.SeekIterator.  If it does not implement
+// Seek, skipped entries are read and discarded.
+func seeker(it keyvalue.Iterator) keyvalue.SeekIterator {
+	if sit, ok := it.(keyvalue.SeekIterator); ok {
+		return sit
+	}
+	return &scanSeeker{Iterator: it}
+}
+
+// A scanSeeker implements Seek for an Iterator by scanning forward to the
+// first entry at or past the sought key, which is held for the next call to
+// Next.
+type scanSeeker struct {
+	keyvalue.Iterator
+
+	held     bool // whether the following fields hold the next entry
+	key, val []byte
+	err      error
+}
+
+// Next implements part of the keyvalue.Iterator interface.
+func (s *scanSeeker) Next() ([]byte, []byte, error) {
+	if s.held {
+		s.held = false
+		return s.key, s.val, s.err
+	}
+	return s.Iterator.Next()
+}
+
+// Seek implements part of the keyvalue.SeekIterator interface.
+func (s *scanSeeker) Seek(key []byte) error {
+	for {
+		if !s.held {
+			s.key, s.val, s.err = s.Iterator.Next()
+			s.held = true
+		}
+		if s.err == io.EOF || (s.err == nil && bytes.Compare(s.key, key) >= 0) {
+			return nil
+		} else if s.err != nil {
+			return s.err
+		}
+		s.held = false
+	}
 }
 
 func getRefKind(ref *xspb.CrossReferences_Reference) string {
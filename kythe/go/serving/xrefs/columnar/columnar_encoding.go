@@ -24,6 +24,7 @@ import (
 
 	"kythe.io/kythe/go/util/keys"
 	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema"
 
 	"github.com/golang/protobuf/proto"
 
@@ -32,6 +33,10 @@ import (
 	xspb "kythe.io/kythe/proto/xref_serving_go_proto"
 )
 
+// TableKeyMarker is stored within a Kythe columnar table to differentiate it
+// from the legacy combined table format.
+const TableKeyMarker = "kythe:columnar"
+
 var (
 	// DecorationsKeyPrefix is the common key prefix for all Kythe columnar
 	// FileDecoration key-value entries.
@@ -40,12 +45,31 @@ var (
 	// CrossReferencesKeyPrefix is the common key prefix for all Kythe columnar
 	// CrossReferences key-value entries.
 	CrossReferencesKeyPrefix, _ = keys.Append(nil, "xr")
+
+	// DocumentationKeyPrefix is the common key prefix for all Kythe columnar
+	// Documentation key-value entries.
+	DocumentationKeyPrefix, _ = keys.Append(nil, "dc")
+
+	// EdgesKeyPrefix is the common key prefix for all Kythe columnar Edges
+	// key-value entries.
+	EdgesKeyPrefix, _ = keys.Append(nil, "eg")
+
+	// DirectoryKeyPrefix is the common key prefix for all Kythe columnar
+	// FileDirectory key-value entries.
+	DirectoryKeyPrefix, _ = keys.Append(nil, "dr")
+
+	// CorpusRootsKey is the key of the single srvpb.CorpusRoots entry in a
+	// Kythe columnar table.
+	CorpusRootsKey, _ = keys.Append(nil, "cr")
 )
 
 func init() {
 	// Restrict the capacity of the key prefixes to ensure appending to it creates a new array.
 	DecorationsKeyPrefix = DecorationsKeyPrefix[:len(DecorationsKeyPrefix):len(DecorationsKeyPrefix)]
 	CrossReferencesKeyPrefix = CrossReferencesKeyPrefix[:len(CrossReferencesKeyPrefix):len(CrossReferencesKeyPrefix)]
+	DocumentationKeyPrefix = DocumentationKeyPrefix[:len(DocumentationKeyPrefix):len(DocumentationKeyPrefix)]
+	EdgesKeyPrefix = EdgesKeyPrefix[:len(EdgesKeyPrefix):len(EdgesKeyPrefix)]
+	DirectoryKeyPrefix = DirectoryKeyPrefix[:len(DirectoryKeyPrefix):len(DirectoryKeyPrefix)]
 }
 
 // Columnar file decorations group numbers.
@@ -65,6 +89,58 @@ const (
 // KV is a single columnar key-value entry.
 type KV struct{ Key, Value []byte }
 
+// DecorGroup identifies a group of a file's columnar FileDecorations entries.
+// The entries of each group are contiguous in the key space and the groups are
+// ordered by their number.
+type DecorGroup int
+
+// Columnar FileDecorations entry groups.
+const (
+	DecorIndexGroup              DecorGroup = columnarDecorationsIndexGroup
+	DecorTextGroup               DecorGroup = columnarDecorationsTextGroup
+	DecorTargetGroup             DecorGroup = columnarDecorationsTargetGroup
+	DecorTargetOverrideGroup     DecorGroup = columnarDecorationsTargetOverrideGroup
+	DecorTargetNodeGroup         DecorGroup = columnarDecorationsTargetNodeGroup
+	DecorTargetDefinitionGroup   DecorGroup = columnarDecorationsTargetDefinitionGroup
+	DecorDefinitionLocationGroup DecorGroup = columnarDecorationsDefinitionLocationGroup
+	DecorOverrideGroup           DecorGroup = columnarDecorationsOverrideGroup
+	DecorDiagnosticGroup         DecorGroup = columnarDecorationsDiagnosticGroup
+)
+
+// DecorationsGroupKey returns the key at which the given group of a file's
+// columnar FileDecorations entries begins.
+func DecorationsGroupKey(keyPrefix []byte, file *spb.VName, g DecorGroup) ([]byte, error) {
+	if g == DecorIndexGroup {
+		return keys.Append(keyPrefix, file)
+	}
+	return keys.Append(keyPrefix, file, int(g))
+}
+
+// DecorationsGroup returns the group of the given columnar FileDecorations
+// entry.
+func DecorationsGroup(fd *xspb.FileDecorations) DecorGroup {
+	switch fd.Entry.(type) {
+	case *xspb.FileDecorations_Text_:
+		return DecorTextGroup
+	case *xspb.FileDecorations_Target_:
+		return DecorTargetGroup
+	case *xspb.FileDecorations_TargetOverride_:
+		return DecorTargetOverrideGroup
+	case *xspb.FileDecorations_TargetNode_:
+		return DecorTargetNodeGroup
+	case *xspb.FileDecorations_TargetDefinition_:
+		return DecorTargetDefinitionGroup
+	case *xspb.FileDecorations_DefinitionLocation_:
+		return DecorDefinitionLocationGroup
+	case *xspb.FileDecorations_Override_:
+		return DecorOverrideGroup
+	case *xspb.FileDecorations_Diagnostic_:
+		return DecorDiagnosticGroup
+	default:
+		return DecorIndexGroup
+	}
+}
+
 // EncodeDecorationsEntry encodes a columnar FileDecorations entry.
 func EncodeDecorationsEntry(keyPrefix []byte, fd *xspb.FileDecorations) (*KV, error) {
 	switch e := fd.Entry.(type) {
@@ -206,6 +282,19 @@ func encodeDecorDiagnostic(prefix []byte, file *spb.VName, d *xspb.FileDecoratio
 	return &KV{key, val}, nil
 }
 
+// DecodeDecorationsGroup returns the group of the columnar FileDecorations
+// entry with the given key, without decoding the entry itself.
+func DecodeDecorationsGroup(key string) (DecorGroup, error) {
+	if key == "" {
+		return DecorIndexGroup, nil
+	}
+	var kind int
+	if _, err := keys.Parse(key, &kind); err != nil {
+		return DecorIndexGroup, fmt.Errorf("invalid FileDecorations group kind: %v", err)
+	}
+	return DecorGroup(kind), nil
+}
+
 // DecodeDecorationsEntry decodes a columnar FileDecorations entry.
 func DecodeDecorationsEntry(file *spb.VName, key string, val []byte) (*xspb.FileDecorations, error) {
 	kind := columnarDecorationsIndexGroup
@@ -384,6 +473,46 @@ const (
 	columnarXRefsRelatedNodeGroup = 30
 )
 
+// XRefsGroup identifies a group of a source node's columnar CrossReferences
+// entries.  The entries of each group are contiguous in the key space and the
+// groups are ordered by their number.
+type XRefsGroup int
+
+// Columnar CrossReferences entry groups.
+const (
+	XRefsIndexGroup       XRefsGroup = columnarXRefsIndexGroup
+	XRefsReferenceGroup   XRefsGroup = columnarXRefsReferenceGroup
+	XRefsRelationGroup    XRefsGroup = columnarXRefsRelationGroup
+	XRefsCallerGroup      XRefsGroup = columnarXRefsCallerGroup
+	XRefsRelatedNodeGroup XRefsGroup = columnarXRefsRelatedNodeGroup
+)
+
+// CrossReferencesGroupKey returns the key at which the given group of a source
+// node's columnar CrossReferences entries begins.
+func CrossReferencesGroupKey(keyPrefix []byte, src *spb.VName, g XRefsGroup) ([]byte, error) {
+	if g == XRefsIndexGroup {
+		return keys.Append(keyPrefix, src)
+	}
+	return keys.Append(keyPrefix, src, int(g))
+}
+
+// CrossReferencesGroup returns the group of the given columnar CrossReferences
+// entry.
+func CrossReferencesGroup(xr *xspb.CrossReferences) XRefsGroup {
+	switch xr.Entry.(type) {
+	case *xspb.CrossReferences_Reference_:
+		return XRefsReferenceGroup
+	case *xspb.CrossReferences_Relation_:
+		return XRefsRelationGroup
+	case *xspb.CrossReferences_Caller_, *xspb.CrossReferences_Callsite_:
+		return XRefsCallerGroup
+	case *xspb.CrossReferences_RelatedNode_:
+		return XRefsRelatedNodeGroup
+	default:
+		return XRefsIndexGroup
+	}
+}
+
 // EncodeCrossReferencesEntry encodes a columnar CrossReferences entry.
 func EncodeCrossReferencesEntry(keyPrefix []byte, xr *xspb.CrossReferences) (*KV, error) {
 	switch e := xr.Entry.(type) {
@@ -504,7 +633,8 @@ func encodeXRefRelatedNode(prefix []byte, src *spb.VName, n *xspb.CrossReference
 	return &KV{key, val}, nil
 }
 
-// DecodeCrossReferencesEntry decodes a columnar CrossReferences entry.
+// DecodeCrossReferencesEntry decodes a columnar CrossReferences entry.  If val
+// is nil, only the parts of the entry encoded in its key are populated.
 func DecodeCrossReferencesEntry(src *spb.VName, key string, val []byte) (*xspb.CrossReferences, error) {
 	kind := columnarXRefsIndexGroup
 	if key != "" {
@@ -633,12 +763,358 @@ func decodeXRefCallerOrCallsite(src *spb.VName, key string, val []byte) (*xspb.C
 }
 
 func decodeXRefRelatedNode(src *spb.VName, key string, val []byte) (*xspb.CrossReferences, error) {
+	var node spb.VName
+	key, err := keys.Parse(key, &node)
+	if err != nil {
+		return nil, err
+	}
 	var rn xspb.CrossReferences_RelatedNode
 	if err := proto.Unmarshal(val, &rn); err != nil {
 		return nil, err
 	}
+	if rn.Node == nil {
+		rn.Node = &scpb.Node{}
+	}
+	rn.Node.Source = &node
 	return &xspb.CrossReferences{
 		Source: src,
 		Entry:  &xspb.CrossReferences_RelatedNode_{&rn},
 	}, nil
 }
+
+// Columnar documentation group numbers.
+// See: kythe/proto/xref_serving.proto
+const (
+	columnarDocumentationIndexGroup    = -1 // no group number
+	columnarDocumentationChildGroup    = 0
+	columnarDocumentationLinkNodeGroup = 10
+)
+
+// EncodeDocumentationEntry encodes a columnar Documentation entry.
+func EncodeDocumentationEntry(keyPrefix []byte, doc *xspb.Documentation) (*KV, error) {
+	switch e := doc.Entry.(type) {
+	case *xspb.Documentation_Index_:
+		return encodeDocIndex(keyPrefix, doc.Source, e.Index)
+	case *xspb.Documentation_Child_:
+		return encodeDocChild(keyPrefix, doc.Source, e.Child)
+	case *xspb.Documentation_LinkNode_:
+		return encodeDocLinkNode(keyPrefix, doc.Source, e.LinkNode)
+	default:
+		return nil, fmt.Errorf("unknown Documentation entry: %T", e)
+	}
+}
+
+func encodeDocIndex(prefix []byte, src *spb.VName, idx *xspb.Documentation_Index) (*KV, error) {
+	key, err := keys.Append(prefix, src)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proto.Marshal(idx)
+	if err != nil {
+		return nil, err
+	}
+	return &KV{key, val}, nil
+}
+
+func encodeDocChild(prefix []byte, src *spb.VName, c *xspb.Documentation_Child) (*KV, error) {
+	key, err := keys.Append(prefix, src, columnarDocumentationChildGroup, c.Child)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proto.Marshal(&xspb.Documentation_Child{})
+	if err != nil {
+		return nil, err
+	}
+	return &KV{key, val}, nil
+}
+
+func encodeDocLinkNode(prefix []byte, src *spb.VName, n *xspb.Documentation_LinkNode) (*KV, error) {
+	node, err := kytheuri.ToVName(n.Node.GetTicket())
+	if err != nil {
+		return nil, err
+	}
+	key, err := keys.Append(prefix, src, columnarDocumentationLinkNodeGroup, node)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proto.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+	return &KV{key, val}, nil
+}
+
+// DecodeDocumentationEntry decodes a columnar Documentation entry.
+func DecodeDocumentationEntry(src *spb.VName, key string, val []byte) (*xspb.Documentation, error) {
+	kind := columnarDocumentationIndexGroup
+	if key != "" {
+		var err error
+		key, err = keys.Parse(key, &kind)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Documentation group kind: %v", err)
+		}
+	}
+	switch kind {
+	case columnarDocumentationIndexGroup:
+		return decodeDocIndex(src, key, val)
+	case columnarDocumentationChildGroup:
+		return decodeDocChild(src, key, val)
+	case columnarDocumentationLinkNodeGroup:
+		return decodeDocLinkNode(src, key, val)
+	default:
+		return nil, fmt.Errorf("unknown group kind: %d", kind)
+	}
+}
+
+func decodeDocIndex(src *spb.VName, key string, val []byte) (*xspb.Documentation, error) {
+	var idx xspb.Documentation_Index
+	if err := proto.Unmarshal(val, &idx); err != nil {
+		return nil, err
+	}
+	return &xspb.Documentation{
+		Source: src,
+		Entry:  &xspb.Documentation_Index_{&idx},
+	}, nil
+}
+
+func decodeDocChild(src *spb.VName, key string, val []byte) (*xspb.Documentation, error) {
+	var child spb.VName
+	key, err := keys.Parse(key, &child)
+	if err != nil {
+		return nil, err
+	} else if key != "" {
+		return nil, fmt.Errorf("unexpected Child key suffix: %q", key)
+	}
+	var c xspb.Documentation_Child
+	if err := proto.Unmarshal(val, &c); err != nil {
+		return nil, err
+	}
+	c.Child = &child
+	return &xspb.Documentation{
+		Source: src,
+		Entry:  &xspb.Documentation_Child_{&c},
+	}, nil
+}
+
+func decodeDocLinkNode(src *spb.VName, key string, val []byte) (*xspb.Documentation, error) {
+	var n xspb.Documentation_LinkNode
+	if err := proto.Unmarshal(val, &n); err != nil {
+		return nil, err
+	}
+	return &xspb.Documentation{
+		Source: src,
+		Entry:  &xspb.Documentation_LinkNode_{&n},
+	}, nil
+}
+
+// Columnar edges group numbers.
+// See: kythe/proto/xref_serving.proto
+const (
+	columnarEdgesIndexGroup  = -1 // no group number
+	columnarEdgesEdgeGroup   = 0
+	columnarEdgesTargetGroup = 10
+)
+
+// EncodeEdgesEntry encodes a columnar Edges entry.
+func EncodeEdgesEntry(keyPrefix []byte, es *xspb.Edges) (*KV, error) {
+	switch e := es.Entry.(type) {
+	case *xspb.Edges_Index_:
+		return encodeEdgesIndex(keyPrefix, es.Source, e.Index)
+	case *xspb.Edges_Edge_:
+		return encodeEdgesEdge(keyPrefix, es.Source, e.Edge)
+	case *xspb.Edges_Target_:
+		return encodeEdgesTarget(keyPrefix, es.Source, e.Target)
+	default:
+		return nil, fmt.Errorf("unknown Edges entry: %T", e)
+	}
+}
+
+func encodeEdgesIndex(prefix []byte, src *spb.VName, idx *xspb.Edges_Index) (*KV, error) {
+	key, err := keys.Append(prefix, src)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proto.Marshal(idx)
+	if err != nil {
+		return nil, err
+	}
+	return &KV{key, val}, nil
+}
+
+func encodeEdgesEdge(prefix []byte, src *spb.VName, e *xspb.Edges_Edge) (*KV, error) {
+	key, err := keys.Append(prefix, src, columnarEdgesEdgeGroup,
+		e.GetGenericKind(), int32(e.GetKytheKind()), e.Reverse, e.Ordinal, e.Target)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proto.Marshal(&xspb.Edges_Edge{})
+	if err != nil {
+		return nil, err
+	}
+	return &KV{key, val}, nil
+}
+
+func encodeEdgesTarget(prefix []byte, src *spb.VName, t *xspb.Edges_Target) (*KV, error) {
+	key, err := keys.Append(prefix, src, columnarEdgesTargetGroup, t.Node.GetSource())
+	if err != nil {
+		return nil, err
+	}
+	val, err := proto.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return &KV{key, val}, nil
+}
+
+// DecodeEdgesEntry decodes a columnar Edges entry.
+func DecodeEdgesEntry(src *spb.VName, key string, val []byte) (*xspb.Edges, error) {
+	kind := columnarEdgesIndexGroup
+	if key != "" {
+		var err error
+		key, err = keys.Parse(key, &kind)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Edges group kind: %v", err)
+		}
+	}
+	switch kind {
+	case columnarEdgesIndexGroup:
+		return decodeEdgesIndex(src, key, val)
+	case columnarEdgesEdgeGroup:
+		return decodeEdgesEdge(src, key, val)
+	case columnarEdgesTargetGroup:
+		return decodeEdgesTarget(src, key, val)
+	default:
+		return nil, fmt.Errorf("unknown group kind: %d", kind)
+	}
+}
+
+func decodeEdgesIndex(src *spb.VName, key string, val []byte) (*xspb.Edges, error) {
+	var idx xspb.Edges_Index
+	if err := proto.Unmarshal(val, &idx); err != nil {
+		return nil, err
+	}
+	return &xspb.Edges{
+		Source: src,
+		Entry:  &xspb.Edges_Index_{&idx},
+	}, nil
+}
+
+func decodeEdgesEdge(src *spb.VName, key string, val []byte) (*xspb.Edges, error) {
+	var (
+		genericKind string
+		kytheKind   int32
+		reverse     bool
+		ordinal     int32
+		target      spb.VName
+	)
+	key, err := keys.Parse(key, &genericKind, &kytheKind, &reverse, &ordinal, &target)
+	if err != nil {
+		return nil, err
+	} else if key != "" {
+		return nil, fmt.Errorf("unexpected Edge key suffix: %q", key)
+	}
+	var e xspb.Edges_Edge
+	if err := proto.Unmarshal(val, &e); err != nil {
+		return nil, err
+	}
+	e.Target = &target
+	e.Reverse = reverse
+	e.Ordinal = ordinal
+	if genericKind != "" {
+		e.Kind = &xspb.Edges_Edge_GenericKind{genericKind}
+	} else {
+		e.Kind = &xspb.Edges_Edge_KytheKind{scpb.EdgeKind(kytheKind)}
+	}
+	return &xspb.Edges{
+		Source: src,
+		Entry:  &xspb.Edges_Edge_{&e},
+	}, nil
+}
+
+func decodeEdgesTarget(src *spb.VName, key string, val []byte) (*xspb.Edges, error) {
+	var t xspb.Edges_Target
+	if err := proto.Unmarshal(val, &t); err != nil {
+		return nil, err
+	}
+	return &xspb.Edges{
+		Source: src,
+		Entry:  &xspb.Edges_Target_{&t},
+	}, nil
+}
+
+// EdgeKindString returns the edge kind of the given columnar Edges edge as a
+// string.  Reverse edge kinds are prefixed by "%".
+func EdgeKindString(e *xspb.Edges_Edge) string {
+	kind := e.GetGenericKind()
+	if kind == "" {
+		kind = schema.EdgeKindString(e.GetKytheKind())
+	}
+	if e.Reverse {
+		return "%" + kind
+	}
+	return kind
+}
+
+// Columnar file directory group numbers.
+// See: kythe/proto/xref_serving.proto
+const (
+	columnarDirectorySubdirectoryGroup = 0
+	columnarDirectoryFileGroup         = 10
+)
+
+// DirectoryVName returns the columnar FileDirectory VName for the given
+// directory.
+func DirectoryVName(corpus, root, path string) *spb.VName {
+	return &spb.VName{Corpus: corpus, Root: root, Path: path}
+}
+
+// EncodeDirectoryEntry encodes a columnar FileDirectory entry.
+func EncodeDirectoryEntry(keyPrefix []byte, dir *xspb.FileDirectory) (*KV, error) {
+	var (
+		key []byte
+		err error
+	)
+	switch e := dir.Entry.(type) {
+	case *xspb.FileDirectory_Subdirectory_:
+		key, err = keys.Append(keyPrefix, dir.Directory, columnarDirectorySubdirectoryGroup, e.Subdirectory.Subdirectory)
+	case *xspb.FileDirectory_File_:
+		key, err = keys.Append(keyPrefix, dir.Directory, columnarDirectoryFileGroup, e.File.File)
+	default:
+		return nil, fmt.Errorf("unknown FileDirectory entry: %T", e)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// The entire entry is encoded in the key.
+	return &KV{key, []byte{}}, nil
+}
+
+// DecodeDirectoryEntry decodes a columnar FileDirectory entry.
+func DecodeDirectoryEntry(dir *spb.VName, key string, val []byte) (*xspb.FileDirectory, error) {
+	var kind int
+	key, err := keys.Parse(key, &kind)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FileDirectory group kind: %v", err)
+	}
+	var vname spb.VName
+	key, err = keys.Parse(key, &vname)
+	if err != nil {
+		return nil, err
+	} else if key != "" {
+		return nil, fmt.Errorf("unexpected FileDirectory key suffix: %q", key)
+	}
+	switch kind {
+	case columnarDirectorySubdirectoryGroup:
+		return &xspb.FileDirectory{
+			Directory: dir,
+			Entry:     &xspb.FileDirectory_Subdirectory_{&xspb.FileDirectory_Subdirectory{Subdirectory: &vname}},
+		}, nil
+	case columnarDirectoryFileGroup:
+		return &xspb.FileDirectory{
+			Directory: dir,
+			Entry:     &xspb.FileDirectory_File_{&xspb.FileDirectory_File{File: &vname}},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown group kind: %d", kind)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"kythe.io/kythe/go/util/keys"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 
 	cpb "kythe.io/kythe/proto/common_go_proto"
@@ -134,6 +135,11 @@ func TestDecorationsEncodingRoundtrip(t *testing.T) {
 				t.Errorf("Error decoding file for %T: %v", test.Entry, err)
 				return
 			}
+			if g, err := DecodeDecorationsGroup(key); err != nil {
+				t.Errorf("Error decoding group for %T: %v", test.Entry, err)
+			} else if g != DecorationsGroup(test) {
+				t.Errorf("Decoded group for %T: found %d; expected %d", test.Entry, g, DecorationsGroup(test))
+			}
 			found, err := DecodeDecorationsEntry(&file, string(key), kv.Value)
 			if err != nil {
 				t.Errorf("Error decoding %T: %v", test.Entry, err)
@@ -244,6 +250,217 @@ func TestCrossReferencesEncodingRoundtrip(t *testing.T) {
 	}
 }
 
+func TestCrossReferencesGroupKey(t *testing.T) {
+	src := &spb.VName{Corpus: "corpus", Path: "path", Signature: "sig"}
+	loc := &srvpb.ExpandedAnchor{
+		Ticket: "kythe:#anchor",
+		Span: &cpb.Span{
+			Start: &cpb.Point{ByteOffset: 1},
+			End:   &cpb.Point{ByteOffset: 2},
+		},
+	}
+	tests := []*xspb.CrossReferences{{
+		Source: src,
+		Entry:  &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{}},
+	}, {
+		Source: src,
+		Entry: &xspb.CrossReferences_Reference_{&xspb.CrossReferences_Reference{
+			Kind:     &xspb.CrossReferences_Reference_GenericKind{"zzz"},
+			Location: loc,
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.CrossReferences_Relation_{&xspb.CrossReferences_Relation{
+			Node: &spb.VName{Signature: "relatedNode"},
+			Kind: &xspb.CrossReferences_Relation_KytheKind{scpb.EdgeKind_EXTENDS},
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.CrossReferences_Callsite_{&xspb.CrossReferences_Callsite{
+			Caller:   &spb.VName{Signature: "caller"},
+			Location: loc,
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.CrossReferences_RelatedNode_{&xspb.CrossReferences_RelatedNode{
+			Node: &scpb.Node{Source: &spb.VName{Signature: "relatedNode"}},
+		}},
+	}}
+
+	var lastKey string
+	for _, test := range tests {
+		kv, err := EncodeCrossReferencesEntry(nil, test)
+		if err != nil {
+			t.Fatalf("Error encoding %T: %v", test.Entry, err)
+		}
+		g := CrossReferencesGroup(test)
+		groupKey, err := CrossReferencesGroupKey(nil, src, g)
+		if err != nil {
+			t.Fatalf("Error encoding group key for %T: %v", test.Entry, err)
+		}
+		if !strings.HasPrefix(string(kv.Key), string(groupKey)) {
+			t.Errorf("Key for %T does not begin with its group (%d) key: %q vs %q", test.Entry, g, kv.Key, groupKey)
+		} else if string(groupKey) <= lastKey && g != XRefsIndexGroup {
+			t.Errorf("Group (%d) key for %T does not follow previous group's entries: %q <= %q", g, test.Entry, groupKey, lastKey)
+		}
+		lastKey = string(kv.Key)
+
+		// Decoding the key alone should preserve the entry's group.
+		var src spb.VName
+		key, err := keys.Parse(string(kv.Key), &src)
+		if err != nil {
+			t.Fatalf("Error decoding source for %T: %v", test.Entry, err)
+		}
+		found, err := DecodeCrossReferencesEntry(&src, key, nil)
+		if err != nil {
+			t.Errorf("Error decoding key for %T: %v", test.Entry, err)
+		} else if fg := CrossReferencesGroup(found); fg != g {
+			t.Errorf("Decoded key for %T has group %d; expected %d", test.Entry, fg, g)
+		} else if rn := test.GetRelatedNode(); rn != nil && !proto.Equal(found.GetRelatedNode().GetNode().GetSource(), rn.Node.Source) {
+			t.Errorf("Decoded key for %T has node %v; expected %v", test.Entry, found.GetRelatedNode().GetNode().GetSource(), rn.Node.Source)
+		}
+	}
+}
+
+func TestDocumentationEncodingRoundtrip(t *testing.T) {
+	src := &spb.VName{Corpus: "corpus", Root: "root", Path: "path", Signature: "sig"}
+	tests := []*xspb.Documentation{{
+		Source: src,
+		Entry: &xspb.Documentation_Index_{&xspb.Documentation_Index{
+			MarkedSource: &cpb.MarkedSource{Kind: cpb.MarkedSource_IDENTIFIER, PreText: "ident"},
+			RawText:      "some [doc] text",
+			Link:         []*cpb.Link{{Definition: []string{"kythe:#linked"}}},
+			DocumentedBy: &spb.VName{Signature: "parent"},
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Documentation_Child_{&xspb.Documentation_Child{
+			Child: &spb.VName{Signature: "child"},
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Documentation_LinkNode_{&xspb.Documentation_LinkNode{
+			Node: &srvpb.Node{
+				Ticket: "kythe:#linked",
+				Fact:   []*cpb.Fact{{Name: "/kythe/node/kind", Value: []byte("record")}},
+			},
+		}},
+	}}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%T", test.Entry), func(t *testing.T) {
+			kv, err := EncodeDocumentationEntry(nil, test)
+			if err != nil {
+				t.Errorf("Error encoding %T: %v", test.Entry, err)
+				return
+			}
+			var src spb.VName
+			key, err := keys.Parse(string(kv.Key), &src)
+			if err != nil {
+				t.Errorf("Error decoding source for %T: %v", test.Entry, err)
+				return
+			}
+			found, err := DecodeDocumentationEntry(&src, string(key), kv.Value)
+			if err != nil {
+				t.Errorf("Error decoding %T: %v", test.Entry, err)
+			} else if diff := cmp.Diff(test, found, ignoreProtoXXXFields); diff != "" {
+				t.Errorf("%T roundtrip differences: (- expected; + found)\n%s", test.Entry, diff)
+			}
+		})
+	}
+}
+
+func TestEdgesEncodingRoundtrip(t *testing.T) {
+	src := &spb.VName{Corpus: "corpus", Root: "root", Path: "path", Signature: "sig"}
+	tests := []*xspb.Edges{{
+		Source: src,
+		Entry: &xspb.Edges_Index_{&xspb.Edges_Index{
+			Node: &scpb.Node{
+				Source: src,
+				Kind:   &scpb.Node_KytheKind{scpb.NodeKind_RECORD},
+			},
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Edge_{&xspb.Edges_Edge{
+			Target:  &spb.VName{Signature: "target"},
+			Kind:    &xspb.Edges_Edge_KytheKind{scpb.EdgeKind_PARAM},
+			Ordinal: 3,
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Edge_{&xspb.Edges_Edge{
+			Target:  &spb.VName{Signature: "target"},
+			Kind:    &xspb.Edges_Edge_GenericKind{"/some/edge"},
+			Reverse: true,
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.Edges_Target_{&xspb.Edges_Target{
+			Node: &scpb.Node{Source: &spb.VName{Signature: "target"}},
+		}},
+	}}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%T", test.Entry), func(t *testing.T) {
+			kv, err := EncodeEdgesEntry(nil, test)
+			if err != nil {
+				t.Errorf("Error encoding %T: %v", test.Entry, err)
+				return
+			}
+			var src spb.VName
+			key, err := keys.Parse(string(kv.Key), &src)
+			if err != nil {
+				t.Errorf("Error decoding source for %T: %v", test.Entry, err)
+				return
+			}
+			found, err := DecodeEdgesEntry(&src, string(key), kv.Value)
+			if err != nil {
+				t.Errorf("Error decoding %T: %v", test.Entry, err)
+			} else if diff := cmp.Diff(test, found, ignoreProtoXXXFields); diff != "" {
+				t.Errorf("%T roundtrip differences: (- expected; + found)\n%s", test.Entry, diff)
+			}
+		})
+	}
+}
+
+func TestDirectoryEncodingRoundtrip(t *testing.T) {
+	dir := DirectoryVName("corpus", "root", "some/dir")
+	tests := []*xspb.FileDirectory{{
+		Directory: dir,
+		Entry: &xspb.FileDirectory_Subdirectory_{&xspb.FileDirectory_Subdirectory{
+			Subdirectory: DirectoryVName("corpus", "root", "some/dir/sub"),
+		}},
+	}, {
+		Directory: dir,
+		Entry: &xspb.FileDirectory_File_{&xspb.FileDirectory_File{
+			File: &spb.VName{Corpus: "corpus", Root: "root", Path: "some/dir/file"},
+		}},
+	}}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%T", test.Entry), func(t *testing.T) {
+			kv, err := EncodeDirectoryEntry(nil, test)
+			if err != nil {
+				t.Errorf("Error encoding %T: %v", test.Entry, err)
+				return
+			}
+			var dir spb.VName
+			key, err := keys.Parse(string(kv.Key), &dir)
+			if err != nil {
+				t.Errorf("Error decoding directory for %T: %v", test.Entry, err)
+				return
+			}
+			found, err := DecodeDirectoryEntry(&dir, string(key), kv.Value)
+			if err != nil {
+				t.Errorf("Error decoding %T: %v", test.Entry, err)
+			} else if diff := cmp.Diff(test, found, ignoreProtoXXXFields); diff != "" {
+				t.Errorf("%T roundtrip differences: (- expected; + found)\n%s", test.Entry, diff)
+			}
+		})
+	}
+}
+
 var ignoreProtoXXXFields = cmp.FilterPath(func(p cmp.Path) bool {
 	for _, s := range p {
 		if strings.HasPrefix(s.String(), ".XXX_") {
@@ -18,6 +18,7 @@ package xrefs
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -25,11 +26,16 @@ import (
 	"kythe.io/kythe/go/serving/xrefs/columnar"
 	"kythe.io/kythe/go/storage/inmemory"
 	"kythe.io/kythe/go/storage/keyvalue"
+	"kythe.io/kythe/go/storage/table"
 	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema"
 
+	"bitbucket.org/creachadair/stringset"
+	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 
 	cpb "kythe.io/kythe/proto/common_go_proto"
+	ipb "kythe.io/kythe/proto/internal_go_proto"
 	scpb "kythe.io/kythe/proto/schema_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
 	spb "kythe.io/kythe/proto/storage_go_proto"
@@ -53,6 +59,14 @@ func mustWriteXRef(t *testing.T, w keyvalue.Writer, fd *xspb.CrossReferences) {
 	mustWrite(t, w, kv.Key, kv.Value)
 }
 
+func mustWriteDoc(t *testing.T, w keyvalue.Writer, doc *xspb.Documentation) {
+	kv, err := columnar.EncodeDocumentationEntry(columnar.DocumentationKeyPrefix, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, w, kv.Key, kv.Value)
+}
+
 func mustWrite(t *testing.T, w keyvalue.Writer, key, val []byte) {
 	if err := w.Write(key, val); err != nil {
 		t.Fatal(err)
@@ -294,6 +308,212 @@ func TestServingDecorations(t *testing.T) {
 	// TODO(schroederc): test diagnostics (w/ or w/o span)
 }
 
+// TestServingDecorations_spans checks that the legacy and columnar tables
+// agree on SPAN requests and dirty buffers.
+func TestServingDecorations_spans(t *testing.T) {
+	ctx := context.Background()
+
+	file := &spb.VName{Path: "file"}
+	fileTicket := kytheuri.ToString(file)
+	text := []byte("func foo() {\n\tbar(baz)\n}\n")
+	targets := []struct {
+		start, end int32
+		kind       scpb.EdgeKind
+		target     string
+	}{
+		{5, 8, scpb.EdgeKind_DEFINES_BINDING, "foo"},
+		{14, 17, scpb.EdgeKind_REF, "bar"},
+		{14, 22, scpb.EdgeKind_REF_CALL, "bar"},
+		{18, 21, scpb.EdgeKind_REF, "baz"},
+	}
+	nodes := []string{"bar", "baz", "foo"}
+
+	// Construct the legacy table.
+	decor := &srvpb.FileDecorations{
+		File: &srvpb.File{Ticket: fileTicket, Text: text, Encoding: "utf-8"},
+	}
+	for _, t := range targets {
+		decor.Decoration = append(decor.Decoration, &srvpb.FileDecorations_Decoration{
+			Anchor: &srvpb.RawAnchor{StartOffset: t.start, EndOffset: t.end},
+			Kind:   schema.EdgeKindString(t.kind),
+			Target: kytheuri.ToString(&spb.VName{Signature: t.target}),
+		})
+	}
+	for _, n := range nodes {
+		decor.Target = append(decor.Target, &srvpb.Node{
+			Ticket: kytheuri.ToString(&spb.VName{Signature: n}),
+			Fact:   []*cpb.Fact{{Name: "/kythe/node/kind", Value: []byte("function")}},
+		})
+	}
+	pt := &table.KVProto{DB: inmemory.NewKeyValueDB()}
+	if err := pt.Put(ctx, DecorationsKey(fileTicket), decor); err != nil {
+		t.Fatal(err)
+	}
+
+	// Construct the columnar table.
+	db := inmemory.NewKeyValueDB()
+	w, err := db.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, w, []byte(ColumnarTableKeyMarker), []byte{})
+	fds := []*xspb.FileDecorations{{
+		File:  file,
+		Entry: &xspb.FileDecorations_Index_{&xspb.FileDecorations_Index{TextEncoding: "utf-8"}},
+	}, {
+		File: file,
+		Entry: &xspb.FileDecorations_Text_{&xspb.FileDecorations_Text{
+			EndOffset: int32(len(text)),
+			Text:      text,
+		}},
+	}}
+	for _, t := range targets {
+		fds = append(fds, &xspb.FileDecorations{
+			File: file,
+			Entry: &xspb.FileDecorations_Target_{&xspb.FileDecorations_Target{
+				StartOffset: t.start,
+				EndOffset:   t.end,
+				Kind:        &xspb.FileDecorations_Target_KytheKind{t.kind},
+				Target:      &spb.VName{Signature: t.target},
+			}},
+		})
+	}
+	for _, n := range nodes {
+		fds = append(fds, &xspb.FileDecorations{
+			File: file,
+			Entry: &xspb.FileDecorations_TargetNode_{&xspb.FileDecorations_TargetNode{
+				Node: &scpb.Node{
+					Source: &spb.VName{Signature: n},
+					Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
+				},
+			}},
+		})
+	}
+	for _, fd := range fds {
+		mustWriteDecor(t, w, fd)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tables := []struct {
+		name string
+		xs   xrefs.Service
+	}{
+		{"legacy", NewCombinedTable(pt)},
+		{"columnar", NewService(ctx, db)},
+	}
+
+	spanLoc := func(start, end int32) *xpb.Location {
+		return &xpb.Location{
+			Ticket: fileTicket,
+			Kind:   xpb.Location_SPAN,
+			Span: &cpb.Span{
+				Start: &cpb.Point{ByteOffset: start},
+				End:   &cpb.Point{ByteOffset: end},
+			},
+		}
+	}
+	dirty := []byte("// comment\nfunc foo() {\n\tbar(qux)\n}\n")
+
+	tests := []struct {
+		name string
+		req  *xpb.DecorationsRequest
+
+		refs       []string // "start-end kind target"
+		nodes      []string
+		sourceText string
+	}{{
+		name: "file",
+		req:  &xpb.DecorationsRequest{Location: &xpb.Location{Ticket: fileTicket}},
+		refs: []string{
+			"5-8 /kythe/edge/defines/binding kythe:#foo",
+			"14-17 /kythe/edge/ref kythe:#bar",
+			"14-22 /kythe/edge/ref/call kythe:#bar",
+			"18-21 /kythe/edge/ref kythe:#baz",
+		},
+		nodes: []string{"kythe:#bar", "kythe:#baz", "kythe:#foo"},
+	}, {
+		name: "within_span",
+		req: &xpb.DecorationsRequest{
+			Location:   spanLoc(13, 23),
+			SpanKind:   xpb.DecorationsRequest_WITHIN_SPAN,
+			SourceText: true,
+		},
+		refs: []string{
+			"14-17 /kythe/edge/ref kythe:#bar",
+			"14-22 /kythe/edge/ref/call kythe:#bar",
+			"18-21 /kythe/edge/ref kythe:#baz",
+		},
+		nodes:      []string{"kythe:#bar", "kythe:#baz"},
+		sourceText: "\tbar(baz)\n",
+	}, {
+		name: "around_span",
+		req: &xpb.DecorationsRequest{
+			Location: spanLoc(18, 19),
+			SpanKind: xpb.DecorationsRequest_AROUND_SPAN,
+		},
+		refs: []string{
+			"14-22 /kythe/edge/ref/call kythe:#bar",
+			"18-21 /kythe/edge/ref kythe:#baz",
+		},
+		nodes: []string{"kythe:#bar", "kythe:#baz"},
+	}, {
+		name: "dirty_buffer",
+		req: &xpb.DecorationsRequest{
+			Location:    &xpb.Location{Ticket: fileTicket},
+			DirtyBuffer: dirty,
+			SourceText:  true,
+		},
+		// Anchors overlapping the edited region no longer exist.
+		refs: []string{
+			"16-19 /kythe/edge/defines/binding kythe:#foo",
+			"25-28 /kythe/edge/ref kythe:#bar",
+		},
+		nodes:      []string{"kythe:#bar", "kythe:#foo"},
+		sourceText: string(dirty),
+	}, {
+		name: "dirty_buffer_span",
+		req: &xpb.DecorationsRequest{
+			Location:    spanLoc(24, 34),
+			SpanKind:    xpb.DecorationsRequest_WITHIN_SPAN,
+			DirtyBuffer: dirty,
+			SourceText:  true,
+		},
+		refs:       []string{"25-28 /kythe/edge/ref kythe:#bar"},
+		nodes:      []string{"kythe:#bar"},
+		sourceText: "\tbar(qux)\n",
+	}}
+
+	for _, tbl := range tables {
+		for _, test := range tests {
+			t.Run(tbl.name+"/"+test.name, func(t *testing.T) {
+				req := proto.Clone(test.req).(*xpb.DecorationsRequest)
+				req.References = true
+				req.Filter = []string{"**"}
+				reply, err := tbl.xs.Decorations(ctx, req)
+				if err != nil {
+					t.Fatalf("Decorations error: %v", err)
+				}
+
+				var refs []string
+				for _, r := range reply.Reference {
+					refs = append(refs, fmt.Sprintf("%d-%d %s %s", r.Span.Start.ByteOffset, r.Span.End.ByteOffset, r.Kind, r.TargetTicket))
+				}
+				if diff := cmp.Diff(test.refs, refs); diff != "" {
+					t.Errorf("References differences: (- expected; + found)\n%s", diff)
+				}
+				if diff := cmp.Diff(test.nodes, stringset.FromKeys(reply.Nodes).Elements()); diff != "" {
+					t.Errorf("Nodes differences: (- expected; + found)\n%s", diff)
+				}
+				if found := string(reply.SourceText); found != test.sourceText {
+					t.Errorf("Expected source text %q; found %q", test.sourceText, found)
+				}
+			})
+		}
+	}
+}
+
 func makeDecorTestCase(ctx context.Context, xs xrefs.Service, req *xpb.DecorationsRequest, expected *xpb.DecorationsReply) func(*testing.T) {
 	return func(t *testing.T) {
 		reply, err := xs.Decorations(ctx, req)
@@ -390,6 +610,25 @@ func TestServingCrossReferences(t *testing.T) {
 				Span:   span,
 			},
 		}},
+	}, {
+		Source: src,
+		Entry: &xspb.CrossReferences_Caller_{&xspb.CrossReferences_Caller{
+			Caller: &spb.VName{Signature: "overrideCaller"},
+			Location: &srvpb.ExpandedAnchor{
+				Ticket: "kythe:?path=path#overrideCaller",
+				Span:   span,
+			},
+		}},
+	}, {
+		Source: src,
+		Entry: &xspb.CrossReferences_Callsite_{&xspb.CrossReferences_Callsite{
+			Caller: &spb.VName{Signature: "overrideCaller"},
+			Kind:   xspb.CrossReferences_Callsite_OVERRIDE,
+			Location: &srvpb.ExpandedAnchor{
+				Ticket: "kythe:?path=path4#callsite_override",
+				Span:   span,
+			},
+		}},
 	}, {
 		Source: src,
 		Entry: &xspb.CrossReferences_Relation_{&xspb.CrossReferences_Relation{
@@ -404,6 +643,10 @@ func TestServingCrossReferences(t *testing.T) {
 				Source: &spb.VName{Signature: "relatedNode"},
 				Kind:   &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION},
 			},
+			DefinitionLocation: &srvpb.ExpandedAnchor{
+				Ticket: "kythe:?path=path#relatedNodeDef",
+				Span:   span,
+			},
 		}},
 	}}
 	for _, xr := range xrefs {
@@ -433,6 +676,9 @@ func TestServingCrossReferences(t *testing.T) {
 		Filter:          []string{"**"},
 		RelatedNodeKind: []string{"NONE"},
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{
+			RelatedNodesByRelation: map[string]int64{},
+		},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -452,6 +698,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:        []string{ticket},
 		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{References: 2},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -466,6 +713,10 @@ func TestServingCrossReferences(t *testing.T) {
 		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
 		Filter:        []string{"**"},
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{
+			References:             2,
+			RelatedNodesByRelation: map[string]int64{"%/kythe/edge/childof": 1},
+		},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -491,10 +742,51 @@ func TestServingCrossReferences(t *testing.T) {
 		},
 	}))
 
+	t.Run("related_node_definitions", makeXRefTestCase(ctx, xs, &xpb.CrossReferencesRequest{
+		Ticket:          []string{ticket},
+		Filter:          []string{"**"},
+		NodeDefinitions: true,
+	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{
+			RelatedNodesByRelation: map[string]int64{"%/kythe/edge/childof": 1},
+		},
+		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
+			ticket: {
+				Ticket:       ticket,
+				MarkedSource: ms,
+				RelatedNode: []*xpb.CrossReferencesReply_RelatedNode{{
+					Ticket:       "kythe:#relatedNode",
+					RelationKind: "%/kythe/edge/childof",
+				}},
+			},
+		},
+		Nodes: map[string]*cpb.NodeInfo{
+			ticket: {
+				Facts: map[string][]byte{
+					"/kythe/node/kind": []byte("record"),
+				},
+			},
+			"kythe:#relatedNode": {
+				Facts: map[string][]byte{
+					"/kythe/node/kind": []byte("function"),
+				},
+				Definition: "kythe:?path=path#relatedNodeDef",
+			},
+		},
+		DefinitionLocations: map[string]*xpb.Anchor{
+			"kythe:?path=path#relatedNodeDef": {
+				Ticket: "kythe:?path=path#relatedNodeDef",
+				Parent: "kythe:?path=path",
+				Span:   span,
+			},
+		},
+	}))
+
 	t.Run("non_call_refs", makeXRefTestCase(ctx, xs, &xpb.CrossReferencesRequest{
 		Ticket:        []string{ticket},
 		ReferenceKind: xpb.CrossReferencesRequest_NON_CALL_REFERENCES,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{References: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -508,6 +800,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:     []string{ticket},
 		CallerKind: xpb.CrossReferencesRequest_OVERRIDE_CALLERS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Callers: 2},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -526,6 +819,16 @@ func TestServingCrossReferences(t *testing.T) {
 						Parent: "kythe:?path=path3",
 						Span:   span,
 					}},
+				}, {
+					Ticket: "kythe:#overrideCaller",
+					Anchor: &xpb.Anchor{
+						Parent: "kythe:?path=path",
+						Span:   span,
+					},
+					Site: []*xpb.Anchor{{
+						Parent: "kythe:?path=path4",
+						Span:   span,
+					}},
 				}},
 			},
 		},
@@ -535,6 +838,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:     []string{ticket},
 		CallerKind: xpb.CrossReferencesRequest_DIRECT_CALLERS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Callers: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -556,6 +860,255 @@ func TestServingCrossReferences(t *testing.T) {
 	}))
 }
 
+func TestServingCrossReferences_merge(t *testing.T) {
+	ctx := context.Background()
+	db := inmemory.NewKeyValueDB()
+	w, err := db.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mark table as columnar
+	mustWrite(t, w, []byte(ColumnarTableKeyMarker), []byte{})
+
+	src := &spb.VName{Language: "go", Signature: "generated"}
+	protoNode := &spb.VName{Language: "protobuf", Signature: "message"}
+	other := &spb.VName{Language: "java", Signature: "generated"}
+	span := &cpb.Span{
+		Start: &cpb.Point{ByteOffset: 5},
+		End:   &cpb.Point{ByteOffset: 9},
+	}
+	ref := func(src *spb.VName, path string) *xspb.CrossReferences {
+		return &xspb.CrossReferences{
+			Source: src,
+			Entry: &xspb.CrossReferences_Reference_{&xspb.CrossReferences_Reference{
+				Kind: &xspb.CrossReferences_Reference_KytheKind{scpb.EdgeKind_REF},
+				Location: &srvpb.ExpandedAnchor{
+					Ticket: "kythe:?path=" + path + "#ref",
+					Span:   span,
+				},
+			}},
+		}
+	}
+	xrefs := []*xspb.CrossReferences{{
+		Source: src,
+		Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
+			Node:      &scpb.Node{},
+			MergeWith: []*spb.VName{protoNode},
+		}},
+	}, ref(src, "go"), {
+		Source: protoNode,
+		Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
+			Node:      &scpb.Node{},
+			MergeWith: []*spb.VName{src, other},
+		}},
+	}, ref(protoNode, "proto"), {
+		Source: other,
+		Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
+			Node:      &scpb.Node{},
+			MergeWith: []*spb.VName{protoNode},
+		}},
+	}, ref(other, "java")}
+	for _, xr := range xrefs {
+		mustWriteXRef(t, w, xr)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	xs := NewService(ctx, db)
+
+	ticket := kytheuri.ToString(src)
+	t.Run("refs", makeXRefTestCase(ctx, xs, &xpb.CrossReferencesRequest{
+		Ticket:        []string{ticket},
+		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
+	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{References: 3},
+		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
+			ticket: {
+				Ticket: ticket,
+				Reference: []*xpb.CrossReferencesReply_RelatedAnchor{{
+					Anchor: &xpb.Anchor{Parent: "kythe:?path=go", Span: span},
+				}, {
+					Anchor: &xpb.Anchor{Parent: "kythe:?path=proto", Span: span},
+				}, {
+					Anchor: &xpb.Anchor{Parent: "kythe:?path=java", Span: span},
+				}},
+				MergedTicket: []string{kytheuri.ToString(protoNode), kytheuri.ToString(other)},
+			},
+		},
+	}))
+}
+
+func TestServingCrossReferences_paging(t *testing.T) {
+	ctx := context.Background()
+	db := inmemory.NewKeyValueDB()
+	w, err := db.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mark table as columnar
+	mustWrite(t, w, []byte(ColumnarTableKeyMarker), []byte{})
+
+	src := &spb.VName{Language: "go", Signature: "generated"}
+	protoNode := &spb.VName{Language: "protobuf", Signature: "message"}
+	caller := &spb.VName{Signature: "caller"}
+	span := &cpb.Span{
+		Start: &cpb.Point{ByteOffset: 5},
+		End:   &cpb.Point{ByteOffset: 9},
+	}
+	ref := func(src *spb.VName, path string) *xspb.CrossReferences {
+		return &xspb.CrossReferences{
+			Source: src,
+			Entry: &xspb.CrossReferences_Reference_{&xspb.CrossReferences_Reference{
+				Kind: &xspb.CrossReferences_Reference_KytheKind{scpb.EdgeKind_REF},
+				Location: &srvpb.ExpandedAnchor{
+					Ticket: "kythe:?path=" + path + "#ref",
+					Span:   span,
+				},
+			}},
+		}
+	}
+	callsite := func(path string) *xspb.CrossReferences {
+		return &xspb.CrossReferences{
+			Source: src,
+			Entry: &xspb.CrossReferences_Callsite_{&xspb.CrossReferences_Callsite{
+				Caller: caller,
+				Kind:   xspb.CrossReferences_Callsite_DIRECT,
+				Location: &srvpb.ExpandedAnchor{
+					Ticket: "kythe:?path=" + path + "#callsite",
+					Span:   span,
+				},
+			}},
+		}
+	}
+	xrefs := []*xspb.CrossReferences{{
+		Source: src,
+		Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
+			Node:      &scpb.Node{},
+			MergeWith: []*spb.VName{protoNode},
+		}},
+	}, ref(src, "a"), ref(src, "b"), {
+		Source: src,
+		Entry: &xspb.CrossReferences_Caller_{&xspb.CrossReferences_Caller{
+			Caller: caller,
+			Location: &srvpb.ExpandedAnchor{
+				Ticket: "kythe:?path=path#caller",
+				Span:   span,
+			},
+		}},
+	}, callsite("c1"), callsite("c2"), {
+		Source: src,
+		Entry: &xspb.CrossReferences_Relation_{&xspb.CrossReferences_Relation{
+			Node: &spb.VName{Signature: "relatedNode"},
+			Kind: &xspb.CrossReferences_Relation_KytheKind{scpb.EdgeKind_CHILD_OF},
+		}},
+	}, {
+		Source: protoNode,
+		Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
+			Node:      &scpb.Node{},
+			MergeWith: []*spb.VName{src},
+		}},
+	}, ref(protoNode, "proto")}
+	for _, xr := range xrefs {
+		mustWriteXRef(t, w, xr)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Iterators that cannot seek must produce the same pages.
+	for _, db := range []keyvalue.DB{db, noSeekDB{db}} {
+		testCrossReferencesPaging(ctx, t, NewService(ctx, db), kytheuri.ToString(src), len(xrefs))
+	}
+}
+
+// testCrossReferencesPaging checks the pages of the cross-references for
+// ticket written by TestServingCrossReferences_paging.
+func testCrossReferencesPaging(ctx context.Context, t *testing.T, xs xrefs.Service, ticket string, maxPages int) {
+	req := &xpb.CrossReferencesRequest{
+		Ticket:        []string{ticket},
+		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
+		CallerKind:    xpb.CrossReferencesRequest_DIRECT_CALLERS,
+		PageSize:      2,
+	}
+	expectedTotal := &xpb.CrossReferencesReply_Total{
+		References: 3,
+		Callers:    1,
+	}
+
+	var pages [][]string
+	for {
+		reply, err := xs.CrossReferences(ctx, req)
+		if err != nil {
+			t.Fatalf("CrossReferences(%v) error: %v", req, err)
+		}
+		if diff := cmp.Diff(expectedTotal, reply.Total, ignoreProtoXXXFields); diff != "" {
+			t.Errorf("Page %d: Total differences: (- expected; + found)\n%s", len(pages), diff)
+		}
+		var page []string
+		if set := reply.CrossReferences[ticket]; set != nil {
+			for _, r := range set.Reference {
+				page = append(page, r.Anchor.Parent)
+			}
+			for _, c := range set.Caller {
+				page = append(page, c.Ticket)
+				for _, site := range c.Site {
+					page = append(page, site.Parent)
+				}
+			}
+		}
+		pages = append(pages, page)
+		if reply.NextPageToken == "" {
+			break
+		} else if len(pages) > maxPages {
+			t.Fatalf("Too many pages: %v", pages)
+		}
+		req.PageToken = reply.NextPageToken
+	}
+
+	// Each caller counts as a single cross-reference along with its callsites.
+	expected := [][]string{
+		{"kythe:?path=a", "kythe:?path=b"},
+		{"kythe:?path=proto", "kythe:#caller", "kythe:?path=c1", "kythe:?path=c2"},
+	}
+	if diff := cmp.Diff(expected, pages); diff != "" {
+		t.Errorf("Page differences: (- expected; + found)\n%s", diff)
+	}
+
+	for _, token := range []string{"!invalid!", "AAAA"} {
+		req.PageToken = token
+		if reply, err := xs.CrossReferences(ctx, req); err == nil {
+			t.Errorf("Expected error for invalid page_token %q; found: %v", token, reply)
+		}
+	}
+}
+
+// noSeekDB is a keyvalue.DB whose iterators do not implement
+// keyvalue.SeekIterator.
+type noSeekDB struct{ keyvalue.DB }
+
+func (db noSeekDB) ScanPrefix(ctx context.Context, prefix []byte, opts *keyvalue.Options) (keyvalue.Iterator, error) {
+	it, err := db.DB.ScanPrefix(ctx, prefix, opts)
+	return struct{ keyvalue.Iterator }{it}, err
+}
+
+func TestTokenTotals(t *testing.T) {
+	total := &xpb.CrossReferencesReply_Total{
+		Definitions:            1,
+		References:             1 << 40,
+		Callers:                3,
+		RelatedNodesByRelation: map[string]int64{"%/kythe/edge/childof": 1<<31 + 1},
+	}
+	token := &ipb.PageToken{}
+	writeTokenTotals(token, total)
+	found := &xpb.CrossReferencesReply_Total{RelatedNodesByRelation: make(map[string]int64)}
+	readTokenTotals(token, found)
+	if diff := cmp.Diff(total, found, ignoreProtoXXXFields); diff != "" {
+		t.Errorf("Total differences: (- expected; + found)\n%s", diff)
+	}
+}
+
 func makeXRefTestCase(ctx context.Context, xs xrefs.Service, req *xpb.CrossReferencesRequest, expected *xpb.CrossReferencesReply) func(*testing.T) {
 	return func(t *testing.T) {
 		reply, err := xs.CrossReferences(ctx, req)
@@ -576,3 +1129,151 @@ var ignoreProtoXXXFields = cmp.FilterPath(func(p cmp.Path) bool {
 	}
 	return false
 }, cmp.Ignore())
+
+func TestServingDocumentation(t *testing.T) {
+	ctx := context.Background()
+	db := inmemory.NewKeyValueDB()
+	w, err := db.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mark table as columnar
+	mustWrite(t, w, []byte(ColumnarTableKeyMarker), []byte{})
+
+	src := &spb.VName{Language: "go", Signature: "documented"}
+	child := &spb.VName{Language: "go", Signature: "child"}
+	subsumed := &spb.VName{Language: "go", Signature: "subsumed"}
+	legacy := &spb.VName{Language: "go", Signature: "legacy"}
+	ms := &cpb.MarkedSource{Kind: cpb.MarkedSource_IDENTIFIER, PreText: "documented"}
+	linked := &srvpb.Node{
+		Ticket: "kythe:?lang=go#linked",
+		Fact:   []*cpb.Fact{{Name: "/kythe/node/kind", Value: []byte("record")}},
+		DefinitionLocation: &srvpb.ExpandedAnchor{
+			Ticket: "kythe:?path=file#def",
+			Span: &cpb.Span{
+				Start: &cpb.Point{ByteOffset: 1},
+				End:   &cpb.Point{ByteOffset: 2},
+			},
+		},
+	}
+	docs := []*xspb.Documentation{{
+		Source: src,
+		Entry: &xspb.Documentation_Index_{&xspb.Documentation_Index{
+			MarkedSource: ms,
+			RawText:      "some [doc]",
+			Link:         []*cpb.Link{{Definition: []string{linked.Ticket}}},
+		}},
+	}, {
+		Source: src,
+		Entry:  &xspb.Documentation_Child_{&xspb.Documentation_Child{Child: child}},
+	}, {
+		Source: src,
+		Entry:  &xspb.Documentation_LinkNode_{&xspb.Documentation_LinkNode{Node: linked}},
+	}, {
+		Source: child,
+		Entry: &xspb.Documentation_Index_{&xspb.Documentation_Index{
+			RawText: "child doc",
+		}},
+	}, {
+		Source: subsumed,
+		Entry: &xspb.Documentation_Index_{&xspb.Documentation_Index{
+			DocumentedBy: child,
+		}},
+	}}
+	for _, doc := range docs {
+		mustWriteDoc(t, w, doc)
+	}
+
+	// Documentation missing from the columnar table falls back to the legacy
+	// combined table format.
+	rec, err := proto.Marshal(&srvpb.Document{
+		Ticket:  kytheuri.ToString(legacy),
+		RawText: "legacy doc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, w, DocumentationKey(kytheuri.ToString(legacy)), rec)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	xs := NewService(ctx, db)
+
+	tests := []struct {
+		Name     string
+		Request  *xpb.DocumentationRequest
+		Expected *xpb.DocumentationReply
+	}{{
+		Name: "children",
+		Request: &xpb.DocumentationRequest{
+			Ticket:          []string{kytheuri.ToString(src)},
+			IncludeChildren: true,
+		},
+		Expected: &xpb.DocumentationReply{
+			Document: []*xpb.DocumentationReply_Document{{
+				Ticket:       kytheuri.ToString(src),
+				Text:         &xpb.Printable{RawText: "some [doc]", Link: []*cpb.Link{{Definition: []string{linked.Ticket}}}},
+				MarkedSource: ms,
+				Children: []*xpb.DocumentationReply_Document{{
+					Ticket: kytheuri.ToString(child),
+					Text:   &xpb.Printable{RawText: "child doc"},
+				}},
+			}},
+			Nodes: map[string]*cpb.NodeInfo{
+				linked.Ticket: {
+					Facts:      map[string][]byte{"/kythe/node/kind": []byte("record")},
+					Definition: "kythe:?path=file#def",
+				},
+			},
+			DefinitionLocations: map[string]*xpb.Anchor{
+				"kythe:?path=file#def": {
+					Ticket: "kythe:?path=file#def",
+					Parent: "kythe:?path=file",
+					Span:   linked.DefinitionLocation.Span,
+				},
+			},
+		},
+	}, {
+		Name:    "documented_by",
+		Request: &xpb.DocumentationRequest{Ticket: []string{kytheuri.ToString(subsumed)}},
+		Expected: &xpb.DocumentationReply{
+			Document: []*xpb.DocumentationReply_Document{{
+				Ticket: kytheuri.ToString(subsumed),
+				Text:   &xpb.Printable{RawText: "child doc"},
+			}},
+			Nodes:               map[string]*cpb.NodeInfo{},
+			DefinitionLocations: map[string]*xpb.Anchor{},
+		},
+	}, {
+		Name:    "legacy_fallback",
+		Request: &xpb.DocumentationRequest{Ticket: []string{kytheuri.ToString(legacy)}},
+		Expected: &xpb.DocumentationReply{
+			Document: []*xpb.DocumentationReply_Document{{
+				Ticket: kytheuri.ToString(legacy),
+				Text:   &xpb.Printable{RawText: "legacy doc"},
+			}},
+			Nodes:               map[string]*cpb.NodeInfo{},
+			DefinitionLocations: map[string]*xpb.Anchor{},
+		},
+	}, {
+		Name:    "missing",
+		Request: &xpb.DocumentationRequest{Ticket: []string{"kythe:#missing"}},
+		Expected: &xpb.DocumentationReply{
+			Nodes:               map[string]*cpb.NodeInfo{},
+			DefinitionLocations: map[string]*xpb.Anchor{},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			reply, err := xs.Documentation(ctx, test.Request)
+			if err != nil {
+				t.Fatalf("Documentation error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expected, reply, ignoreProtoXXXFields); diff != "" {
+				t.Fatalf("DocumentationReply differences: (- expected; + found)\n%s", diff)
+			}
+		})
+	}
+}
@@ -507,6 +507,9 @@ func (t *Table) CrossReferences(ctx context.Context, req *xpb.CrossReferencesReq
 		if crs.MarkedSource == nil {
 			crs.MarkedSource = cr.MarkedSource
 		}
+		if tickets[i] != ticket {
+			crs.MergedTicket = append(crs.MergedTicket, tickets[i])
+		}
 
 		if *mergeCrossReferences {
 			// Add any additional merge nodes to the set of table lookups
@@ -693,10 +696,12 @@ func (s *refStats) addCallers(crs *xpb.CrossReferencesReply_CrossReferenceSet, g
 	s.total += len(cs)
 	for _, c := range cs {
 		ra := &xpb.CrossReferencesReply_RelatedAnchor{
-			Anchor: a2a(c.Caller, false).Anchor,
 			Ticket: c.SemanticCaller,
 			Site:   make([]*xpb.Anchor, 0, len(c.Callsite)),
 		}
+		if c.Caller != nil {
+			ra.Anchor = a2a(c.Caller, false).Anchor
+		}
 		ra.MarkedSource = c.MarkedSource
 		for _, site := range c.Callsite {
 			ra.Site = append(ra.Site, a2a(site, false).Anchor)
@@ -901,7 +906,9 @@ func (t *Table) Documentation(ctx context.Context, req *xpb.DocumentationRequest
 }
 
 func clearRelatedSnippets(ra *xpb.CrossReferencesReply_RelatedAnchor) {
-	clearSnippet(ra.Anchor)
+	if ra.Anchor != nil {
+		clearSnippet(ra.Anchor)
+	}
 	for _, site := range ra.Site {
 		clearSnippet(site)
 	}
@@ -925,6 +925,10 @@ func TestCrossReferencesMerge(t *testing.T) {
 			RelationKind: "/kythe/edge/param",
 			Ordinal:      1,
 		}},
+		MergedTicket: []string{
+			"kythe://someCorpus?lang=otpl#withCallers",
+			"kythe://someCorpus?lang=otpl#withRelated",
+		},
 	}
 
 	if err := testutil.DeepEqual(&xpb.CrossReferencesReply_Total{
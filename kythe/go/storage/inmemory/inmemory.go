@@ -159,6 +159,12 @@ func (p *kvPrefixIterator) Next() (key, val []byte, err error) {
 	return []byte(k), []byte(v), nil
 }
 
+// Seek implements part of the keyvalue.SeekIterator interface.
+func (p *kvPrefixIterator) Seek(key []byte) error {
+	p.idx = sort.SearchStrings(p.db.keys, string(key))
+	return nil
+}
+
 // Close implements part of the keyvalue.Iterator interface.
 func (p *kvPrefixIterator) Close() error {
 	p.db.mu.RUnlock()
@@ -169,7 +175,7 @@ func (p *kvPrefixIterator) Close() error {
 func (k *KeyValueDB) ScanPrefix(ctx context.Context, prefix []byte, opts *keyvalue.Options) (keyvalue.Iterator, error) {
 	k.mu.RLock()
 	p := string(prefix)
-	i := sort.Search(len(k.keys), func(i int) bool { return strings.HasPrefix(k.keys[i], p) })
+	i := sort.Search(len(k.keys), func(i int) bool { return strings.Compare(k.keys[i], p) >= 0 })
 	return &kvPrefixIterator{k, p, i}, nil
 }
 
@@ -191,6 +197,12 @@ func (p *kvRangeIterator) Next() (key, val []byte, err error) {
 	return []byte(k), []byte(v), nil
 }
 
+// Seek implements part of the keyvalue.SeekIterator interface.
+func (p *kvRangeIterator) Seek(key []byte) error {
+	p.idx = sort.SearchStrings(p.db.keys, string(key))
+	return nil
+}
+
 // Close implements part of the keyvalue.Iterator interface.
 func (p *kvRangeIterator) Close() error {
 	p.db.mu.RUnlock()
@@ -122,6 +122,69 @@ func TestKeyValueDB_scanPrefix(t *testing.T) {
 	}
 }
 
+func TestKeyValueDB_scanPrefixFollowingKeys(t *testing.T) {
+	db := NewKeyValueDB()
+	writeEntries(t, db, []entry{
+		{"a", "val"},
+		{"b", "val"},
+		{"c", "val"},
+		{"d", "val"},
+	})
+
+	it, err := db.ScanPrefix(ctx, []byte("a"), nil)
+	if err != nil {
+		t.Fatalf("ScanPrefix error: %v", err)
+	}
+	defer it.Close()
+
+	if k, _, err := it.Next(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	} else if found := string(k); found != "a" {
+		t.Errorf("Expected key %q; found %q", "a", found)
+	}
+	if k, _, err := it.Next(); err != io.EOF {
+		t.Errorf("Expected io.EOF; found key %q (err: %v)", k, err)
+	}
+}
+
+func TestKeyValueDB_seek(t *testing.T) {
+	db := NewKeyValueDB()
+	writeEntries(t, db, []entry{
+		{"j0", "val0"},
+		{"k0", "val0"},
+		{"k1", "val1"},
+		{"k3", "val3"},
+		{"l0", "val0"},
+	})
+
+	kvit, err := db.ScanPrefix(ctx, []byte("k"), nil)
+	if err != nil {
+		t.Fatalf("ScanPrefix error: %v", err)
+	}
+	defer kvit.Close()
+	it, ok := kvit.(keyvalue.SeekIterator)
+	if !ok {
+		t.Fatalf("Iterator %T does not implement keyvalue.SeekIterator", kvit)
+	}
+
+	if err := it.Seek([]byte("k2")); err != nil {
+		t.Fatalf("Seek error: %v", err)
+	}
+	if k, _, err := it.Next(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	} else if found := string(k); found != "k3" {
+		t.Errorf("Expected key %q; found %q", "k3", found)
+	}
+
+	// Seeking past the prefix exhausts the iterator.
+	if err := it.Seek([]byte("l")); err != nil {
+		t.Fatalf("Seek error: %v", err)
+	}
+	if k, _, err := it.Next(); err != io.EOF {
+		t.Errorf("Expected io.EOF; found key %q (err: %v)", k, err)
+	}
+}
+
 func TestKeyValueDB_scanRange(t *testing.T) {
 	db := NewKeyValueDB()
 
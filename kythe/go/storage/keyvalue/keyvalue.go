@@ -126,6 +126,17 @@ type Iterator interface {
 	Next() (key, val []byte, err error)
 }
 
+// SeekIterator is an Iterator that can skip ahead to a given key.  An Iterator
+// returned by a DB may optionally implement SeekIterator.
+type SeekIterator interface {
+	Iterator
+
+	// Seek positions the Iterator such that the next call to Next returns the
+	// first entry with a key greater than or equal to the given key.  The key
+	// should not precede the Iterator's current position.
+	Seek(key []byte) error
+}
+
 // Writer provides write access to a DB. Writes must be Closed when no longer
 // used to ensure that resources are not leaked.
 type Writer interface {
@@ -260,3 +260,9 @@ func (i iterator) Next() ([]byte, []byte, error) {
 	i.it.Next()
 	return key, val, nil
 }
+
+// Seek implements part of the keyvalue.SeekIterator interface.
+func (i iterator) Seek(key []byte) error {
+	i.it.Seek(key)
+	return i.it.GetError()
+}
@@ -40,7 +40,6 @@ import (
 	gsrv "kythe.io/kythe/go/serving/graph"
 	xsrv "kythe.io/kythe/go/serving/xrefs"
 	"kythe.io/kythe/go/storage/leveldb"
-	"kythe.io/kythe/go/storage/table"
 )
 
 var (
@@ -63,9 +62,8 @@ func main() {
 	}
 	defer db.Close(ctx)
 	xs := xsrv.NewService(ctx, db)
-	tbl := &table.KVProto{db}
-	gs := gsrv.NewCombinedTable(tbl)
-	ft := &ftsrv.Table{Proto: tbl, PrefixedKeys: true}
+	gs := gsrv.NewService(ctx, db)
+	ft := ftsrv.NewService(ctx, db)
 
 	xrefs.RegisterHTTPHandlers(ctx, xs, http.DefaultServeMux)
 	graph.RegisterHTTPHandlers(ctx, gs, http.DefaultServeMux)
@@ -30,6 +30,7 @@ const Prefix = schema.Prefix + "edge/"
 
 // Edge kind labels
 const (
+	BoundedUpper            = Prefix + "bounded/upper"
 	ChildOf                 = Prefix + "childof"
 	Extends                 = Prefix + "extends"
 	ExtendsPrivate          = Prefix + "extends/private"
@@ -44,6 +45,7 @@ const (
 	Overrides               = Prefix + "overrides"
 	Param                   = Prefix + "param"
 	Satisfies               = Prefix + "satisfies"
+	TParam                  = Prefix + "tparam"
 	Typed                   = Prefix + "typed"
 )
 
@@ -56,17 +58,23 @@ const (
 	Documents         = Prefix + "documents"
 	Ref               = Prefix + "ref"
 	RefCall           = Prefix + "ref/call"
+	RefCallDynamic    = Prefix + "ref/call/dynamic"
+	RefDoc            = Prefix + "ref/doc"
 	RefImplicit       = Prefix + "ref/implicit"
 	RefCallImplicit   = Prefix + "ref/call/implicit"
 	RefImports        = Prefix + "ref/imports"
 	RefInit           = Prefix + "ref/init"
 	RefInitImplicit   = Prefix + "ref/init/implicit"
+	RefWrites         = Prefix + "ref/writes"
 	Tagged            = Prefix + "tagged"
 )
 
 // ParamIndex returns an edge label of the form "param.i" for the i given.
 func ParamIndex(i int) string { return Param + "." + strconv.Itoa(i) }
 
+// TParamIndex returns an edge label of the form "tparam.i" for the i given.
+func TParamIndex(i int) string { return TParam + "." + strconv.Itoa(i) }
+
 // revPrefix is used to distinguish reverse kinds from forward ones.
 const revPrefix = "%"
 
@@ -105,6 +113,14 @@ func IsAnchorEdge(kind string) bool {
 		IsVariant(canon, Ref) || IsVariant(canon, RefCall)
 }
 
+// IsCall reports whether kind is ref/call or one of its variants, other than
+// ref/call/dynamic.  A ref/call/dynamic edge records a possible target of a
+// dynamically dispatched call, not a call the source is known to make.
+func IsCall(kind string) bool {
+	canon := Canonical(kind)
+	return IsVariant(canon, RefCall) && !IsVariant(canon, RefCallDynamic)
+}
+
 var ordinalKind = regexp.MustCompile(`^(.+)\.(\d+)$`)
 
 // ParseOrdinal reports whether kind has an ordinal suffix (.nnn), and if so,
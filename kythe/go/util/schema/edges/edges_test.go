@@ -128,6 +128,26 @@ func TestIsVariant(t *testing.T) {
 	}
 }
 
+func TestIsCall(t *testing.T) {
+	tests := []struct {
+		kind string
+		want bool
+	}{
+		{RefCall, true},
+		{RefCallImplicit, true},
+		{Mirror(RefCall), true},
+		{RefCallDynamic, false},
+		{Mirror(RefCallDynamic), false},
+		{Ref, false},
+		{RefInit, false},
+	}
+	for _, test := range tests {
+		if got := IsCall(test.kind); got != test.want {
+			t.Errorf("IsCall(%q): got %v, want %v", test.kind, got, test.want)
+		}
+	}
+}
+
 func TestParamIndex(t *testing.T) {
 	tests := []string{"param.0", "param.1", "param.2", "param.3"}
 	for i, test := range tests {
@@ -23,6 +23,7 @@ const prefix = "/kythe/" // duplicated to avoid a circular import
 const (
 	AnchorEnd    = prefix + "loc/end"
 	AnchorStart  = prefix + "loc/start"
+	BuildConfig  = prefix + "build/config"
 	Code         = prefix + "code"
 	Complete     = prefix + "complete"
 	ContextURL   = prefix + "context/url"
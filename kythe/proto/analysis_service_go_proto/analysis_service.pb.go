@@ -6,7 +6,12 @@ package analysis_service_go_proto
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import _ "kythe.io/kythe/proto/analysis_go_proto"
+import analysis_go_proto "kythe.io/kythe/proto/analysis_go_proto"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -19,6 +24,196 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CompilationAnalyzerClient is the client API for CompilationAnalyzer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CompilationAnalyzerClient interface {
+	Analyze(ctx context.Context, in *analysis_go_proto.AnalysisRequest, opts ...grpc.CallOption) (CompilationAnalyzer_AnalyzeClient, error)
+}
+
+type compilationAnalyzerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCompilationAnalyzerClient(cc *grpc.ClientConn) CompilationAnalyzerClient {
+	return &compilationAnalyzerClient{cc}
+}
+
+func (c *compilationAnalyzerClient) Analyze(ctx context.Context, in *analysis_go_proto.AnalysisRequest, opts ...grpc.CallOption) (CompilationAnalyzer_AnalyzeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CompilationAnalyzer_serviceDesc.Streams[0], "/kythe.proto.CompilationAnalyzer/Analyze", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &compilationAnalyzerAnalyzeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CompilationAnalyzer_AnalyzeClient interface {
+	Recv() (*analysis_go_proto.AnalysisOutput, error)
+	grpc.ClientStream
+}
+
+type compilationAnalyzerAnalyzeClient struct {
+	grpc.ClientStream
+}
+
+func (x *compilationAnalyzerAnalyzeClient) Recv() (*analysis_go_proto.AnalysisOutput, error) {
+	m := new(analysis_go_proto.AnalysisOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CompilationAnalyzerServer is the server API for CompilationAnalyzer service.
+type CompilationAnalyzerServer interface {
+	Analyze(*analysis_go_proto.AnalysisRequest, CompilationAnalyzer_AnalyzeServer) error
+}
+
+func RegisterCompilationAnalyzerServer(s *grpc.Server, srv CompilationAnalyzerServer) {
+	s.RegisterService(&_CompilationAnalyzer_serviceDesc, srv)
+}
+
+func _CompilationAnalyzer_Analyze_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(analysis_go_proto.AnalysisRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CompilationAnalyzerServer).Analyze(m, &compilationAnalyzerAnalyzeServer{stream})
+}
+
+type CompilationAnalyzer_AnalyzeServer interface {
+	Send(*analysis_go_proto.AnalysisOutput) error
+	grpc.ServerStream
+}
+
+type compilationAnalyzerAnalyzeServer struct {
+	grpc.ServerStream
+}
+
+func (x *compilationAnalyzerAnalyzeServer) Send(m *analysis_go_proto.AnalysisOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _CompilationAnalyzer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kythe.proto.CompilationAnalyzer",
+	HandlerType: (*CompilationAnalyzerServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Analyze",
+			Handler:       _CompilationAnalyzer_Analyze_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kythe/proto/analysis_service.proto",
+}
+
+// FileDataServiceClient is the client API for FileDataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FileDataServiceClient interface {
+	Get(ctx context.Context, in *analysis_go_proto.FilesRequest, opts ...grpc.CallOption) (FileDataService_GetClient, error)
+}
+
+type fileDataServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFileDataServiceClient(cc *grpc.ClientConn) FileDataServiceClient {
+	return &fileDataServiceClient{cc}
+}
+
+func (c *fileDataServiceClient) Get(ctx context.Context, in *analysis_go_proto.FilesRequest, opts ...grpc.CallOption) (FileDataService_GetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FileDataService_serviceDesc.Streams[0], "/kythe.proto.FileDataService/Get", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileDataServiceGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FileDataService_GetClient interface {
+	Recv() (*analysis_go_proto.FileData, error)
+	grpc.ClientStream
+}
+
+type fileDataServiceGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileDataServiceGetClient) Recv() (*analysis_go_proto.FileData, error) {
+	m := new(analysis_go_proto.FileData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FileDataServiceServer is the server API for FileDataService service.
+type FileDataServiceServer interface {
+	Get(*analysis_go_proto.FilesRequest, FileDataService_GetServer) error
+}
+
+func RegisterFileDataServiceServer(s *grpc.Server, srv FileDataServiceServer) {
+	s.RegisterService(&_FileDataService_serviceDesc, srv)
+}
+
+func _FileDataService_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(analysis_go_proto.FilesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileDataServiceServer).Get(m, &fileDataServiceGetServer{stream})
+}
+
+type FileDataService_GetServer interface {
+	Send(*analysis_go_proto.FileData) error
+	grpc.ServerStream
+}
+
+type fileDataServiceGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileDataServiceGetServer) Send(m *analysis_go_proto.FileData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _FileDataService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kythe.proto.FileDataService",
+	HandlerType: (*FileDataServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Get",
+			Handler:       _FileDataService_Get_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kythe/proto/analysis_service.proto",
+}
+
 func init() {
 	proto.RegisterFile("kythe/proto/analysis_service.proto", fileDescriptor_analysis_service_f419a172737a00cd)
 }
@@ -36,7 +36,7 @@ func (m *NodeData) Reset()         { *m = NodeData{} }
 func (m *NodeData) String() string { return proto.CompactTextString(m) }
 func (*NodeData) ProtoMessage()    {}
 func (*NodeData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{0}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{0}
 }
 func (m *NodeData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeData.Unmarshal(m, b)
@@ -95,6 +95,7 @@ type GraphNode struct {
 	NodeData             *NodeData `protobuf:"bytes,1,opt,name=node_data,json=nodeData" json:"node_data,omitempty"`
 	Predecessors         []string  `protobuf:"bytes,2,rep,name=predecessors" json:"predecessors,omitempty"`
 	Successors           []string  `protobuf:"bytes,3,rep,name=successors" json:"successors,omitempty"`
+	Truncated            bool      `protobuf:"varint,4,opt,name=truncated" json:"truncated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -104,7 +105,7 @@ func (m *GraphNode) Reset()         { *m = GraphNode{} }
 func (m *GraphNode) String() string { return proto.CompactTextString(m) }
 func (*GraphNode) ProtoMessage()    {}
 func (*GraphNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{1}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{1}
 }
 func (m *GraphNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphNode.Unmarshal(m, b)
@@ -145,6 +146,13 @@ func (m *GraphNode) GetSuccessors() []string {
 	return nil
 }
 
+func (m *GraphNode) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
 type Graph struct {
 	Nodes                map[string]*GraphNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -156,7 +164,7 @@ func (m *Graph) Reset()         { *m = Graph{} }
 func (m *Graph) String() string { return proto.CompactTextString(m) }
 func (*Graph) ProtoMessage()    {}
 func (*Graph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{2}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{2}
 }
 func (m *Graph) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Graph.Unmarshal(m, b)
@@ -195,7 +203,7 @@ func (m *NodeFilter) Reset()         { *m = NodeFilter{} }
 func (m *NodeFilter) String() string { return proto.CompactTextString(m) }
 func (*NodeFilter) ProtoMessage()    {}
 func (*NodeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{3}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{3}
 }
 func (m *NodeFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeFilter.Unmarshal(m, b)
@@ -240,7 +248,7 @@ func (m *Tickets) Reset()         { *m = Tickets{} }
 func (m *Tickets) String() string { return proto.CompactTextString(m) }
 func (*Tickets) ProtoMessage()    {}
 func (*Tickets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{4}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{4}
 }
 func (m *Tickets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tickets.Unmarshal(m, b)
@@ -270,6 +278,8 @@ func (m *Tickets) GetTickets() []string {
 type TypeHierarchyRequest struct {
 	TypeTicket           string      `protobuf:"bytes,1,opt,name=type_ticket,json=typeTicket" json:"type_ticket,omitempty"`
 	NodeFilter           *NodeFilter `protobuf:"bytes,2,opt,name=node_filter,json=nodeFilter" json:"node_filter,omitempty"`
+	MaxDepth             int32       `protobuf:"varint,3,opt,name=max_depth,json=maxDepth" json:"max_depth,omitempty"`
+	MaxFanOut            int32       `protobuf:"varint,4,opt,name=max_fan_out,json=maxFanOut" json:"max_fan_out,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -279,7 +289,7 @@ func (m *TypeHierarchyRequest) Reset()         { *m = TypeHierarchyRequest{} }
 func (m *TypeHierarchyRequest) String() string { return proto.CompactTextString(m) }
 func (*TypeHierarchyRequest) ProtoMessage()    {}
 func (*TypeHierarchyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{5}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{5}
 }
 func (m *TypeHierarchyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TypeHierarchyRequest.Unmarshal(m, b)
@@ -313,6 +323,20 @@ func (m *TypeHierarchyRequest) GetNodeFilter() *NodeFilter {
 	return nil
 }
 
+func (m *TypeHierarchyRequest) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *TypeHierarchyRequest) GetMaxFanOut() int32 {
+	if m != nil {
+		return m.MaxFanOut
+	}
+	return 0
+}
+
 type TypeHierarchyReply struct {
 	TypeTicket           string   `protobuf:"bytes,1,opt,name=type_ticket,json=typeTicket" json:"type_ticket,omitempty"`
 	Graph                *Graph   `protobuf:"bytes,2,opt,name=graph" json:"graph,omitempty"`
@@ -325,7 +349,7 @@ func (m *TypeHierarchyReply) Reset()         { *m = TypeHierarchyReply{} }
 func (m *TypeHierarchyReply) String() string { return proto.CompactTextString(m) }
 func (*TypeHierarchyReply) ProtoMessage()    {}
 func (*TypeHierarchyReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{6}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{6}
 }
 func (m *TypeHierarchyReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TypeHierarchyReply.Unmarshal(m, b)
@@ -361,6 +385,8 @@ func (m *TypeHierarchyReply) GetGraph() *Graph {
 
 type CallersRequest struct {
 	Tickets              []string `protobuf:"bytes,1,rep,name=tickets" json:"tickets,omitempty"`
+	MaxDepth             int32    `protobuf:"varint,2,opt,name=max_depth,json=maxDepth" json:"max_depth,omitempty"`
+	MaxNodes             int32    `protobuf:"varint,3,opt,name=max_nodes,json=maxNodes" json:"max_nodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -370,7 +396,7 @@ func (m *CallersRequest) Reset()         { *m = CallersRequest{} }
 func (m *CallersRequest) String() string { return proto.CompactTextString(m) }
 func (*CallersRequest) ProtoMessage()    {}
 func (*CallersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{7}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{7}
 }
 func (m *CallersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallersRequest.Unmarshal(m, b)
@@ -397,6 +423,20 @@ func (m *CallersRequest) GetTickets() []string {
 	return nil
 }
 
+func (m *CallersRequest) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *CallersRequest) GetMaxNodes() int32 {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return 0
+}
+
 type CallersReply struct {
 	Graph                *Graph   `protobuf:"bytes,1,opt,name=graph" json:"graph,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -408,7 +448,7 @@ func (m *CallersReply) Reset()         { *m = CallersReply{} }
 func (m *CallersReply) String() string { return proto.CompactTextString(m) }
 func (*CallersReply) ProtoMessage()    {}
 func (*CallersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{8}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{8}
 }
 func (m *CallersReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallersReply.Unmarshal(m, b)
@@ -437,6 +477,8 @@ func (m *CallersReply) GetGraph() *Graph {
 
 type CalleesRequest struct {
 	Tickets              []string `protobuf:"bytes,1,rep,name=tickets" json:"tickets,omitempty"`
+	MaxDepth             int32    `protobuf:"varint,2,opt,name=max_depth,json=maxDepth" json:"max_depth,omitempty"`
+	MaxNodes             int32    `protobuf:"varint,3,opt,name=max_nodes,json=maxNodes" json:"max_nodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -446,7 +488,7 @@ func (m *CalleesRequest) Reset()         { *m = CalleesRequest{} }
 func (m *CalleesRequest) String() string { return proto.CompactTextString(m) }
 func (*CalleesRequest) ProtoMessage()    {}
 func (*CalleesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{9}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{9}
 }
 func (m *CalleesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CalleesRequest.Unmarshal(m, b)
@@ -473,6 +515,20 @@ func (m *CalleesRequest) GetTickets() []string {
 	return nil
 }
 
+func (m *CalleesRequest) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *CalleesRequest) GetMaxNodes() int32 {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return 0
+}
+
 type CalleesReply struct {
 	Graph                *Graph   `protobuf:"bytes,1,opt,name=graph" json:"graph,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -484,7 +540,7 @@ func (m *CalleesReply) Reset()         { *m = CalleesReply{} }
 func (m *CalleesReply) String() string { return proto.CompactTextString(m) }
 func (*CalleesReply) ProtoMessage()    {}
 func (*CalleesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{10}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{10}
 }
 func (m *CalleesReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CalleesReply.Unmarshal(m, b)
@@ -522,7 +578,7 @@ func (m *ParametersRequest) Reset()         { *m = ParametersRequest{} }
 func (m *ParametersRequest) String() string { return proto.CompactTextString(m) }
 func (*ParametersRequest) ProtoMessage()    {}
 func (*ParametersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{11}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{11}
 }
 func (m *ParametersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParametersRequest.Unmarshal(m, b)
@@ -562,7 +618,7 @@ func (m *ParametersReply) Reset()         { *m = ParametersReply{} }
 func (m *ParametersReply) String() string { return proto.CompactTextString(m) }
 func (*ParametersReply) ProtoMessage()    {}
 func (*ParametersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{12}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{12}
 }
 func (m *ParametersReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParametersReply.Unmarshal(m, b)
@@ -614,7 +670,7 @@ func (m *ParentsRequest) Reset()         { *m = ParentsRequest{} }
 func (m *ParentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParentsRequest) ProtoMessage()    {}
 func (*ParentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{13}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{13}
 }
 func (m *ParentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParentsRequest.Unmarshal(m, b)
@@ -652,7 +708,7 @@ func (m *ParentsReply) Reset()         { *m = ParentsReply{} }
 func (m *ParentsReply) String() string { return proto.CompactTextString(m) }
 func (*ParentsReply) ProtoMessage()    {}
 func (*ParentsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{14}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{14}
 }
 func (m *ParentsReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParentsReply.Unmarshal(m, b)
@@ -690,7 +746,7 @@ func (m *ChildrenRequest) Reset()         { *m = ChildrenRequest{} }
 func (m *ChildrenRequest) String() string { return proto.CompactTextString(m) }
 func (*ChildrenRequest) ProtoMessage()    {}
 func (*ChildrenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{15}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{15}
 }
 func (m *ChildrenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChildrenRequest.Unmarshal(m, b)
@@ -728,7 +784,7 @@ func (m *ChildrenReply) Reset()         { *m = ChildrenReply{} }
 func (m *ChildrenReply) String() string { return proto.CompactTextString(m) }
 func (*ChildrenReply) ProtoMessage()    {}
 func (*ChildrenReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_explore_67c2d15939767f38, []int{16}
+	return fileDescriptor_explore_c7a8e39ccf268c1a, []int{16}
 }
 func (m *ChildrenReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChildrenReply.Unmarshal(m, b)
@@ -781,68 +837,72 @@ func init() {
 	proto.RegisterMapType((map[string]*Tickets)(nil), "kythe.proto.ChildrenReply.InputToChildrenEntry")
 }
 
-func init() { proto.RegisterFile("kythe/proto/explore.proto", fileDescriptor_explore_67c2d15939767f38) }
-
-var fileDescriptor_explore_67c2d15939767f38 = []byte{
-	// 947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x35, 0x2d, 0xab, 0xb6, 0x46, 0xb6, 0x6c, 0x6f, 0x15, 0x97, 0x66, 0xd3, 0x58, 0x65, 0x2e,
-	0xaa, 0xdd, 0xc8, 0x80, 0x5c, 0xb4, 0x6e, 0x0f, 0x05, 0x5a, 0x37, 0x4e, 0x0a, 0xb8, 0x81, 0xc1,
-	0xa4, 0x49, 0x81, 0xa2, 0x10, 0x18, 0x72, 0x24, 0x11, 0xa2, 0xb8, 0xcc, 0x92, 0x34, 0xa2, 0x73,
-	0xef, 0xfd, 0x3b, 0xbd, 0xf6, 0xd4, 0x53, 0x0f, 0xfd, 0x49, 0xc1, 0x7e, 0x90, 0xe2, 0xca, 0x94,
-	0xe3, 0xc0, 0x37, 0x72, 0xe6, 0xcd, 0x7b, 0xfb, 0x66, 0x96, 0x23, 0xc1, 0xfe, 0x64, 0x96, 0x8e,
-	0xf1, 0x38, 0x66, 0x34, 0xa5, 0xc7, 0xf8, 0x36, 0x0e, 0x29, 0xc3, 0x9e, 0x78, 0x23, 0x4d, 0x91,
-	0x92, 0x2f, 0x96, 0x59, 0xc6, 0x79, 0x74, 0x3a, 0xa5, 0x91, 0xca, 0x68, 0x0c, 0x49, 0x4a, 0x99,
-	0x3b, 0xca, 0x8b, 0xf6, 0xca, 0xa9, 0xb7, 0x0c, 0x87, 0x32, 0x6e, 0xff, 0x6f, 0xc0, 0xc6, 0x33,
-	0xea, 0xe3, 0x4f, 0x6e, 0xea, 0x12, 0x02, 0x6b, 0x93, 0x20, 0xf2, 0x4d, 0xa3, 0x63, 0x74, 0x1b,
-	0x8e, 0x78, 0x26, 0x26, 0xac, 0x27, 0xd9, 0x6b, 0x11, 0x5e, 0x15, 0xe1, 0xfc, 0x95, 0x9c, 0x40,
-	0x23, 0xa4, 0x9e, 0x9b, 0x06, 0x34, 0x4a, 0xcc, 0x5a, 0xa7, 0xd6, 0x6d, 0xf6, 0xef, 0xf5, 0x4a,
-	0x07, 0xed, 0x5d, 0xa8, 0xac, 0x33, 0xc7, 0x91, 0x23, 0xd8, 0xf5, 0x71, 0x18, 0x44, 0x01, 0x7f,
-	0x1d, 0xb8, 0x91, 0x37, 0xa6, 0xcc, 0x5c, 0x13, 0xc4, 0x3b, 0xf3, 0xc4, 0x0f, 0x22, 0x4e, 0xbe,
-	0x82, 0x35, 0x8f, 0xfa, 0x68, 0xd6, 0x3b, 0x46, 0xb7, 0xd9, 0xef, 0x68, 0xe4, 0xca, 0xf8, 0x2f,
-	0x2e, 0x9b, 0xa0, 0xff, 0x9c, 0x66, 0xcc, 0x43, 0x47, 0xa0, 0xed, 0x3f, 0x0d, 0x68, 0x3c, 0x61,
-	0x6e, 0x3c, 0xe6, 0xbe, 0x48, 0x1f, 0x1a, 0x11, 0xf5, 0x71, 0xe0, 0xbb, 0xa9, 0x2b, 0x8c, 0x2d,
-	0x9e, 0x32, 0x77, 0xef, 0x6c, 0x44, 0x79, 0x1f, 0x6c, 0xd8, 0x8c, 0x19, 0xfa, 0xe8, 0x61, 0x92,
-	0x50, 0x96, 0x98, 0xab, 0x9d, 0x5a, 0xb7, 0xe1, 0x68, 0x31, 0xf2, 0x00, 0x20, 0xc9, 0xbc, 0x1c,
-	0x51, 0x13, 0x88, 0x52, 0xc4, 0xfe, 0xcb, 0x80, 0xba, 0x38, 0x05, 0x39, 0x81, 0x3a, 0x67, 0x4e,
-	0x4c, 0x43, 0xf4, 0xe8, 0x33, 0x4d, 0x5d, 0x40, 0xc4, 0x19, 0x92, 0xc7, 0x51, 0xca, 0x66, 0x8e,
-	0xc4, 0x5a, 0x97, 0x00, 0xf3, 0x20, 0xd9, 0x81, 0xda, 0x04, 0x67, 0x6a, 0x2e, 0xfc, 0x91, 0x7c,
-	0x09, 0xf5, 0x2b, 0x37, 0xcc, 0x50, 0x0c, 0xa5, 0xd9, 0xdf, 0xbb, 0x4e, 0xca, 0xcb, 0x1d, 0x09,
-	0xfa, 0x6e, 0xf5, 0xd4, 0xb0, 0xaf, 0x24, 0xe3, 0x79, 0x10, 0xa6, 0xc8, 0xc8, 0x23, 0x20, 0x41,
-	0xe4, 0x85, 0x99, 0x8f, 0xfe, 0x20, 0x74, 0xa3, 0x51, 0xe6, 0x8e, 0xd4, 0x09, 0x1b, 0xce, 0x6e,
-	0x9e, 0xb9, 0xc8, 0x13, 0xe4, 0x5b, 0x68, 0x15, 0xf0, 0x61, 0x10, 0xa2, 0xec, 0x49, 0xb3, 0x4f,
-	0x34, 0xdd, 0x97, 0xcf, 0xdc, 0x29, 0x3a, 0x5b, 0x39, 0xf2, 0x9c, 0x03, 0xed, 0x87, 0xb0, 0xfe,
-	0x22, 0xf0, 0x26, 0x98, 0x26, 0xfc, 0x2e, 0xa5, 0xf2, 0x51, 0x29, 0xe5, 0xaf, 0xf6, 0x1b, 0x68,
-	0xbf, 0x98, 0xc5, 0xf8, 0x34, 0x40, 0xe6, 0x32, 0x6f, 0x3c, 0x73, 0xf0, 0x4d, 0x86, 0x49, 0x4a,
-	0x0e, 0xa0, 0x99, 0xce, 0x62, 0x1c, 0x48, 0x9c, 0x6a, 0x00, 0xf0, 0x90, 0xe4, 0x24, 0xa7, 0xd0,
-	0x14, 0xe3, 0x1d, 0x0a, 0x5b, 0xaa, 0x1b, 0x9f, 0x5c, 0x1b, 0xb0, 0x74, 0xed, 0x40, 0x54, 0x3c,
-	0xdb, 0x03, 0x20, 0x0b, 0x92, 0x71, 0x38, 0x7b, 0xbf, 0x60, 0x17, 0xea, 0x23, 0xde, 0x5e, 0x25,
-	0x45, 0xae, 0x37, 0xde, 0x91, 0x00, 0xfb, 0x10, 0x5a, 0x67, 0x6e, 0x18, 0x22, 0x4b, 0x72, 0x37,
-	0xcb, 0xfd, 0x9f, 0xc2, 0x66, 0x81, 0xe5, 0xc7, 0x28, 0x54, 0x8c, 0xdb, 0xaa, 0xe0, 0x07, 0xa8,
-	0xe0, 0x07, 0xab, 0x7c, 0x0f, 0xbb, 0x97, 0x2e, 0x73, 0xa7, 0x98, 0x96, 0xec, 0x7c, 0x01, 0x3b,
-	0xc3, 0x2c, 0xf2, 0xc4, 0x97, 0xac, 0x2b, 0x6e, 0xe7, 0x71, 0x35, 0x79, 0xfb, 0xef, 0x35, 0xd8,
-	0x2e, 0x13, 0x70, 0xf5, 0x10, 0xf6, 0xe6, 0xe5, 0x74, 0x10, 0x17, 0x69, 0xf5, 0xa1, 0x7c, 0xad,
-	0x1d, 0x67, 0xa1, 0xba, 0x77, 0x9e, 0x2b, 0xd0, 0x79, 0x46, 0x7e, 0x41, 0xed, 0x61, 0x45, 0x8a,
-	0xc4, 0x60, 0x96, 0xd5, 0x18, 0xa6, 0x19, 0x8b, 0x06, 0xf9, 0x37, 0xc4, 0xf5, 0xbe, 0xb9, 0xa5,
-	0x9e, 0x23, 0x4a, 0x5f, 0xf2, 0x4a, 0x29, 0x78, 0x6f, 0x58, 0x95, 0x23, 0x4f, 0xca, 0x9b, 0x47,
-	0xee, 0xc7, 0xc3, 0x1b, 0x25, 0xf2, 0x4d, 0x24, 0x59, 0x8b, 0x75, 0x64, 0xfd, 0x01, 0xfb, 0x4b,
-	0xdd, 0x56, 0xac, 0x86, 0x43, 0x7d, 0x35, 0xb4, 0x35, 0x4d, 0x35, 0x90, 0xd2, 0x62, 0xb0, 0x9e,
-	0x82, 0xb5, 0xdc, 0x5c, 0x05, 0x7f, 0xbb, 0xcc, 0xdf, 0x28, 0x33, 0x39, 0xb0, 0xa5, 0x79, 0xa8,
-	0x28, 0x3e, 0xd2, 0x0f, 0xb7, 0x64, 0x15, 0x97, 0xd6, 0xd6, 0x21, 0xb4, 0x2e, 0x5d, 0x86, 0x51,
-	0x7a, 0x8b, 0xfb, 0xfd, 0x8f, 0x01, 0x9b, 0x05, 0x98, 0x5f, 0xb1, 0x57, 0xb0, 0x13, 0x44, 0x71,
-	0x96, 0xaa, 0xfb, 0xc5, 0x13, 0xea, 0x72, 0x3d, 0x5a, 0x9c, 0x44, 0x51, 0xd4, 0xfb, 0x99, 0x57,
-	0x88, 0x46, 0xf3, 0x98, 0x1c, 0x46, 0x2b, 0xd0, 0x82, 0xd6, 0x2b, 0xf8, 0xb8, 0x02, 0x76, 0xf7,
-	0x61, 0xd8, 0x47, 0xb0, 0x7d, 0x36, 0x0e, 0x42, 0x9f, 0x61, 0xf4, 0x7e, 0xbf, 0xff, 0x1a, 0xb0,
-	0x35, 0x47, 0x73, 0xc3, 0xbf, 0xc3, 0x6e, 0x61, 0xd8, 0x53, 0x19, 0xe5, 0xf8, 0x58, 0x93, 0xd6,
-	0xca, 0x72, 0xcb, 0x79, 0x50, 0x7a, 0xde, 0x0e, 0xf4, 0xa8, 0xf5, 0x1b, 0xb4, 0xab, 0x80, 0x77,
-	0x77, 0xdd, 0xff, 0xaf, 0x06, 0xad, 0xc7, 0xf2, 0x1f, 0xcf, 0x73, 0x64, 0x57, 0x81, 0x87, 0xe4,
-	0x0c, 0xd6, 0xd5, 0x46, 0x24, 0x9f, 0xea, 0x27, 0xd7, 0x76, 0xaa, 0xb5, 0x5f, 0x9d, 0x8c, 0xc3,
-	0x99, 0xbd, 0x52, 0x90, 0x60, 0x25, 0x09, 0xde, 0x44, 0x82, 0x65, 0x12, 0x35, 0xe4, 0x05, 0x12,
-	0xfd, 0x5e, 0x2e, 0x90, 0x94, 0xaf, 0x94, 0xbd, 0x42, 0xce, 0x61, 0x23, 0x6f, 0x1a, 0xb9, 0xbf,
-	0x64, 0x12, 0x92, 0xc6, 0x5a, 0x3e, 0x27, 0x7b, 0x85, 0xfc, 0x0a, 0x5b, 0xda, 0xaf, 0x16, 0xf9,
-	0x5c, 0xef, 0x6d, 0xc5, 0x8f, 0xa8, 0x75, 0x70, 0x13, 0x44, 0xd2, 0x5e, 0x00, 0x94, 0x76, 0xe5,
-	0x83, 0xa5, 0x6b, 0x4a, 0x12, 0xde, 0xbf, 0x69, 0x8d, 0xd9, 0x2b, 0x3f, 0x3e, 0x84, 0x03, 0x8f,
-	0x4e, 0x7b, 0x23, 0x4a, 0x47, 0x21, 0xf6, 0x7c, 0xbc, 0x4a, 0x29, 0x0d, 0x93, 0x72, 0xd1, 0xa5,
-	0xf1, 0xfa, 0x23, 0xf1, 0x70, 0xf2, 0x2e, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x37, 0x72, 0x26, 0xf7,
-	0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("kythe/proto/explore.proto", fileDescriptor_explore_c7a8e39ccf268c1a) }
+
+var fileDescriptor_explore_c7a8e39ccf268c1a = []byte{
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0xaf, 0x9b, 0x86, 0x36, 0x93, 0xfe, 0x5d, 0x7a, 0x25, 0xf5, 0x95, 0x36, 0xf8, 0x5e, 0x42,
+	0xcb, 0xa5, 0x52, 0x8a, 0xa0, 0xf0, 0x80, 0x04, 0xbd, 0xeb, 0x1d, 0x52, 0x39, 0x2a, 0x5f, 0xb9,
+	0x43, 0x42, 0x28, 0xda, 0xb3, 0x27, 0x89, 0x15, 0xc7, 0x6b, 0xd6, 0xeb, 0xaa, 0xf9, 0x12, 0x7c,
+	0x07, 0xbe, 0x00, 0xaf, 0xbc, 0xf2, 0xc4, 0x13, 0x0f, 0x7c, 0x24, 0xe4, 0xdd, 0x75, 0xe2, 0x4d,
+	0x9d, 0xf6, 0xd0, 0x49, 0xbc, 0xed, 0xce, 0xfc, 0xe6, 0x37, 0xf3, 0x9b, 0x59, 0x4f, 0x02, 0xbb,
+	0xc3, 0xb1, 0x18, 0xe0, 0x71, 0xcc, 0x99, 0x60, 0xc7, 0x78, 0x13, 0x87, 0x8c, 0x63, 0x5b, 0xde,
+	0x48, 0x5d, 0xba, 0xd4, 0xc5, 0x6e, 0x14, 0x71, 0x1e, 0x1b, 0x8d, 0x58, 0xa4, 0x3d, 0x06, 0x43,
+	0x22, 0x18, 0xa7, 0xfd, 0x3c, 0x68, 0xa7, 0xe8, 0xba, 0xe1, 0xd8, 0x53, 0x76, 0xe7, 0x1f, 0x0b,
+	0x56, 0x5e, 0x30, 0x1f, 0x9f, 0x50, 0x41, 0x09, 0x81, 0xa5, 0x61, 0x10, 0xf9, 0x0d, 0xab, 0x69,
+	0xb5, 0x6a, 0xae, 0x3c, 0x93, 0x06, 0x2c, 0x27, 0xe9, 0x1b, 0x69, 0x5e, 0x94, 0xe6, 0xfc, 0x4a,
+	0x4e, 0xa0, 0x16, 0x32, 0x8f, 0x8a, 0x80, 0x45, 0x49, 0xa3, 0xd2, 0xac, 0xb4, 0xea, 0x9d, 0x07,
+	0xed, 0x42, 0xa1, 0xed, 0x0b, 0xed, 0x75, 0xa7, 0x38, 0x72, 0x04, 0x5b, 0x3e, 0xf6, 0x82, 0x28,
+	0xc8, 0xae, 0x5d, 0x1a, 0x79, 0x03, 0xc6, 0x1b, 0x4b, 0x92, 0x78, 0x73, 0xea, 0xf8, 0x5a, 0xda,
+	0xc9, 0xa7, 0xb0, 0xe4, 0x31, 0x1f, 0x1b, 0xd5, 0xa6, 0xd5, 0xaa, 0x77, 0x9a, 0x06, 0xb9, 0x16,
+	0xfe, 0x1d, 0xe5, 0x43, 0xf4, 0x5f, 0xb2, 0x94, 0x7b, 0xe8, 0x4a, 0xb4, 0xf3, 0x9b, 0x05, 0xb5,
+	0x67, 0x9c, 0xc6, 0x83, 0x4c, 0x17, 0xe9, 0x40, 0x2d, 0x62, 0x3e, 0x76, 0x7d, 0x2a, 0xa8, 0x14,
+	0x36, 0x5b, 0x65, 0xae, 0xde, 0x5d, 0x89, 0xf4, 0x89, 0x38, 0xb0, 0x1a, 0x73, 0xf4, 0xd1, 0xc3,
+	0x24, 0x61, 0x3c, 0x69, 0x2c, 0x36, 0x2b, 0xad, 0x9a, 0x6b, 0xd8, 0xc8, 0x3e, 0x40, 0x92, 0x7a,
+	0x39, 0xa2, 0x22, 0x11, 0x05, 0x0b, 0xd9, 0x83, 0x9a, 0xe0, 0x69, 0xe4, 0x51, 0x81, 0xbe, 0x14,
+	0xb8, 0xe2, 0x4e, 0x0d, 0xce, 0xaf, 0x16, 0x54, 0x65, 0x8d, 0xe4, 0x04, 0xaa, 0x59, 0xde, 0xa4,
+	0x61, 0xc9, 0x0e, 0x7e, 0x68, 0xd4, 0x26, 0x21, 0xb2, 0xc2, 0xe4, 0x69, 0x24, 0xf8, 0xd8, 0x55,
+	0x58, 0xfb, 0x12, 0x60, 0x6a, 0x24, 0x9b, 0x50, 0x19, 0xe2, 0x58, 0x4f, 0x2d, 0x3b, 0x92, 0x4f,
+	0xa0, 0x7a, 0x4d, 0xc3, 0x14, 0xe5, 0xc8, 0xea, 0x9d, 0x9d, 0xdb, 0xa4, 0x59, 0xb8, 0xab, 0x40,
+	0x5f, 0x2e, 0x9e, 0x5a, 0xce, 0xb5, 0x62, 0x3c, 0x0f, 0x42, 0x81, 0x9c, 0x3c, 0x06, 0x12, 0x44,
+	0x5e, 0x98, 0xfa, 0xe8, 0x77, 0x43, 0x1a, 0xf5, 0x53, 0xda, 0xd7, 0x15, 0xd6, 0xdc, 0xad, 0xdc,
+	0x73, 0x91, 0x3b, 0xc8, 0x17, 0xb0, 0x3e, 0x81, 0xf7, 0x82, 0x10, 0x55, 0xc7, 0xea, 0x1d, 0x62,
+	0xe4, 0x7d, 0xf5, 0x82, 0x8e, 0xd0, 0x5d, 0xcb, 0x91, 0xe7, 0x19, 0xd0, 0x79, 0x04, 0xcb, 0x57,
+	0x81, 0x37, 0x44, 0x91, 0x64, 0x2f, 0x4d, 0xa8, 0xa3, 0xce, 0x94, 0x5f, 0x9d, 0xdf, 0x2d, 0xd8,
+	0xbe, 0x1a, 0xc7, 0xf8, 0x3c, 0x40, 0x4e, 0xb9, 0x37, 0x18, 0xbb, 0xf8, 0x4b, 0x8a, 0x89, 0x20,
+	0x07, 0x50, 0x17, 0xe3, 0x18, 0xbb, 0x0a, 0xa8, 0x3b, 0x00, 0x99, 0x49, 0x91, 0x92, 0x53, 0xa8,
+	0xcb, 0xe9, 0xf7, 0xa4, 0x2e, 0xdd, 0x8e, 0x0f, 0x6e, 0xcd, 0x5f, 0xc9, 0x76, 0x21, 0x9a, 0x9c,
+	0xc9, 0x43, 0xa8, 0x8d, 0xe8, 0x4d, 0xd7, 0xc7, 0x58, 0x0c, 0x1a, 0x95, 0xa6, 0xd5, 0xaa, 0xba,
+	0x2b, 0x23, 0x7a, 0xf3, 0x24, 0xbb, 0x93, 0x7d, 0xa8, 0x67, 0xce, 0x1e, 0x8d, 0xba, 0x2c, 0x15,
+	0x72, 0xbc, 0x55, 0x37, 0xc3, 0x9f, 0xd3, 0xe8, 0xfb, 0x54, 0x38, 0x5d, 0x20, 0x33, 0xf5, 0xc6,
+	0xe1, 0xf8, 0xfe, 0x6a, 0x5b, 0x50, 0xed, 0x67, 0xc3, 0xd1, 0x75, 0x92, 0xdb, 0x63, 0x73, 0x15,
+	0xc0, 0xf1, 0x61, 0xfd, 0x8c, 0x86, 0x21, 0xf2, 0x24, 0x6f, 0xc5, 0xdc, 0xee, 0x99, 0x4a, 0x16,
+	0x67, 0x94, 0x68, 0xa7, 0x7a, 0x82, 0x53, 0x99, 0xf2, 0x75, 0x39, 0xa7, 0xb0, 0x3a, 0xc9, 0x92,
+	0x09, 0x98, 0xd4, 0x67, 0xbd, 0x6d, 0x7d, 0xf8, 0xbf, 0xd4, 0x87, 0xff, 0xb9, 0xbe, 0xaf, 0x60,
+	0xeb, 0x92, 0x72, 0x3a, 0x42, 0x51, 0x68, 0xe1, 0xc7, 0xb0, 0xd9, 0x4b, 0x23, 0x4f, 0x6e, 0x26,
+	0xb3, 0xd6, 0x8d, 0xdc, 0xae, 0xdf, 0xaa, 0xf3, 0xc7, 0x12, 0x6c, 0x14, 0x09, 0xb2, 0xec, 0x21,
+	0xec, 0x4c, 0xc3, 0x59, 0x37, 0x9e, 0xb8, 0xf5, 0xa7, 0xfd, 0x99, 0x51, 0xce, 0x4c, 0x74, 0xfb,
+	0x3c, 0xcf, 0xc0, 0xa6, 0x1e, 0xf5, 0xcd, 0x6f, 0xf7, 0x4a, 0x5c, 0x24, 0x86, 0x46, 0x31, 0x1b,
+	0x47, 0x91, 0xf2, 0xa8, 0x9b, 0x7f, 0xf5, 0x59, 0xbe, 0xcf, 0xdf, 0x32, 0x9f, 0x2b, 0x43, 0x5f,
+	0x65, 0x91, 0x2a, 0xe1, 0x83, 0x5e, 0x99, 0x8f, 0x3c, 0x2b, 0x6e, 0x52, 0xb5, 0xef, 0x0f, 0xef,
+	0x4c, 0x91, 0x6f, 0x56, 0xc5, 0x3a, 0x59, 0xaf, 0xf6, 0xcf, 0xb0, 0x3b, 0x57, 0x6d, 0xc9, 0x32,
+	0x3b, 0x34, 0x97, 0xd9, 0xb6, 0x91, 0x53, 0x0f, 0xa4, 0xb0, 0xca, 0xec, 0xe7, 0x60, 0xcf, 0x17,
+	0x57, 0xc2, 0xbf, 0x5d, 0xe4, 0xaf, 0x15, 0x99, 0x5c, 0x58, 0x33, 0x34, 0x94, 0x04, 0x1f, 0x99,
+	0xc5, 0xcd, 0xf9, 0x69, 0x29, 0x2c, 0xda, 0x43, 0x58, 0xbf, 0xa4, 0x1c, 0x23, 0x71, 0xff, 0x97,
+	0xe1, 0xfc, 0x69, 0xc1, 0xea, 0x04, 0x9c, 0x3d, 0xb1, 0xd7, 0xb0, 0x19, 0x44, 0x71, 0x2a, 0xf4,
+	0xfb, 0xc2, 0x48, 0xc7, 0xd4, 0x3b, 0x8f, 0x67, 0x27, 0x31, 0x09, 0x6a, 0x7f, 0x9b, 0x45, 0x5c,
+	0x31, 0x6d, 0x53, 0xc3, 0x58, 0x0f, 0x0c, 0xa3, 0xfd, 0x1a, 0xde, 0x2f, 0x81, 0xbd, 0xfb, 0x30,
+	0x9c, 0x23, 0xd8, 0x38, 0x1b, 0x04, 0xa1, 0xcf, 0x31, 0xba, 0x5f, 0xef, 0x5f, 0x16, 0xac, 0x4d,
+	0xd1, 0x99, 0xe0, 0x9f, 0x60, 0x6b, 0x22, 0xd8, 0xd3, 0x1e, 0xad, 0xf8, 0xd8, 0x48, 0x6d, 0x84,
+	0xe5, 0x92, 0x73, 0xa3, 0xd2, 0xbc, 0x11, 0x98, 0x56, 0xfb, 0x47, 0xd8, 0x2e, 0x03, 0xbe, 0xbb,
+	0xea, 0xce, 0xdf, 0x15, 0x58, 0x7f, 0xaa, 0xfe, 0xc1, 0xbd, 0x44, 0x7e, 0x1d, 0x78, 0x48, 0xce,
+	0x60, 0x59, 0xef, 0x52, 0xf2, 0xd0, 0xac, 0xdc, 0xd8, 0xe3, 0xf6, 0x6e, 0xb9, 0x33, 0x0e, 0xc7,
+	0xce, 0xc2, 0x84, 0x04, 0x4b, 0x49, 0xf0, 0x2e, 0x12, 0x2c, 0x92, 0xe8, 0x21, 0xcf, 0x90, 0x98,
+	0xef, 0xd2, 0xde, 0x2d, 0x77, 0x2a, 0x92, 0x73, 0x58, 0xc9, 0x9b, 0x46, 0xf6, 0xe6, 0x4c, 0x42,
+	0xd1, 0xd8, 0xf3, 0xe7, 0xe4, 0x2c, 0x90, 0x1f, 0x60, 0xcd, 0xf8, 0xa5, 0x24, 0x1f, 0x99, 0xbd,
+	0x2d, 0xf9, 0xd5, 0xb7, 0x0f, 0xee, 0x82, 0x28, 0xda, 0x0b, 0x80, 0xc2, 0xae, 0xdc, 0x9f, 0xbb,
+	0xa6, 0x14, 0xe1, 0xde, 0x5d, 0x6b, 0xcc, 0x59, 0xf8, 0xe6, 0x11, 0x1c, 0x78, 0x6c, 0xd4, 0xee,
+	0x33, 0xd6, 0x0f, 0xb1, 0xed, 0xe3, 0xb5, 0x60, 0x2c, 0x4c, 0x8a, 0x41, 0x97, 0xd6, 0x9b, 0xf7,
+	0xe4, 0xe1, 0xe4, 0xdf, 0x01, 0x00, 0x86, 0x7b, 0x21, 0x9e, 0xc7, 0x0b, 0x00, 0x00,
 }
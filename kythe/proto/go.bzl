@@ -33,7 +33,7 @@ _go_proto_src = rule(
     },
 )
 
-def go_kythe_proto(proto = None, deps = [], importpath = None):
+def go_kythe_proto(proto = None, deps = [], importpath = None, has_services = False):
     """Helper for go_proto_library for kythe project.
 
     A shorthand for a go_proto_library with its import path set to the
@@ -56,6 +56,7 @@ def go_kythe_proto(proto = None, deps = [], importpath = None):
     Args:
       proto: the proto lib to build a _go_proto lib for
       deps: the deps for the proto lib
+      has_services: whether to generate gRPC code for the services of the proto
     """
     base = proto.rsplit(":", 2)[-1]
     filename = "_".join(base.split("_")[:-1]) + ".pb.go"
@@ -66,8 +67,12 @@ def go_kythe_proto(proto = None, deps = [], importpath = None):
 
     if not importpath:
         importpath = KYTHE_IMPORT_BASE + "/" + name
+    compilers = ["@io_bazel_rules_go//proto:go_proto"]
+    if has_services:
+        compilers = ["@io_bazel_rules_go//proto:go_grpc"]
     go_proto_library(
         name = name,
+        compilers = compilers,
         deps = deps,
         importpath = importpath,
         proto = proto,
@@ -6,6 +6,7 @@ package go_go_proto
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import storage_go_proto "kythe.io/kythe/proto/storage_go_proto"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -19,23 +20,25 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type GoDetails struct {
-	Goos                 string   `protobuf:"bytes,1,opt,name=goos" json:"goos,omitempty"`
-	Goarch               string   `protobuf:"bytes,2,opt,name=goarch" json:"goarch,omitempty"`
-	Goroot               string   `protobuf:"bytes,3,opt,name=goroot" json:"goroot,omitempty"`
-	Gopath               string   `protobuf:"bytes,4,opt,name=gopath" json:"gopath,omitempty"`
-	Compiler             string   `protobuf:"bytes,5,opt,name=compiler" json:"compiler,omitempty"`
-	BuildTags            []string `protobuf:"bytes,6,rep,name=build_tags,json=buildTags" json:"build_tags,omitempty"`
-	CgoEnabled           bool     `protobuf:"varint,7,opt,name=cgo_enabled,json=cgoEnabled" json:"cgo_enabled,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Goos                 string                     `protobuf:"bytes,1,opt,name=goos" json:"goos,omitempty"`
+	Goarch               string                     `protobuf:"bytes,2,opt,name=goarch" json:"goarch,omitempty"`
+	Goroot               string                     `protobuf:"bytes,3,opt,name=goroot" json:"goroot,omitempty"`
+	Gopath               string                     `protobuf:"bytes,4,opt,name=gopath" json:"gopath,omitempty"`
+	Compiler             string                     `protobuf:"bytes,5,opt,name=compiler" json:"compiler,omitempty"`
+	BuildTags            []string                   `protobuf:"bytes,6,rep,name=build_tags,json=buildTags" json:"build_tags,omitempty"`
+	CgoEnabled           bool                       `protobuf:"varint,7,opt,name=cgo_enabled,json=cgoEnabled" json:"cgo_enabled,omitempty"`
+	CgoTypes             string                     `protobuf:"bytes,8,opt,name=cgo_types,json=cgoTypes" json:"cgo_types,omitempty"`
+	Configuration        []*GoDetails_Configuration `protobuf:"bytes,9,rep,name=configuration" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *GoDetails) Reset()         { *m = GoDetails{} }
 func (m *GoDetails) String() string { return proto.CompactTextString(m) }
 func (*GoDetails) ProtoMessage()    {}
 func (*GoDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_go_b55a944b6eba7fa1, []int{0}
+	return fileDescriptor_go_645bdea3284349d1, []int{0}
 }
 func (m *GoDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GoDetails.Unmarshal(m, b)
@@ -104,26 +107,301 @@ func (m *GoDetails) GetCgoEnabled() bool {
 	return false
 }
 
+func (m *GoDetails) GetCgoTypes() string {
+	if m != nil {
+		return m.CgoTypes
+	}
+	return ""
+}
+
+func (m *GoDetails) GetConfiguration() []*GoDetails_Configuration {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+type GoDetails_Configuration struct {
+	Context              string   `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Goos                 string   `protobuf:"bytes,2,opt,name=goos" json:"goos,omitempty"`
+	Goarch               string   `protobuf:"bytes,3,opt,name=goarch" json:"goarch,omitempty"`
+	BuildTags            []string `protobuf:"bytes,4,rep,name=build_tags,json=buildTags" json:"build_tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GoDetails_Configuration) Reset()         { *m = GoDetails_Configuration{} }
+func (m *GoDetails_Configuration) String() string { return proto.CompactTextString(m) }
+func (*GoDetails_Configuration) ProtoMessage()    {}
+func (*GoDetails_Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_go_645bdea3284349d1, []int{0, 0}
+}
+func (m *GoDetails_Configuration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GoDetails_Configuration.Unmarshal(m, b)
+}
+func (m *GoDetails_Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GoDetails_Configuration.Marshal(b, m, deterministic)
+}
+func (dst *GoDetails_Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GoDetails_Configuration.Merge(dst, src)
+}
+func (m *GoDetails_Configuration) XXX_Size() int {
+	return xxx_messageInfo_GoDetails_Configuration.Size(m)
+}
+func (m *GoDetails_Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_GoDetails_Configuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GoDetails_Configuration proto.InternalMessageInfo
+
+func (m *GoDetails_Configuration) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *GoDetails_Configuration) GetGoos() string {
+	if m != nil {
+		return m.Goos
+	}
+	return ""
+}
+
+func (m *GoDetails_Configuration) GetGoarch() string {
+	if m != nil {
+		return m.Goarch
+	}
+	return ""
+}
+
+func (m *GoDetails_Configuration) GetBuildTags() []string {
+	if m != nil {
+		return m.BuildTags
+	}
+	return nil
+}
+
+type MethodSetSummary struct {
+	ImportPath           string                   `protobuf:"bytes,1,opt,name=import_path,json=importPath" json:"import_path,omitempty"`
+	Dependency           []string                 `protobuf:"bytes,2,rep,name=dependency" json:"dependency,omitempty"`
+	Type                 []*MethodSetSummary_Type `protobuf:"bytes,3,rep,name=type" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *MethodSetSummary) Reset()         { *m = MethodSetSummary{} }
+func (m *MethodSetSummary) String() string { return proto.CompactTextString(m) }
+func (*MethodSetSummary) ProtoMessage()    {}
+func (*MethodSetSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_go_645bdea3284349d1, []int{1}
+}
+func (m *MethodSetSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MethodSetSummary.Unmarshal(m, b)
+}
+func (m *MethodSetSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MethodSetSummary.Marshal(b, m, deterministic)
+}
+func (dst *MethodSetSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodSetSummary.Merge(dst, src)
+}
+func (m *MethodSetSummary) XXX_Size() int {
+	return xxx_messageInfo_MethodSetSummary.Size(m)
+}
+func (m *MethodSetSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodSetSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodSetSummary proto.InternalMessageInfo
+
+func (m *MethodSetSummary) GetImportPath() string {
+	if m != nil {
+		return m.ImportPath
+	}
+	return ""
+}
+
+func (m *MethodSetSummary) GetDependency() []string {
+	if m != nil {
+		return m.Dependency
+	}
+	return nil
+}
+
+func (m *MethodSetSummary) GetType() []*MethodSetSummary_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+type MethodSetSummary_Method struct {
+	Name                 string                  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Package              string                  `protobuf:"bytes,2,opt,name=package" json:"package,omitempty"`
+	Type                 string                  `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	Vname                *storage_go_proto.VName `protobuf:"bytes,4,opt,name=vname" json:"vname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *MethodSetSummary_Method) Reset()         { *m = MethodSetSummary_Method{} }
+func (m *MethodSetSummary_Method) String() string { return proto.CompactTextString(m) }
+func (*MethodSetSummary_Method) ProtoMessage()    {}
+func (*MethodSetSummary_Method) Descriptor() ([]byte, []int) {
+	return fileDescriptor_go_645bdea3284349d1, []int{1, 0}
+}
+func (m *MethodSetSummary_Method) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MethodSetSummary_Method.Unmarshal(m, b)
+}
+func (m *MethodSetSummary_Method) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MethodSetSummary_Method.Marshal(b, m, deterministic)
+}
+func (dst *MethodSetSummary_Method) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodSetSummary_Method.Merge(dst, src)
+}
+func (m *MethodSetSummary_Method) XXX_Size() int {
+	return xxx_messageInfo_MethodSetSummary_Method.Size(m)
+}
+func (m *MethodSetSummary_Method) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodSetSummary_Method.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodSetSummary_Method proto.InternalMessageInfo
+
+func (m *MethodSetSummary_Method) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MethodSetSummary_Method) GetPackage() string {
+	if m != nil {
+		return m.Package
+	}
+	return ""
+}
+
+func (m *MethodSetSummary_Method) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MethodSetSummary_Method) GetVname() *storage_go_proto.VName {
+	if m != nil {
+		return m.Vname
+	}
+	return nil
+}
+
+type MethodSetSummary_Type struct {
+	Vname                *storage_go_proto.VName    `protobuf:"bytes,1,opt,name=vname" json:"vname,omitempty"`
+	Interface            bool                       `protobuf:"varint,2,opt,name=interface" json:"interface,omitempty"`
+	Method               []*MethodSetSummary_Method `protobuf:"bytes,3,rep,name=method" json:"method,omitempty"`
+	PointerMethod        []*MethodSetSummary_Method `protobuf:"bytes,4,rep,name=pointer_method,json=pointerMethod" json:"pointer_method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *MethodSetSummary_Type) Reset()         { *m = MethodSetSummary_Type{} }
+func (m *MethodSetSummary_Type) String() string { return proto.CompactTextString(m) }
+func (*MethodSetSummary_Type) ProtoMessage()    {}
+func (*MethodSetSummary_Type) Descriptor() ([]byte, []int) {
+	return fileDescriptor_go_645bdea3284349d1, []int{1, 1}
+}
+func (m *MethodSetSummary_Type) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MethodSetSummary_Type.Unmarshal(m, b)
+}
+func (m *MethodSetSummary_Type) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MethodSetSummary_Type.Marshal(b, m, deterministic)
+}
+func (dst *MethodSetSummary_Type) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodSetSummary_Type.Merge(dst, src)
+}
+func (m *MethodSetSummary_Type) XXX_Size() int {
+	return xxx_messageInfo_MethodSetSummary_Type.Size(m)
+}
+func (m *MethodSetSummary_Type) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodSetSummary_Type.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodSetSummary_Type proto.InternalMessageInfo
+
+func (m *MethodSetSummary_Type) GetVname() *storage_go_proto.VName {
+	if m != nil {
+		return m.Vname
+	}
+	return nil
+}
+
+func (m *MethodSetSummary_Type) GetInterface() bool {
+	if m != nil {
+		return m.Interface
+	}
+	return false
+}
+
+func (m *MethodSetSummary_Type) GetMethod() []*MethodSetSummary_Method {
+	if m != nil {
+		return m.Method
+	}
+	return nil
+}
+
+func (m *MethodSetSummary_Type) GetPointerMethod() []*MethodSetSummary_Method {
+	if m != nil {
+		return m.PointerMethod
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GoDetails)(nil), "kythe.proto.GoDetails")
+	proto.RegisterType((*GoDetails_Configuration)(nil), "kythe.proto.GoDetails.Configuration")
+	proto.RegisterType((*MethodSetSummary)(nil), "kythe.proto.MethodSetSummary")
+	proto.RegisterType((*MethodSetSummary_Method)(nil), "kythe.proto.MethodSetSummary.Method")
+	proto.RegisterType((*MethodSetSummary_Type)(nil), "kythe.proto.MethodSetSummary.Type")
 }
 
-func init() { proto.RegisterFile("kythe/proto/go.proto", fileDescriptor_go_b55a944b6eba7fa1) }
-
-var fileDescriptor_go_b55a944b6eba7fa1 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0xc6, 0x71, 0xea, 0x66, 0x5d, 0xde, 0xdd, 0x82, 0x48, 0x10, 0x64, 0xd5, 0x53, 0x4f, 0xd9,
-	0xc1, 0x6f, 0x20, 0x8a, 0xf7, 0xe2, 0x7d, 0xa4, 0x69, 0x78, 0x5b, 0xcc, 0x7c, 0x4a, 0x12, 0x05,
-	0x3f, 0x9f, 0x5f, 0x4c, 0x96, 0x58, 0xd9, 0x29, 0xcf, 0xff, 0x97, 0xd3, 0x4b, 0xd7, 0xef, 0xdf,
-	0x69, 0x74, 0xfb, 0x39, 0x20, 0x61, 0xcf, 0xd0, 0x79, 0xc8, 0x6d, 0xd6, 0x12, 0x0f, 0x3f, 0x15,
-	0x89, 0x57, 0x3c, 0xbb, 0x64, 0x26, 0x1f, 0xa5, 0xa4, 0x35, 0x03, 0x51, 0x55, 0x4d, 0xd5, 0x8a,
-	0x2e, 0x6f, 0x79, 0x43, 0x35, 0xc3, 0x04, 0x3b, 0xaa, 0x8b, 0xac, 0x7f, 0x55, 0x3c, 0x00, 0x49,
-	0xad, 0x16, 0x3f, 0x55, 0xf1, 0xd9, 0xa4, 0x51, 0xad, 0x17, 0x3f, 0x95, 0xbc, 0xa5, 0x8d, 0xc5,
-	0x71, 0x9e, 0xbc, 0x0b, 0xea, 0x32, 0xff, 0xfc, 0xb7, 0xbc, 0x23, 0xea, 0x3f, 0x27, 0x3f, 0x1c,
-	0x92, 0xe1, 0xa8, 0xea, 0x66, 0xd5, 0x8a, 0x4e, 0x64, 0x79, 0x33, 0x1c, 0xe5, 0x8e, 0xb6, 0x96,
-	0x71, 0x70, 0x1f, 0xa6, 0xf7, 0x6e, 0x50, 0x57, 0x4d, 0xd5, 0x6e, 0x3a, 0xb2, 0x8c, 0x97, 0x22,
-	0x4f, 0xf7, 0xb4, 0xb3, 0x38, 0x6a, 0x06, 0xd8, 0x3b, 0x3d, 0xb8, 0xaf, 0x04, 0xf8, 0xa8, 0xcf,
-	0x0e, 0xed, 0xeb, 0xfc, 0x3c, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x91, 0xa7, 0x5a, 0x78, 0x14,
-	0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("kythe/proto/go.proto", fileDescriptor_go_645bdea3284349d1) }
+
+var fileDescriptor_go_645bdea3284349d1 = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x69, 0x93, 0xcd, 0x36, 0xa7, 0x54, 0x64, 0x10, 0x19, 0xe3, 0x9f, 0xad, 0xc5, 0x8b,
+	0x5c, 0x65, 0x61, 0x05, 0xaf, 0xbc, 0xf2, 0x0f, 0x82, 0xa2, 0x48, 0xb6, 0x78, 0x5b, 0xa6, 0x93,
+	0xd9, 0x69, 0xd8, 0x24, 0x27, 0x24, 0xa7, 0x8b, 0x7d, 0x1d, 0xdf, 0xca, 0x0b, 0xdf, 0x45, 0x66,
+	0x26, 0xa9, 0xc9, 0xba, 0x20, 0x5e, 0x65, 0xbe, 0xef, 0x9c, 0xf3, 0x4d, 0xce, 0x8f, 0x81, 0x07,
+	0xd7, 0x07, 0xda, 0xa9, 0xf3, 0xba, 0x41, 0xc2, 0x73, 0x8d, 0x89, 0x3d, 0xb0, 0xb9, 0x75, 0x9d,
+	0x88, 0x1e, 0x0d, 0x5b, 0x5a, 0xc2, 0x46, 0xe8, 0xae, 0xb4, 0xfa, 0xe1, 0x41, 0xf8, 0x01, 0xdf,
+	0x29, 0x12, 0x79, 0xd1, 0x32, 0x06, 0xbe, 0x46, 0x6c, 0xf9, 0x64, 0x39, 0x89, 0xc3, 0xd4, 0x9e,
+	0xd9, 0x43, 0x08, 0x34, 0x8a, 0x46, 0xee, 0xf8, 0xd4, 0xba, 0x9d, 0x72, 0x7e, 0x83, 0x48, 0xdc,
+	0xeb, 0x7d, 0xa3, 0x9c, 0x5f, 0x0b, 0xda, 0x71, 0xbf, 0xf7, 0x8d, 0x62, 0x11, 0xcc, 0x24, 0x96,
+	0x75, 0x5e, 0xa8, 0x86, 0x9f, 0xd8, 0xca, 0x51, 0xb3, 0xa7, 0x00, 0xdb, 0x7d, 0x5e, 0x64, 0x1b,
+	0x12, 0xba, 0xe5, 0xc1, 0xd2, 0x8b, 0xc3, 0x34, 0xb4, 0xce, 0x5a, 0xe8, 0x96, 0x9d, 0xc1, 0x5c,
+	0x6a, 0xdc, 0xa8, 0x4a, 0x6c, 0x0b, 0x95, 0xf1, 0xd3, 0xe5, 0x24, 0x9e, 0xa5, 0x20, 0x35, 0xbe,
+	0x77, 0x0e, 0x7b, 0x0c, 0xa1, 0x69, 0xa0, 0x43, 0xad, 0x5a, 0x3e, 0xeb, 0xc2, 0x35, 0xae, 0x8d,
+	0x66, 0x1f, 0x61, 0x21, 0xb1, 0xba, 0xca, 0xf5, 0xbe, 0x11, 0x94, 0x63, 0xc5, 0xc3, 0xa5, 0x17,
+	0xcf, 0x2f, 0x5e, 0x24, 0x03, 0x44, 0xc9, 0x91, 0x41, 0xf2, 0x76, 0xd8, 0x9b, 0x8e, 0x47, 0x23,
+	0x82, 0xc5, 0xa8, 0xce, 0x38, 0x9c, 0x4a, 0xac, 0x48, 0x7d, 0xa7, 0x0e, 0x5a, 0x2f, 0x8f, 0x2c,
+	0xa7, 0x77, 0xb2, 0xf4, 0x46, 0x2c, 0xc7, 0xfb, 0xfb, 0xb7, 0xf6, 0x5f, 0xfd, 0xf2, 0xe0, 0xfe,
+	0x67, 0x45, 0x3b, 0xcc, 0x2e, 0x15, 0x5d, 0xee, 0xcb, 0x52, 0x34, 0x07, 0x03, 0x25, 0x2f, 0x6b,
+	0x6c, 0x68, 0x63, 0x61, 0xbb, 0xdb, 0xc1, 0x59, 0x5f, 0x0d, 0xf0, 0x67, 0x00, 0x99, 0xaa, 0x55,
+	0x95, 0xa9, 0x4a, 0x1e, 0xf8, 0xd4, 0x86, 0x0e, 0x1c, 0xf6, 0x0a, 0x7c, 0x03, 0x8c, 0x7b, 0x16,
+	0xc7, 0x6a, 0x84, 0xe3, 0xf6, 0x6d, 0x89, 0x41, 0x99, 0xda, 0xfe, 0x88, 0x20, 0x70, 0x65, 0xb3,
+	0x62, 0x25, 0x4a, 0xd5, 0x3f, 0x17, 0x73, 0x36, 0x40, 0x6a, 0x21, 0xaf, 0x85, 0x56, 0xdd, 0xe6,
+	0xbd, 0x34, 0xdd, 0xdd, 0x7d, 0xb6, 0xdb, 0x9c, 0x59, 0x0c, 0x27, 0x37, 0x36, 0xc2, 0xbc, 0x95,
+	0xf9, 0x05, 0x1b, 0xfd, 0xc4, 0xb7, 0x2f, 0xa2, 0x54, 0xa9, 0x6b, 0x88, 0x7e, 0x4e, 0xc0, 0x5f,
+	0x8f, 0x46, 0x26, 0xff, 0x18, 0x61, 0x4f, 0x20, 0xcc, 0x2b, 0x52, 0xcd, 0x95, 0x90, 0xee, 0x67,
+	0x66, 0xe9, 0x1f, 0x83, 0xbd, 0x86, 0xa0, 0xb4, 0x6b, 0x70, 0xef, 0x8e, 0xf7, 0xf0, 0x17, 0x00,
+	0x67, 0xa4, 0xdd, 0x0c, 0xfb, 0x04, 0xf7, 0x6a, 0xb4, 0x61, 0x9b, 0x2e, 0xc5, 0xff, 0x8f, 0x94,
+	0x45, 0x37, 0xeb, 0xe4, 0x9b, 0xe7, 0x70, 0x26, 0xb1, 0x4c, 0x34, 0xa2, 0x2e, 0x54, 0x92, 0xa9,
+	0x1b, 0x42, 0x2c, 0xda, 0x61, 0xd2, 0x36, 0xb0, 0x9f, 0x97, 0xbf, 0x07, 0x00, 0x41, 0x48, 0x08,
+	0x11, 0xee, 0x03, 0x00, 0x00,
 }
@@ -22,6 +22,10 @@ type FindRequest struct {
 	Identifier           string   `protobuf:"bytes,1,opt,name=identifier" json:"identifier,omitempty"`
 	Corpus               []string `protobuf:"bytes,2,rep,name=corpus" json:"corpus,omitempty"`
 	Languages            []string `protobuf:"bytes,3,rep,name=languages" json:"languages,omitempty"`
+	NodeKind             []string `protobuf:"bytes,4,rep,name=node_kind,json=nodeKind" json:"node_kind,omitempty"`
+	Search               bool     `protobuf:"varint,5,opt,name=search" json:"search,omitempty"`
+	PageSize             int32    `protobuf:"varint,6,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,7,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -31,7 +35,7 @@ func (m *FindRequest) Reset()         { *m = FindRequest{} }
 func (m *FindRequest) String() string { return proto.CompactTextString(m) }
 func (*FindRequest) ProtoMessage()    {}
 func (*FindRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identifier_00b2b7435e9cc3ea, []int{0}
+	return fileDescriptor_identifier_c6534e7124ab409b, []int{0}
 }
 func (m *FindRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindRequest.Unmarshal(m, b)
@@ -72,8 +76,37 @@ func (m *FindRequest) GetLanguages() []string {
 	return nil
 }
 
+func (m *FindRequest) GetNodeKind() []string {
+	if m != nil {
+		return m.NodeKind
+	}
+	return nil
+}
+
+func (m *FindRequest) GetSearch() bool {
+	if m != nil {
+		return m.Search
+	}
+	return false
+}
+
+func (m *FindRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *FindRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type FindReply struct {
 	Matches              []*FindReply_Match `protobuf:"bytes,1,rep,name=matches" json:"matches,omitempty"`
+	NextPageToken        string             `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -83,7 +116,7 @@ func (m *FindReply) Reset()         { *m = FindReply{} }
 func (m *FindReply) String() string { return proto.CompactTextString(m) }
 func (*FindReply) ProtoMessage()    {}
 func (*FindReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identifier_00b2b7435e9cc3ea, []int{1}
+	return fileDescriptor_identifier_c6534e7124ab409b, []int{1}
 }
 func (m *FindReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindReply.Unmarshal(m, b)
@@ -110,6 +143,13 @@ func (m *FindReply) GetMatches() []*FindReply_Match {
 	return nil
 }
 
+func (m *FindReply) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type FindReply_Match struct {
 	Ticket               string   `protobuf:"bytes,1,opt,name=ticket" json:"ticket,omitempty"`
 	NodeKind             string   `protobuf:"bytes,2,opt,name=node_kind,json=nodeKind" json:"node_kind,omitempty"`
@@ -125,7 +165,7 @@ func (m *FindReply_Match) Reset()         { *m = FindReply_Match{} }
 func (m *FindReply_Match) String() string { return proto.CompactTextString(m) }
 func (*FindReply_Match) ProtoMessage()    {}
 func (*FindReply_Match) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identifier_00b2b7435e9cc3ea, []int{1, 0}
+	return fileDescriptor_identifier_c6534e7124ab409b, []int{1, 0}
 }
 func (m *FindReply_Match) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindReply_Match.Unmarshal(m, b)
@@ -187,29 +227,34 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("kythe/proto/identifier.proto", fileDescriptor_identifier_00b2b7435e9cc3ea)
-}
-
-var fileDescriptor_identifier_00b2b7435e9cc3ea = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xd1, 0x6a, 0xf2, 0x30,
-	0x14, 0xc7, 0xa9, 0x55, 0xbf, 0xaf, 0xa7, 0xdb, 0x60, 0xb9, 0x90, 0xe0, 0x64, 0x53, 0x61, 0xe0,
-	0x55, 0x05, 0x07, 0x63, 0xd7, 0xbb, 0x18, 0x8c, 0xe1, 0x2e, 0xea, 0x03, 0x48, 0x4c, 0xce, 0x6a,
-	0xb0, 0x4d, 0xb4, 0x49, 0x05, 0x9f, 0x67, 0x4f, 0xb7, 0xb7, 0x18, 0x49, 0x9d, 0xf6, 0xc2, 0xab,
-	0x72, 0x7e, 0xff, 0x5f, 0xe8, 0x39, 0x7f, 0x18, 0x6c, 0x0e, 0x76, 0x8d, 0xd3, 0x6d, 0xa9, 0xad,
-	0x9e, 0x4a, 0x81, 0xca, 0xca, 0x2f, 0x89, 0x65, 0xe2, 0x01, 0x89, 0x7d, 0x5a, 0x0f, 0x63, 0x0e,
-	0xf1, 0x9b, 0x54, 0x22, 0xc5, 0x5d, 0x85, 0xc6, 0x92, 0x7b, 0x80, 0xb3, 0x4f, 0x83, 0x61, 0x30,
-	0x89, 0xd2, 0x06, 0x21, 0x3d, 0xe8, 0x72, 0x5d, 0x6e, 0x2b, 0x43, 0x5b, 0xc3, 0x70, 0x12, 0xa5,
-	0xc7, 0x89, 0x0c, 0x20, 0xca, 0x99, 0xca, 0x2a, 0x96, 0xa1, 0xa1, 0xa1, 0x8f, 0xce, 0x60, 0xfc,
-	0x13, 0x40, 0x54, 0xff, 0x65, 0x9b, 0x1f, 0xc8, 0x33, 0xfc, 0x2b, 0x98, 0xe5, 0x6b, 0x34, 0x34,
-	0x18, 0x86, 0x93, 0x78, 0x36, 0x48, 0x1a, 0x1b, 0x25, 0x27, 0x31, 0x99, 0x3b, 0x2b, 0xfd, 0x93,
-	0xfb, 0xdf, 0x01, 0x74, 0x3c, 0x72, 0x5b, 0x58, 0xc9, 0x37, 0x68, 0x8f, 0x1b, 0x1e, 0x27, 0x72,
-	0x07, 0x91, 0xd2, 0x02, 0x97, 0x1b, 0xa9, 0x04, 0x6d, 0xf9, 0xe8, 0xbf, 0x03, 0x1f, 0x52, 0x09,
-	0x32, 0x82, 0x2b, 0x1f, 0x9a, 0x6a, 0xe5, 0xf3, 0xd0, 0xe7, 0xb1, 0x63, 0x8b, 0x1a, 0xb9, 0xf7,
-	0x2b, 0x66, 0x70, 0xa9, 0x58, 0x81, 0xb4, 0x5d, 0xbf, 0x77, 0xe0, 0x93, 0x15, 0x48, 0x1e, 0xe1,
-	0x66, 0x57, 0xb1, 0xdc, 0xf5, 0x20, 0x6a, 0xa3, 0xe3, 0x8d, 0xeb, 0x13, 0x75, 0xda, 0x6c, 0x0e,
-	0xb7, 0xef, 0xa7, 0xbe, 0x16, 0x58, 0xee, 0x25, 0x47, 0xf2, 0x02, 0x6d, 0x77, 0x16, 0xa1, 0x17,
-	0x2e, 0xf5, 0xc5, 0xf7, 0x7b, 0x97, 0x3b, 0x78, 0x1d, 0xc1, 0x03, 0xd7, 0x45, 0x92, 0x69, 0x9d,
-	0xe5, 0x98, 0x08, 0xdc, 0x5b, 0xad, 0x73, 0xd3, 0x94, 0x57, 0x5d, 0xff, 0x79, 0xfa, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x5b, 0x5e, 0x58, 0x2b, 0xf6, 0x01, 0x00, 0x00,
+	proto.RegisterFile("kythe/proto/identifier.proto", fileDescriptor_identifier_c6534e7124ab409b)
+}
+
+var fileDescriptor_identifier_c6534e7124ab409b = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x49, 0xd3, 0xf4, 0x36, 0x27, 0xfe, 0xc1, 0x59, 0x5c, 0x86, 0x6b, 0xd5, 0xdc, 0x0b,
+	0x4a, 0x56, 0xb9, 0x50, 0x41, 0x5c, 0xbb, 0x10, 0x44, 0x2a, 0x92, 0xba, 0x0f, 0xd3, 0xe4, 0x98,
+	0x0e, 0x49, 0x66, 0xd2, 0xcc, 0xa4, 0xd8, 0xbe, 0x85, 0xcf, 0xe0, 0x2b, 0xf9, 0x40, 0x32, 0x93,
+	0xb4, 0x8d, 0xd0, 0x55, 0x38, 0xbf, 0xef, 0x3b, 0x73, 0xe6, 0x7c, 0x13, 0x58, 0x94, 0x07, 0xbd,
+	0xc5, 0xc7, 0xa6, 0x95, 0x5a, 0x3e, 0xf2, 0x1c, 0x85, 0xe6, 0x3f, 0x39, 0xb6, 0xb1, 0x05, 0x24,
+	0xb0, 0x6a, 0x5f, 0x3c, 0xfc, 0x75, 0x20, 0xf8, 0xcc, 0x45, 0x9e, 0xe0, 0xae, 0x43, 0xa5, 0xc9,
+	0x6b, 0x80, 0x4b, 0x03, 0x75, 0x42, 0x27, 0xf2, 0x93, 0x11, 0x21, 0xb7, 0x30, 0xcb, 0x64, 0xdb,
+	0x74, 0x8a, 0x4e, 0x42, 0x37, 0xf2, 0x93, 0xa1, 0x22, 0x0b, 0xf0, 0x2b, 0x26, 0x8a, 0x8e, 0x15,
+	0xa8, 0xa8, 0x6b, 0xa5, 0x0b, 0x20, 0x2f, 0xc1, 0x17, 0x32, 0xc7, 0xb4, 0xe4, 0x22, 0xa7, 0x53,
+	0xab, 0xce, 0x0d, 0xf8, 0xca, 0x45, 0x6e, 0x8e, 0x54, 0xc8, 0xda, 0x6c, 0x4b, 0xbd, 0xd0, 0x89,
+	0xe6, 0xc9, 0x50, 0x99, 0xa6, 0x86, 0x15, 0x98, 0x2a, 0x7e, 0x44, 0x3a, 0x0b, 0x9d, 0xc8, 0x4b,
+	0xe6, 0x06, 0xac, 0xf9, 0x11, 0xc9, 0x2b, 0x00, 0x2b, 0x6a, 0x59, 0xa2, 0xa0, 0x37, 0xf6, 0x9e,
+	0xd6, 0xfe, 0xc3, 0x80, 0x87, 0xdf, 0x13, 0xf0, 0xfb, 0xb5, 0x9a, 0xea, 0x40, 0x3e, 0xc0, 0x4d,
+	0xcd, 0x74, 0xb6, 0x45, 0x45, 0x9d, 0xd0, 0x8d, 0x82, 0xe5, 0x22, 0x1e, 0x65, 0x10, 0x9f, 0x8d,
+	0xf1, 0xca, 0xb8, 0x92, 0x93, 0x99, 0xbc, 0x83, 0xe7, 0x02, 0x7f, 0xe9, 0x74, 0x34, 0x69, 0x62,
+	0x27, 0x3d, 0x35, 0xf8, 0xfb, 0x69, 0xda, 0xdd, 0x1f, 0x07, 0x3c, 0xdb, 0x6a, 0x76, 0xd1, 0x3c,
+	0x2b, 0x51, 0x0f, 0xd1, 0x0d, 0xd5, 0xff, 0x01, 0xf4, 0x67, 0x5c, 0x02, 0xb8, 0x87, 0x27, 0x56,
+	0x54, 0xdd, 0xc6, 0xea, 0xae, 0xd5, 0x03, 0xc3, 0xd6, 0x3d, 0x32, 0xfd, 0x1b, 0xa6, 0x30, 0x15,
+	0xac, 0x46, 0x3a, 0xed, 0xfb, 0x0d, 0xf8, 0xc6, 0x6a, 0x24, 0x6f, 0xe1, 0xd9, 0xae, 0x63, 0x95,
+	0x79, 0xa0, 0xbc, 0x77, 0x78, 0xfd, 0x2d, 0xcf, 0xd4, 0xd8, 0x96, 0x2b, 0x78, 0xf1, 0xe5, 0xfc,
+	0x90, 0x6b, 0x6c, 0xf7, 0x3c, 0x43, 0xf2, 0x11, 0xa6, 0x66, 0x7d, 0x42, 0xaf, 0x24, 0x62, 0xff,
+	0x88, 0xbb, 0xdb, 0xeb, 0x59, 0x7d, 0xba, 0x87, 0x37, 0x99, 0xac, 0xe3, 0x42, 0xca, 0xa2, 0xc2,
+	0x38, 0xc7, 0xbd, 0x96, 0xb2, 0x52, 0x63, 0xf3, 0x66, 0x66, 0x3f, 0xef, 0xff, 0x0d, 0x00, 0xd5,
+	0xd2, 0xd0, 0x6f, 0x90, 0x02, 0x00, 0x00,
 }
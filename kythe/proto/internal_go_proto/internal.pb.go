@@ -32,7 +32,7 @@ func (m *Source) Reset()         { *m = Source{} }
 func (m *Source) String() string { return proto.CompactTextString(m) }
 func (*Source) ProtoMessage()    {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{0}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{0}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Source.Unmarshal(m, b)
@@ -85,7 +85,7 @@ func (m *Source_Edge) Reset()         { *m = Source_Edge{} }
 func (m *Source_Edge) String() string { return proto.CompactTextString(m) }
 func (*Source_Edge) ProtoMessage()    {}
 func (*Source_Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{0, 0}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{0, 0}
 }
 func (m *Source_Edge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Source_Edge.Unmarshal(m, b)
@@ -130,7 +130,7 @@ func (m *Source_EdgeGroup) Reset()         { *m = Source_EdgeGroup{} }
 func (m *Source_EdgeGroup) String() string { return proto.CompactTextString(m) }
 func (*Source_EdgeGroup) ProtoMessage()    {}
 func (*Source_EdgeGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{0, 1}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{0, 1}
 }
 func (m *Source_EdgeGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Source_EdgeGroup.Unmarshal(m, b)
@@ -162,6 +162,8 @@ type PageToken struct {
 	SecondaryToken       []string          `protobuf:"bytes,2,rep,name=secondary_token,json=secondaryToken" json:"secondary_token,omitempty"`
 	SubTokens            map[string]string `protobuf:"bytes,3,rep,name=sub_tokens,json=subTokens" json:"sub_tokens,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Indices              map[string]int32  `protobuf:"bytes,4,rep,name=indices" json:"indices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Key                  []byte            `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Counts               map[string]int64  `protobuf:"bytes,6,rep,name=counts" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -171,7 +173,7 @@ func (m *PageToken) Reset()         { *m = PageToken{} }
 func (m *PageToken) String() string { return proto.CompactTextString(m) }
 func (*PageToken) ProtoMessage()    {}
 func (*PageToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{1}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{1}
 }
 func (m *PageToken) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PageToken.Unmarshal(m, b)
@@ -219,6 +221,20 @@ func (m *PageToken) GetIndices() map[string]int32 {
 	return nil
 }
 
+func (m *PageToken) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *PageToken) GetCounts() map[string]int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
 type CrossReference struct {
 	SourceDecoration     *CrossReference_Decoration       `protobuf:"bytes,1,opt,name=source_decoration,json=sourceDecoration" json:"source_decoration,omitempty"`
 	Referent             *serving_go_proto.Node           `protobuf:"bytes,2,opt,name=referent" json:"referent,omitempty"`
@@ -234,7 +250,7 @@ func (m *CrossReference) Reset()         { *m = CrossReference{} }
 func (m *CrossReference) String() string { return proto.CompactTextString(m) }
 func (*CrossReference) ProtoMessage()    {}
 func (*CrossReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{2}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{2}
 }
 func (m *CrossReference) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReference.Unmarshal(m, b)
@@ -302,7 +318,7 @@ func (m *CrossReference_Decoration) Reset()         { *m = CrossReference_Decora
 func (m *CrossReference_Decoration) String() string { return proto.CompactTextString(m) }
 func (*CrossReference_Decoration) ProtoMessage()    {}
 func (*CrossReference_Decoration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{2, 0}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{2, 0}
 }
 func (m *CrossReference_Decoration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReference_Decoration.Unmarshal(m, b)
@@ -356,7 +372,7 @@ func (m *SortedKeyValue) Reset()         { *m = SortedKeyValue{} }
 func (m *SortedKeyValue) String() string { return proto.CompactTextString(m) }
 func (*SortedKeyValue) ProtoMessage()    {}
 func (*SortedKeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{3}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{3}
 }
 func (m *SortedKeyValue) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SortedKeyValue.Unmarshal(m, b)
@@ -409,7 +425,7 @@ func (m *Path) Reset()         { *m = Path{} }
 func (m *Path) String() string { return proto.CompactTextString(m) }
 func (*Path) ProtoMessage()    {}
 func (*Path) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{4}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{4}
 }
 func (m *Path) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Path.Unmarshal(m, b)
@@ -461,7 +477,7 @@ func (m *Path_Node) Reset()         { *m = Path_Node{} }
 func (m *Path_Node) String() string { return proto.CompactTextString(m) }
 func (*Path_Node) ProtoMessage()    {}
 func (*Path_Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{4, 0}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{4, 0}
 }
 func (m *Path_Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Path_Node.Unmarshal(m, b)
@@ -654,7 +670,7 @@ func (m *Path_Edge) Reset()         { *m = Path_Edge{} }
 func (m *Path_Edge) String() string { return proto.CompactTextString(m) }
 func (*Path_Edge) ProtoMessage()    {}
 func (*Path_Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_4a9196845605ba5f, []int{4, 1}
+	return fileDescriptor_internal_bb9c7c5deb5e6a75, []int{4, 1}
 }
 func (m *Path_Edge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Path_Edge.Unmarshal(m, b)
@@ -702,6 +718,7 @@ func init() {
 	proto.RegisterType((*Source_Edge)(nil), "kythe.proto.internal.Source.Edge")
 	proto.RegisterType((*Source_EdgeGroup)(nil), "kythe.proto.internal.Source.EdgeGroup")
 	proto.RegisterType((*PageToken)(nil), "kythe.proto.internal.PageToken")
+	proto.RegisterMapType((map[string]int64)(nil), "kythe.proto.internal.PageToken.CountsEntry")
 	proto.RegisterMapType((map[string]int32)(nil), "kythe.proto.internal.PageToken.IndicesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "kythe.proto.internal.PageToken.SubTokensEntry")
 	proto.RegisterType((*CrossReference)(nil), "kythe.proto.internal.CrossReference")
@@ -713,59 +730,61 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("kythe/proto/internal.proto", fileDescriptor_internal_4a9196845605ba5f)
-}
-
-var fileDescriptor_internal_4a9196845605ba5f = []byte{
-	// 789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xd1, 0x6e, 0xdb, 0x36,
-	0x14, 0x8d, 0x6d, 0xc9, 0x89, 0xae, 0x3d, 0x27, 0x23, 0x8a, 0x41, 0xd1, 0x80, 0x35, 0xf5, 0x80,
-	0x35, 0x0f, 0x9b, 0x0c, 0x64, 0xe8, 0x1a, 0x14, 0x1d, 0x86, 0x75, 0x4d, 0xe6, 0x21, 0x68, 0x51,
-	0xd0, 0xc3, 0x9e, 0x06, 0x18, 0x8a, 0x74, 0x23, 0x13, 0x56, 0x49, 0x83, 0xa2, 0x9d, 0x78, 0x3f,
-	0xb0, 0xef, 0xd8, 0xfb, 0xbe, 0x63, 0xaf, 0xfb, 0x80, 0xfd, 0xcc, 0x20, 0x92, 0xb2, 0xe5, 0x41,
-	0x8e, 0xb3, 0x3e, 0x49, 0x97, 0x3c, 0xf7, 0xdc, 0x43, 0x9e, 0x4b, 0x12, 0x82, 0xe9, 0x52, 0x4d,
-	0x70, 0x30, 0x93, 0x42, 0x89, 0x01, 0xe3, 0x0a, 0x25, 0x8f, 0xb2, 0x50, 0x87, 0xe4, 0x91, 0x9e,
-	0x33, 0x41, 0x58, 0xce, 0x05, 0xc7, 0xd5, 0x8c, 0x1c, 0xe5, 0x82, 0xf1, 0xd4, 0x60, 0xfa, 0x7f,
-	0xb7, 0xa0, 0x3d, 0x12, 0x73, 0x19, 0x23, 0xf9, 0x04, 0xda, 0x8a, 0xc5, 0x53, 0x54, 0x7e, 0xe3,
-	0xa4, 0x71, 0xea, 0x51, 0x1b, 0x91, 0x6f, 0xc1, 0xbd, 0x89, 0x62, 0x95, 0xfb, 0xcd, 0x93, 0xd6,
-	0x69, 0xe7, 0xec, 0x69, 0x58, 0x57, 0x23, 0x34, 0x24, 0xe1, 0x65, 0x81, 0xbc, 0xe0, 0x4a, 0x2e,
-	0xa9, 0xc9, 0x22, 0x6f, 0xa0, 0x83, 0x49, 0x8a, 0xe3, 0x54, 0x8a, 0xf9, 0x2c, 0xf7, 0x5b, 0x9a,
-	0xe4, 0xcb, 0x7b, 0x49, 0x2e, 0x92, 0x14, 0x7f, 0xd4, 0x70, 0xc3, 0x04, 0xb8, 0x1a, 0x08, 0xce,
-	0xc1, 0x29, 0xa6, 0xb7, 0xaa, 0xf5, 0x61, 0x5f, 0xc8, 0x84, 0xf1, 0x28, 0xf3, 0x9b, 0x27, 0x8d,
-	0x53, 0x97, 0x96, 0x61, 0xf0, 0x1a, 0xbc, 0x15, 0x31, 0x79, 0x0e, 0x6e, 0x41, 0x9a, 0xfb, 0x0d,
-	0xad, 0xe7, 0xc9, 0x4e, 0x3d, 0xd4, 0xe0, 0x83, 0x73, 0x80, 0xf5, 0x1a, 0xc9, 0x11, 0xb4, 0xa6,
-	0xb8, 0xb4, 0x12, 0x8a, 0x5f, 0xf2, 0x08, 0xdc, 0x45, 0x94, 0xcd, 0x51, 0x57, 0xef, 0x52, 0x13,
-	0xbc, 0x68, 0x9e, 0x37, 0x02, 0x84, 0xc3, 0xff, 0x2c, 0xac, 0x26, 0xfd, 0x65, 0x35, 0xbd, 0x73,
-	0xf6, 0xc5, 0xc3, 0xf6, 0xa9, 0x52, 0xa6, 0xff, 0x4f, 0x13, 0xbc, 0x77, 0x51, 0x8a, 0x3f, 0x8b,
-	0x29, 0xf2, 0x42, 0x0e, 0xe3, 0x09, 0xde, 0xe9, 0x1a, 0x2e, 0x35, 0x01, 0x79, 0x0a, 0x87, 0x39,
-	0xc6, 0x82, 0x27, 0x91, 0x5c, 0x8e, 0x55, 0x01, 0xd4, 0xe6, 0x7a, 0xb4, 0xb7, 0x1a, 0x36, 0xe9,
-	0x6f, 0x00, 0xf2, 0xf9, 0xb5, 0x81, 0x94, 0xde, 0x85, 0xf5, 0x9a, 0x56, 0x35, 0xc3, 0xd1, 0xfc,
-	0x5a, 0xff, 0x58, 0xf7, 0xbc, 0xbc, 0x8c, 0xc9, 0x25, 0xec, 0x33, 0x9e, 0xb0, 0x18, 0x73, 0xdf,
-	0xb9, 0xaf, 0x0f, 0xd6, 0x5c, 0x3f, 0x19, 0xb8, 0x61, 0x2a, 0x93, 0x83, 0x97, 0xd0, 0xdb, 0x2c,
-	0xb2, 0xcb, 0x08, 0xaf, 0x6a, 0xc4, 0x0b, 0xe8, 0x56, 0x69, 0x77, 0xe5, 0xba, 0xd5, 0xdd, 0xfd,
-	0xc3, 0x81, 0xde, 0x0f, 0x52, 0xe4, 0x39, 0xc5, 0x1b, 0x94, 0xc8, 0x63, 0x24, 0xbf, 0xc2, 0xc7,
-	0xb9, 0xf6, 0x63, 0x9c, 0x60, 0x2c, 0x64, 0xa4, 0x98, 0xe0, 0x9a, 0xac, 0x73, 0x36, 0xa8, 0x5f,
-	0xde, 0x26, 0x41, 0xf8, 0x7a, 0x95, 0x46, 0x8f, 0x0c, 0xd3, 0x7a, 0x84, 0x3c, 0x83, 0x03, 0x69,
-	0x90, 0xca, 0xf6, 0xc4, 0xf1, 0x06, 0x69, 0x79, 0x9c, 0xdf, 0x8a, 0x04, 0xe9, 0x0a, 0x5a, 0x88,
-	0x52, 0x91, 0x4c, 0x51, 0x55, 0x45, 0xb5, 0x3e, 0x50, 0x94, 0x61, 0xaa, 0x88, 0x1a, 0xc2, 0x47,
-	0x76, 0xc9, 0x11, 0x8f, 0x27, 0x42, 0xfa, 0x8e, 0x66, 0xfe, 0xbc, 0x56, 0xd9, 0xc5, 0xdd, 0x2c,
-	0xe2, 0x09, 0x26, 0xdf, 0x6b, 0x28, 0xed, 0x9a, 0x4c, 0x13, 0x15, 0x4c, 0x56, 0xa7, 0x65, 0x72,
-	0xff, 0x07, 0x93, 0xc9, 0x34, 0x51, 0xf0, 0x7b, 0x03, 0xa0, 0x22, 0xf1, 0x2b, 0x70, 0x6e, 0x58,
-	0x86, 0xd6, 0x88, 0xfa, 0x3d, 0xbb, 0x64, 0x19, 0x52, 0x0d, 0x23, 0xdf, 0x40, 0xdb, 0x0a, 0x30,
-	0x9b, 0xfc, 0x59, 0x6d, 0x02, 0x8d, 0x6e, 0x6d, 0x6d, 0x8b, 0x26, 0x04, 0x9c, 0x29, 0xe3, 0x89,
-	0xde, 0x5a, 0x8f, 0xea, 0xff, 0xfe, 0x08, 0x7a, 0x23, 0x21, 0x15, 0x26, 0x57, 0xb8, 0xfc, 0xa5,
-	0xe8, 0x9c, 0x9a, 0x0e, 0x3b, 0x86, 0x83, 0x5c, 0x48, 0x35, 0x2e, 0x86, 0x4d, 0x83, 0xee, 0x17,
-	0xf1, 0x55, 0xb5, 0xf9, 0x5a, 0x95, 0x1b, 0xa4, 0xff, 0xa7, 0x03, 0xce, 0xbb, 0x48, 0x4d, 0xc8,
-	0x33, 0x70, 0x67, 0x6c, 0x21, 0x94, 0x5d, 0xd9, 0xe3, 0x6d, 0x27, 0x48, 0x4d, 0x4c, 0x4f, 0x18,
-	0x74, 0x91, 0x66, 0x2e, 0x3c, 0x73, 0x8b, 0xdf, 0x97, 0x56, 0xbd, 0xee, 0xfe, 0x6a, 0x82, 0x53,
-	0xd0, 0x90, 0xef, 0x00, 0x64, 0x74, 0x5b, 0xba, 0x04, 0x0f, 0xd9, 0xa4, 0xe1, 0x1e, 0xf5, 0x64,
-	0x19, 0x90, 0xb7, 0x70, 0x88, 0xd6, 0xbf, 0x92, 0xa5, 0xf3, 0x60, 0xaf, 0x87, 0x7b, 0xb4, 0x87,
-	0x1b, 0x23, 0x64, 0x60, 0x0d, 0xee, 0xee, 0x30, 0x78, 0xb8, 0x67, 0x2d, 0xde, 0xf6, 0x62, 0x7c,
-	0x0a, 0x1e, 0x17, 0x09, 0x8e, 0xb5, 0x8f, 0xc6, 0x8b, 0x83, 0x62, 0xe0, 0x8a, 0xf1, 0xa4, 0x38,
-	0x7e, 0x42, 0xb2, 0x54, 0xbf, 0x27, 0xad, 0x9d, 0xc7, 0xaf, 0x84, 0xbe, 0x3a, 0x82, 0x5e, 0x3e,
-	0xc3, 0x98, 0x45, 0x19, 0xfb, 0x4d, 0xf7, 0x63, 0xf0, 0xde, 0xbe, 0x5b, 0x65, 0xc3, 0x34, 0xd6,
-	0x0d, 0xb3, 0xfd, 0xcd, 0x22, 0xcf, 0xa1, 0x6d, 0x9a, 0xdc, 0x16, 0xdf, 0xe9, 0xb6, 0x85, 0xbf,
-	0x7a, 0x02, 0x8f, 0x63, 0xf1, 0x3e, 0x4c, 0x85, 0x48, 0x33, 0x0c, 0x13, 0x5c, 0x28, 0x21, 0xb2,
-	0xbc, 0x9a, 0x7d, 0xdd, 0xd6, 0x9f, 0xaf, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x57, 0x76,
-	0xd9, 0x50, 0x08, 0x00, 0x00,
+	proto.RegisterFile("kythe/proto/internal.proto", fileDescriptor_internal_bb9c7c5deb5e6a75)
+}
+
+var fileDescriptor_internal_bb9c7c5deb5e6a75 = []byte{
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xd1, 0x6e, 0xe3, 0x44,
+	0x14, 0xad, 0x6b, 0x3b, 0xad, 0x6f, 0x4a, 0x5a, 0x46, 0x2b, 0xe4, 0x1a, 0x89, 0xed, 0x16, 0x89,
+	0xad, 0x04, 0xb8, 0x52, 0xd1, 0xb2, 0x65, 0xb5, 0x08, 0xb1, 0xdd, 0x96, 0xa2, 0x6a, 0x57, 0xab,
+	0x09, 0xe2, 0x09, 0x29, 0x72, 0xed, 0x5b, 0x67, 0x14, 0xef, 0x4c, 0x34, 0x9e, 0x64, 0x37, 0xfc,
+	0x00, 0xdf, 0xc1, 0x3b, 0xdf, 0xc1, 0x2b, 0x5f, 0xc2, 0x3f, 0x20, 0xcf, 0x8c, 0x13, 0x07, 0x25,
+	0x71, 0xe1, 0x29, 0xb9, 0xe3, 0x73, 0xce, 0x1c, 0xdf, 0x73, 0x3d, 0x03, 0xd1, 0x68, 0xa6, 0x86,
+	0x78, 0x3a, 0x96, 0x42, 0x89, 0x53, 0xc6, 0x15, 0x4a, 0x9e, 0x14, 0xb1, 0x2e, 0xc9, 0x03, 0xfd,
+	0xcc, 0x14, 0x71, 0xfd, 0x2c, 0x3a, 0x6c, 0x32, 0x4a, 0x94, 0x53, 0xc6, 0x73, 0x83, 0x39, 0xfe,
+	0xcb, 0x85, 0x4e, 0x5f, 0x4c, 0x64, 0x8a, 0xe4, 0x23, 0xe8, 0x28, 0x96, 0x8e, 0x50, 0x85, 0xce,
+	0x91, 0x73, 0x12, 0x50, 0x5b, 0x91, 0x6f, 0xc1, 0xbf, 0x4b, 0x52, 0x55, 0x86, 0xdb, 0x47, 0xee,
+	0x49, 0xf7, 0xec, 0x71, 0xbc, 0x6a, 0x8f, 0xd8, 0x88, 0xc4, 0x57, 0x15, 0xf2, 0x92, 0x2b, 0x39,
+	0xa3, 0x86, 0x45, 0x5e, 0x41, 0x17, 0xb3, 0x1c, 0x07, 0xb9, 0x14, 0x93, 0x71, 0x19, 0xba, 0x5a,
+	0xe4, 0x8b, 0x8d, 0x22, 0x97, 0x59, 0x8e, 0x3f, 0x68, 0xb8, 0x51, 0x02, 0x9c, 0x2f, 0x44, 0xe7,
+	0xe0, 0x55, 0x8f, 0xd7, 0xba, 0x0d, 0x61, 0x47, 0xc8, 0x8c, 0xf1, 0xa4, 0x08, 0xb7, 0x8f, 0x9c,
+	0x13, 0x9f, 0xd6, 0x65, 0xf4, 0x12, 0x82, 0xb9, 0x30, 0x79, 0x0a, 0x7e, 0x25, 0x5a, 0x86, 0x8e,
+	0xf6, 0xf3, 0xa8, 0xd5, 0x0f, 0x35, 0xf8, 0xe8, 0x1c, 0x60, 0xf1, 0x8e, 0xe4, 0x00, 0xdc, 0x11,
+	0xce, 0xac, 0x85, 0xea, 0x2f, 0x79, 0x00, 0xfe, 0x34, 0x29, 0x26, 0xa8, 0x77, 0xdf, 0xa3, 0xa6,
+	0x78, 0xb6, 0x7d, 0xee, 0x44, 0x08, 0xfb, 0xff, 0x7a, 0xb1, 0x15, 0xf4, 0xe7, 0x4d, 0x7a, 0xf7,
+	0xec, 0xb3, 0xfb, 0xf5, 0xa9, 0xb1, 0xcd, 0xf1, 0xdf, 0x2e, 0x04, 0x6f, 0x92, 0x1c, 0x7f, 0x12,
+	0x23, 0xe4, 0x95, 0x1d, 0xc6, 0x33, 0x7c, 0xaf, 0xf7, 0xf0, 0xa9, 0x29, 0xc8, 0x63, 0xd8, 0x2f,
+	0x31, 0x15, 0x3c, 0x4b, 0xe4, 0x6c, 0xa0, 0x2a, 0xa0, 0x0e, 0x37, 0xa0, 0xbd, 0xf9, 0xb2, 0xa1,
+	0xbf, 0x02, 0x28, 0x27, 0xb7, 0x06, 0x52, 0x67, 0x17, 0xaf, 0xf6, 0x34, 0xdf, 0x33, 0xee, 0x4f,
+	0x6e, 0xf5, 0x1f, 0x9b, 0x5e, 0x50, 0xd6, 0x35, 0xb9, 0x82, 0x1d, 0xc6, 0x33, 0x96, 0x62, 0x19,
+	0x7a, 0x9b, 0xe6, 0x60, 0xa1, 0xf5, 0xa3, 0x81, 0x1b, 0xa5, 0x9a, 0x5c, 0xf7, 0xcd, 0xd7, 0x2d,
+	0xd6, 0x7d, 0xbb, 0x80, 0x4e, 0x2a, 0x26, 0x5c, 0x95, 0x61, 0x47, 0x0b, 0x7f, 0xde, 0x26, 0x7c,
+	0xa1, 0xd1, 0x46, 0xd7, 0x52, 0xa3, 0xe7, 0xd0, 0x5b, 0xf6, 0xde, 0x96, 0x6f, 0xd0, 0xcc, 0xf7,
+	0x19, 0xec, 0x35, 0xdd, 0xb6, 0x71, 0xfd, 0x26, 0xf7, 0x1b, 0xe8, 0x36, 0x0c, 0xb5, 0x51, 0xdd,
+	0x66, 0xde, 0xbf, 0x7b, 0xd0, 0xbb, 0x90, 0xa2, 0x2c, 0x29, 0xde, 0xa1, 0x44, 0x9e, 0x22, 0xf9,
+	0x05, 0x3e, 0x2c, 0xf5, 0x84, 0x0c, 0x32, 0x4c, 0x85, 0x4c, 0x14, 0x13, 0x5c, 0x8b, 0x75, 0xcf,
+	0x4e, 0x57, 0xf7, 0x65, 0x59, 0x20, 0x7e, 0x39, 0xa7, 0xd1, 0x03, 0xa3, 0xb4, 0x58, 0x21, 0x4f,
+	0x60, 0x57, 0x1a, 0xa4, 0xb2, 0x53, 0x7a, 0xb8, 0x24, 0x5a, 0x1f, 0x30, 0xaf, 0x45, 0x86, 0x74,
+	0x0e, 0xad, 0x4c, 0xa9, 0x44, 0xe6, 0xa8, 0x9a, 0xa6, 0xdc, 0xff, 0x69, 0xca, 0x28, 0x35, 0x4c,
+	0x5d, 0xc3, 0x07, 0xf6, 0x95, 0x13, 0x9e, 0x0e, 0x85, 0x0c, 0x3d, 0xad, 0xfc, 0xe9, 0x4a, 0x67,
+	0x97, 0xef, 0xc7, 0x09, 0xcf, 0x30, 0xfb, 0x5e, 0x43, 0xe9, 0x9e, 0x61, 0x9a, 0xaa, 0x52, 0xb2,
+	0x3e, 0xad, 0x92, 0xff, 0x1f, 0x94, 0x0c, 0xd3, 0x54, 0xd1, 0x6f, 0x0e, 0x40, 0xc3, 0xe2, 0x97,
+	0xe0, 0xdd, 0xb1, 0x02, 0x43, 0x67, 0x43, 0xcf, 0xae, 0x58, 0x81, 0x54, 0xc3, 0xc8, 0xd7, 0xd0,
+	0xb1, 0x06, 0x4c, 0x93, 0x3f, 0x59, 0x49, 0xa0, 0xc9, 0x3b, 0xbb, 0xb7, 0x45, 0x13, 0x02, 0xde,
+	0x88, 0xf1, 0x4c, 0xb7, 0x36, 0xa0, 0xfa, 0xff, 0x71, 0x1f, 0x7a, 0x7d, 0x21, 0x15, 0x66, 0x37,
+	0x38, 0xfb, 0xb9, 0x9a, 0x9c, 0x15, 0x13, 0x76, 0x08, 0xbb, 0xa5, 0x90, 0x6a, 0x50, 0x2d, 0x9b,
+	0xd9, 0xde, 0xa9, 0xea, 0x9b, 0xe6, 0xf0, 0xb9, 0x8d, 0x33, 0xed, 0xf8, 0x0f, 0x0f, 0xbc, 0x37,
+	0x89, 0x1a, 0x92, 0x27, 0xe0, 0x8f, 0xd9, 0x54, 0x28, 0xfb, 0x66, 0x0f, 0xd7, 0x7d, 0x7a, 0x6a,
+	0x68, 0x66, 0xc2, 0xa0, 0x2b, 0x9a, 0x39, 0x82, 0xcd, 0xbd, 0xb2, 0x89, 0xd6, 0x3c, 0x80, 0xff,
+	0xdc, 0x06, 0xaf, 0x92, 0x21, 0xdf, 0x01, 0xc8, 0xe4, 0x5d, 0x9d, 0x12, 0xdc, 0xa7, 0x49, 0xd7,
+	0x5b, 0x34, 0x90, 0x75, 0x41, 0x5e, 0xc3, 0x3e, 0xda, 0xfc, 0x6a, 0x95, 0xee, 0xbd, 0xb3, 0xbe,
+	0xde, 0xa2, 0x3d, 0x5c, 0x5a, 0x21, 0xa7, 0x36, 0xe0, 0xbd, 0x96, 0x80, 0xaf, 0xb7, 0x6c, 0xc4,
+	0xeb, 0xee, 0xb0, 0x8f, 0x21, 0xe0, 0x22, 0xc3, 0x81, 0xce, 0xd1, 0x64, 0xb1, 0x5b, 0x2d, 0xdc,
+	0x30, 0x9e, 0x55, 0x9f, 0x9f, 0x90, 0x2c, 0xd7, 0x37, 0x9c, 0xdb, 0xfa, 0xf9, 0xd5, 0xd0, 0x17,
+	0x07, 0xd0, 0x2b, 0xc7, 0x98, 0xb2, 0xa4, 0x60, 0xbf, 0xea, 0x79, 0x8c, 0xde, 0xda, 0x9b, 0xb4,
+	0x1e, 0x18, 0x67, 0x31, 0x30, 0xeb, 0x6f, 0x51, 0xf2, 0x14, 0x3a, 0x66, 0xc8, 0xed, 0xe6, 0xad,
+	0x69, 0x5b, 0xf8, 0x8b, 0x47, 0xf0, 0x30, 0x15, 0x6f, 0xe3, 0x5c, 0x88, 0xbc, 0xc0, 0x38, 0xc3,
+	0xa9, 0x12, 0xa2, 0x28, 0x9b, 0xec, 0xdb, 0x8e, 0xfe, 0xf9, 0xea, 0x9f, 0x01, 0x00, 0xb2, 0x98,
+	0x35, 0x64, 0xe2, 0x08, 0x00, 0x00,
 }
@@ -39,7 +39,7 @@ func (x FileDecorations_Override_Kind) String() string {
 	return proto.EnumName(FileDecorations_Override_Kind_name, int32(x))
 }
 func (FileDecorations_Override_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{11, 1, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{11, 1, 0}
 }
 
 type Relatives_Type int32
@@ -65,7 +65,7 @@ func (x Relatives_Type) String() string {
 	return proto.EnumName(Relatives_Type_name, int32(x))
 }
 func (Relatives_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{15, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{16, 0}
 }
 
 type Callgraph_Type int32
@@ -91,7 +91,7 @@ func (x Callgraph_Type) String() string {
 	return proto.EnumName(Callgraph_Type_name, int32(x))
 }
 func (Callgraph_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{16, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{17, 0}
 }
 
 type Node struct {
@@ -107,7 +107,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{0}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -163,7 +163,7 @@ func (m *Edge) Reset()         { *m = Edge{} }
 func (m *Edge) String() string { return proto.CompactTextString(m) }
 func (*Edge) ProtoMessage()    {}
 func (*Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{1}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{1}
 }
 func (m *Edge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Edge.Unmarshal(m, b)
@@ -230,7 +230,7 @@ func (m *EdgeGroup) Reset()         { *m = EdgeGroup{} }
 func (m *EdgeGroup) String() string { return proto.CompactTextString(m) }
 func (*EdgeGroup) ProtoMessage()    {}
 func (*EdgeGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{2}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{2}
 }
 func (m *EdgeGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EdgeGroup.Unmarshal(m, b)
@@ -276,7 +276,7 @@ func (m *EdgeGroup_Edge) Reset()         { *m = EdgeGroup_Edge{} }
 func (m *EdgeGroup_Edge) String() string { return proto.CompactTextString(m) }
 func (*EdgeGroup_Edge) ProtoMessage()    {}
 func (*EdgeGroup_Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{2, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{2, 0}
 }
 func (m *EdgeGroup_Edge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EdgeGroup_Edge.Unmarshal(m, b)
@@ -324,7 +324,7 @@ func (m *PagedEdgeSet) Reset()         { *m = PagedEdgeSet{} }
 func (m *PagedEdgeSet) String() string { return proto.CompactTextString(m) }
 func (*PagedEdgeSet) ProtoMessage()    {}
 func (*PagedEdgeSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{3}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{3}
 }
 func (m *PagedEdgeSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PagedEdgeSet.Unmarshal(m, b)
@@ -386,7 +386,7 @@ func (m *PageIndex) Reset()         { *m = PageIndex{} }
 func (m *PageIndex) String() string { return proto.CompactTextString(m) }
 func (*PageIndex) ProtoMessage()    {}
 func (*PageIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{4}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{4}
 }
 func (m *PageIndex) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PageIndex.Unmarshal(m, b)
@@ -440,7 +440,7 @@ func (m *EdgePage) Reset()         { *m = EdgePage{} }
 func (m *EdgePage) String() string { return proto.CompactTextString(m) }
 func (*EdgePage) ProtoMessage()    {}
 func (*EdgePage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{5}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{5}
 }
 func (m *EdgePage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EdgePage.Unmarshal(m, b)
@@ -493,7 +493,7 @@ func (m *FileDirectory) Reset()         { *m = FileDirectory{} }
 func (m *FileDirectory) String() string { return proto.CompactTextString(m) }
 func (*FileDirectory) ProtoMessage()    {}
 func (*FileDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{6}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{6}
 }
 func (m *FileDirectory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDirectory.Unmarshal(m, b)
@@ -538,7 +538,7 @@ func (m *CorpusRoots) Reset()         { *m = CorpusRoots{} }
 func (m *CorpusRoots) String() string { return proto.CompactTextString(m) }
 func (*CorpusRoots) ProtoMessage()    {}
 func (*CorpusRoots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{7}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{7}
 }
 func (m *CorpusRoots) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CorpusRoots.Unmarshal(m, b)
@@ -577,7 +577,7 @@ func (m *CorpusRoots_Corpus) Reset()         { *m = CorpusRoots_Corpus{} }
 func (m *CorpusRoots_Corpus) String() string { return proto.CompactTextString(m) }
 func (*CorpusRoots_Corpus) ProtoMessage()    {}
 func (*CorpusRoots_Corpus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{7, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{7, 0}
 }
 func (m *CorpusRoots_Corpus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CorpusRoots_Corpus.Unmarshal(m, b)
@@ -624,7 +624,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{8}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{8}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_File.Unmarshal(m, b)
@@ -680,7 +680,7 @@ func (m *RawAnchor) Reset()         { *m = RawAnchor{} }
 func (m *RawAnchor) String() string { return proto.CompactTextString(m) }
 func (*RawAnchor) ProtoMessage()    {}
 func (*RawAnchor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{9}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{9}
 }
 func (m *RawAnchor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RawAnchor.Unmarshal(m, b)
@@ -751,7 +751,7 @@ func (m *ExpandedAnchor) Reset()         { *m = ExpandedAnchor{} }
 func (m *ExpandedAnchor) String() string { return proto.CompactTextString(m) }
 func (*ExpandedAnchor) ProtoMessage()    {}
 func (*ExpandedAnchor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{10}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{10}
 }
 func (m *ExpandedAnchor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExpandedAnchor.Unmarshal(m, b)
@@ -829,7 +829,7 @@ func (m *FileDecorations) Reset()         { *m = FileDecorations{} }
 func (m *FileDecorations) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations) ProtoMessage()    {}
 func (*FileDecorations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{11}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{11}
 }
 func (m *FileDecorations) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations.Unmarshal(m, b)
@@ -905,7 +905,7 @@ func (m *FileDecorations_Decoration) Reset()         { *m = FileDecorations_Deco
 func (m *FileDecorations_Decoration) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_Decoration) ProtoMessage()    {}
 func (*FileDecorations_Decoration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{11, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{11, 0}
 }
 func (m *FileDecorations_Decoration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_Decoration.Unmarshal(m, b)
@@ -968,7 +968,7 @@ func (m *FileDecorations_Override) Reset()         { *m = FileDecorations_Overri
 func (m *FileDecorations_Override) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_Override) ProtoMessage()    {}
 func (*FileDecorations_Override) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{11, 1}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{11, 1}
 }
 func (m *FileDecorations_Override) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_Override.Unmarshal(m, b)
@@ -1041,7 +1041,7 @@ func (m *PagedCrossReferences) Reset()         { *m = PagedCrossReferences{} }
 func (m *PagedCrossReferences) String() string { return proto.CompactTextString(m) }
 func (*PagedCrossReferences) ProtoMessage()    {}
 func (*PagedCrossReferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{12}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{12}
 }
 func (m *PagedCrossReferences) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PagedCrossReferences.Unmarshal(m, b)
@@ -1130,7 +1130,7 @@ func (m *PagedCrossReferences_RelatedNode) Reset()         { *m = PagedCrossRefe
 func (m *PagedCrossReferences_RelatedNode) String() string { return proto.CompactTextString(m) }
 func (*PagedCrossReferences_RelatedNode) ProtoMessage()    {}
 func (*PagedCrossReferences_RelatedNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{12, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{12, 0}
 }
 func (m *PagedCrossReferences_RelatedNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PagedCrossReferences_RelatedNode.Unmarshal(m, b)
@@ -1178,7 +1178,7 @@ func (m *PagedCrossReferences_Caller) Reset()         { *m = PagedCrossReference
 func (m *PagedCrossReferences_Caller) String() string { return proto.CompactTextString(m) }
 func (*PagedCrossReferences_Caller) ProtoMessage()    {}
 func (*PagedCrossReferences_Caller) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{12, 1}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{12, 1}
 }
 func (m *PagedCrossReferences_Caller) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PagedCrossReferences_Caller.Unmarshal(m, b)
@@ -1240,7 +1240,7 @@ func (m *PagedCrossReferences_Group) Reset()         { *m = PagedCrossReferences
 func (m *PagedCrossReferences_Group) String() string { return proto.CompactTextString(m) }
 func (*PagedCrossReferences_Group) ProtoMessage()    {}
 func (*PagedCrossReferences_Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{12, 2}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{12, 2}
 }
 func (m *PagedCrossReferences_Group) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PagedCrossReferences_Group.Unmarshal(m, b)
@@ -1301,7 +1301,7 @@ func (m *PagedCrossReferences_Page) Reset()         { *m = PagedCrossReferences_
 func (m *PagedCrossReferences_Page) String() string { return proto.CompactTextString(m) }
 func (*PagedCrossReferences_Page) ProtoMessage()    {}
 func (*PagedCrossReferences_Page) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{12, 3}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{12, 3}
 }
 func (m *PagedCrossReferences_Page) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PagedCrossReferences_Page.Unmarshal(m, b)
@@ -1355,7 +1355,7 @@ func (m *PagedCrossReferences_PageIndex) Reset()         { *m = PagedCrossRefere
 func (m *PagedCrossReferences_PageIndex) String() string { return proto.CompactTextString(m) }
 func (*PagedCrossReferences_PageIndex) ProtoMessage()    {}
 func (*PagedCrossReferences_PageIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{12, 4}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{12, 4}
 }
 func (m *PagedCrossReferences_PageIndex) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PagedCrossReferences_PageIndex.Unmarshal(m, b)
@@ -1413,7 +1413,7 @@ func (m *Document) Reset()         { *m = Document{} }
 func (m *Document) String() string { return proto.CompactTextString(m) }
 func (*Document) ProtoMessage()    {}
 func (*Document) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{13}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{13}
 }
 func (m *Document) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Document.Unmarshal(m, b)
@@ -1495,7 +1495,7 @@ func (m *IdentifierMatch) Reset()         { *m = IdentifierMatch{} }
 func (m *IdentifierMatch) String() string { return proto.CompactTextString(m) }
 func (*IdentifierMatch) ProtoMessage()    {}
 func (*IdentifierMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{14}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{14}
 }
 func (m *IdentifierMatch) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdentifierMatch.Unmarshal(m, b)
@@ -1549,7 +1549,7 @@ func (m *IdentifierMatch_Node) Reset()         { *m = IdentifierMatch_Node{} }
 func (m *IdentifierMatch_Node) String() string { return proto.CompactTextString(m) }
 func (*IdentifierMatch_Node) ProtoMessage()    {}
 func (*IdentifierMatch_Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{14, 0}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{14, 0}
 }
 func (m *IdentifierMatch_Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdentifierMatch_Node.Unmarshal(m, b)
@@ -1590,6 +1590,90 @@ func (m *IdentifierMatch_Node) GetNodeSubkind() string {
 	return ""
 }
 
+type IdentifierNgram struct {
+	Identifier           []*IdentifierNgram_Identifier `protobuf:"bytes,1,rep,name=identifier" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *IdentifierNgram) Reset()         { *m = IdentifierNgram{} }
+func (m *IdentifierNgram) String() string { return proto.CompactTextString(m) }
+func (*IdentifierNgram) ProtoMessage()    {}
+func (*IdentifierNgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{15}
+}
+func (m *IdentifierNgram) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdentifierNgram.Unmarshal(m, b)
+}
+func (m *IdentifierNgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdentifierNgram.Marshal(b, m, deterministic)
+}
+func (dst *IdentifierNgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifierNgram.Merge(dst, src)
+}
+func (m *IdentifierNgram) XXX_Size() int {
+	return xxx_messageInfo_IdentifierNgram.Size(m)
+}
+func (m *IdentifierNgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifierNgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifierNgram proto.InternalMessageInfo
+
+func (m *IdentifierNgram) GetIdentifier() []*IdentifierNgram_Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+type IdentifierNgram_Identifier struct {
+	QualifiedName        string   `protobuf:"bytes,1,opt,name=qualified_name,json=qualifiedName" json:"qualified_name,omitempty"`
+	BaseName             string   `protobuf:"bytes,2,opt,name=base_name,json=baseName" json:"base_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IdentifierNgram_Identifier) Reset()         { *m = IdentifierNgram_Identifier{} }
+func (m *IdentifierNgram_Identifier) String() string { return proto.CompactTextString(m) }
+func (*IdentifierNgram_Identifier) ProtoMessage()    {}
+func (*IdentifierNgram_Identifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{15, 0}
+}
+func (m *IdentifierNgram_Identifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdentifierNgram_Identifier.Unmarshal(m, b)
+}
+func (m *IdentifierNgram_Identifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdentifierNgram_Identifier.Marshal(b, m, deterministic)
+}
+func (dst *IdentifierNgram_Identifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifierNgram_Identifier.Merge(dst, src)
+}
+func (m *IdentifierNgram_Identifier) XXX_Size() int {
+	return xxx_messageInfo_IdentifierNgram_Identifier.Size(m)
+}
+func (m *IdentifierNgram_Identifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifierNgram_Identifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifierNgram_Identifier proto.InternalMessageInfo
+
+func (m *IdentifierNgram_Identifier) GetQualifiedName() string {
+	if m != nil {
+		return m.QualifiedName
+	}
+	return ""
+}
+
+func (m *IdentifierNgram_Identifier) GetBaseName() string {
+	if m != nil {
+		return m.BaseName
+	}
+	return ""
+}
+
 type Relatives struct {
 	Tickets              []string       `protobuf:"bytes,1,rep,name=tickets" json:"tickets,omitempty"`
 	Type                 Relatives_Type `protobuf:"varint,2,opt,name=type,enum=kythe.proto.serving.Relatives_Type" json:"type,omitempty"`
@@ -1602,7 +1686,7 @@ func (m *Relatives) Reset()         { *m = Relatives{} }
 func (m *Relatives) String() string { return proto.CompactTextString(m) }
 func (*Relatives) ProtoMessage()    {}
 func (*Relatives) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{15}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{16}
 }
 func (m *Relatives) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Relatives.Unmarshal(m, b)
@@ -1648,7 +1732,7 @@ func (m *Callgraph) Reset()         { *m = Callgraph{} }
 func (m *Callgraph) String() string { return proto.CompactTextString(m) }
 func (*Callgraph) ProtoMessage()    {}
 func (*Callgraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_serving_798e764c5281a514, []int{16}
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{17}
 }
 func (m *Callgraph) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Callgraph.Unmarshal(m, b)
@@ -1682,6 +1766,98 @@ func (m *Callgraph) GetType() Callgraph_Type {
 	return Callgraph_UNKNOWN
 }
 
+type TypeHierarchy struct {
+	Supertypes           []string `protobuf:"bytes,1,rep,name=supertypes" json:"supertypes,omitempty"`
+	Subtypes             []string `protobuf:"bytes,2,rep,name=subtypes" json:"subtypes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TypeHierarchy) Reset()         { *m = TypeHierarchy{} }
+func (m *TypeHierarchy) String() string { return proto.CompactTextString(m) }
+func (*TypeHierarchy) ProtoMessage()    {}
+func (*TypeHierarchy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{18}
+}
+func (m *TypeHierarchy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TypeHierarchy.Unmarshal(m, b)
+}
+func (m *TypeHierarchy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TypeHierarchy.Marshal(b, m, deterministic)
+}
+func (dst *TypeHierarchy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypeHierarchy.Merge(dst, src)
+}
+func (m *TypeHierarchy) XXX_Size() int {
+	return xxx_messageInfo_TypeHierarchy.Size(m)
+}
+func (m *TypeHierarchy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypeHierarchy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypeHierarchy proto.InternalMessageInfo
+
+func (m *TypeHierarchy) GetSupertypes() []string {
+	if m != nil {
+		return m.Supertypes
+	}
+	return nil
+}
+
+func (m *TypeHierarchy) GetSubtypes() []string {
+	if m != nil {
+		return m.Subtypes
+	}
+	return nil
+}
+
+type FunctionParameters struct {
+	Function             *Node    `protobuf:"bytes,1,opt,name=function" json:"function,omitempty"`
+	Parameters           []*Node  `protobuf:"bytes,2,rep,name=parameters" json:"parameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FunctionParameters) Reset()         { *m = FunctionParameters{} }
+func (m *FunctionParameters) String() string { return proto.CompactTextString(m) }
+func (*FunctionParameters) ProtoMessage()    {}
+func (*FunctionParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_serving_d7da04bce0b5d752, []int{19}
+}
+func (m *FunctionParameters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionParameters.Unmarshal(m, b)
+}
+func (m *FunctionParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionParameters.Marshal(b, m, deterministic)
+}
+func (dst *FunctionParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionParameters.Merge(dst, src)
+}
+func (m *FunctionParameters) XXX_Size() int {
+	return xxx_messageInfo_FunctionParameters.Size(m)
+}
+func (m *FunctionParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionParameters proto.InternalMessageInfo
+
+func (m *FunctionParameters) GetFunction() *Node {
+	if m != nil {
+		return m.Function
+	}
+	return nil
+}
+
+func (m *FunctionParameters) GetParameters() []*Node {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Node)(nil), "kythe.proto.serving.Node")
 	proto.RegisterType((*Edge)(nil), "kythe.proto.serving.Edge")
@@ -1708,118 +1884,129 @@ func init() {
 	proto.RegisterType((*Document)(nil), "kythe.proto.serving.Document")
 	proto.RegisterType((*IdentifierMatch)(nil), "kythe.proto.serving.IdentifierMatch")
 	proto.RegisterType((*IdentifierMatch_Node)(nil), "kythe.proto.serving.IdentifierMatch.Node")
+	proto.RegisterType((*IdentifierNgram)(nil), "kythe.proto.serving.IdentifierNgram")
+	proto.RegisterType((*IdentifierNgram_Identifier)(nil), "kythe.proto.serving.IdentifierNgram.Identifier")
 	proto.RegisterType((*Relatives)(nil), "kythe.proto.serving.Relatives")
 	proto.RegisterType((*Callgraph)(nil), "kythe.proto.serving.Callgraph")
+	proto.RegisterType((*TypeHierarchy)(nil), "kythe.proto.serving.TypeHierarchy")
+	proto.RegisterType((*FunctionParameters)(nil), "kythe.proto.serving.FunctionParameters")
 	proto.RegisterEnum("kythe.proto.serving.FileDecorations_Override_Kind", FileDecorations_Override_Kind_name, FileDecorations_Override_Kind_value)
 	proto.RegisterEnum("kythe.proto.serving.Relatives_Type", Relatives_Type_name, Relatives_Type_value)
 	proto.RegisterEnum("kythe.proto.serving.Callgraph_Type", Callgraph_Type_name, Callgraph_Type_value)
 }
 
-func init() { proto.RegisterFile("kythe/proto/serving.proto", fileDescriptor_serving_798e764c5281a514) }
-
-var fileDescriptor_serving_798e764c5281a514 = []byte{
-	// 1645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x6f, 0xdb, 0xc6,
-	0x12, 0x7e, 0x94, 0x29, 0x59, 0x1a, 0xca, 0xb6, 0xb2, 0xc9, 0x0b, 0x68, 0x05, 0x2f, 0x71, 0x68,
-	0x3c, 0xc4, 0x0f, 0x89, 0xe5, 0x57, 0x27, 0x6d, 0x81, 0x06, 0x69, 0x90, 0xd8, 0x4a, 0xe3, 0xc4,
-	0x91, 0x83, 0x95, 0x9b, 0xe4, 0x54, 0x82, 0x26, 0xd7, 0x32, 0x61, 0x89, 0xab, 0x92, 0x2b, 0x3b,
-	0xba, 0xb7, 0xa7, 0xa2, 0xfd, 0x07, 0xbd, 0xf4, 0x56, 0x14, 0xbd, 0xf4, 0x57, 0xf4, 0x3f, 0xf4,
-	0x6f, 0x14, 0x3d, 0x15, 0x68, 0xb1, 0xb3, 0x4b, 0x8a, 0x8e, 0x25, 0x5b, 0x75, 0x7b, 0xd2, 0xce,
-	0xec, 0xcc, 0xec, 0xcc, 0x37, 0xb3, 0xb3, 0x43, 0xc1, 0xe2, 0xe1, 0x50, 0x1c, 0xb0, 0xb5, 0x7e,
-	0xcc, 0x05, 0x5f, 0x4b, 0x58, 0x7c, 0x14, 0x46, 0x9d, 0x06, 0x52, 0xe4, 0x32, 0x6e, 0x29, 0xa2,
-	0xa1, 0xb7, 0xea, 0x76, 0x5e, 0xde, 0xe7, 0xbd, 0x1e, 0x8f, 0x94, 0x84, 0xf3, 0x9d, 0x01, 0x66,
-	0x8b, 0x07, 0x8c, 0x5c, 0x85, 0x92, 0x08, 0xfd, 0x43, 0x26, 0x6c, 0x63, 0xc9, 0x58, 0xa9, 0x50,
-	0x4d, 0x91, 0x3b, 0x60, 0xee, 0x7b, 0xbe, 0xb0, 0x0b, 0x4b, 0x33, 0x2b, 0xd6, 0xba, 0xdd, 0xc8,
-	0x9b, 0xd7, 0x96, 0x9e, 0x78, 0xbe, 0xa0, 0x28, 0x45, 0x76, 0xe1, 0x72, 0xc0, 0xf6, 0xc3, 0x28,
-	0x14, 0x21, 0x8f, 0xdc, 0x2e, 0xf7, 0x3d, 0xb9, 0xb0, 0x67, 0x96, 0x8c, 0x15, 0x6b, 0x7d, 0xb9,
-	0x31, 0xc6, 0xb7, 0x46, 0xf3, 0x6d, 0xdf, 0x8b, 0x02, 0x16, 0x3c, 0x8a, 0xfc, 0x03, 0x1e, 0x53,
-	0x32, 0xd2, 0xdf, 0xd6, 0xea, 0xce, 0xcf, 0x06, 0x98, 0xcd, 0xa0, 0xc3, 0xc8, 0x7b, 0x50, 0x4a,
-	0xf8, 0x20, 0xf6, 0x19, 0x3a, 0x69, 0xad, 0x2f, 0x8e, 0xb5, 0x28, 0xe3, 0xa1, 0x5a, 0x90, 0x10,
-	0x30, 0x0f, 0xc3, 0x28, 0xb0, 0x0b, 0x18, 0x15, 0xae, 0x89, 0x0d, 0xb3, 0x3c, 0x0e, 0xc2, 0xc8,
-	0xeb, 0xda, 0xc5, 0x25, 0x63, 0xa5, 0x48, 0x53, 0x52, 0x1e, 0x20, 0xbc, 0xb8, 0xc3, 0x84, 0x76,
-	0xf9, 0xac, 0x03, 0x94, 0x60, 0x06, 0x90, 0x39, 0x0d, 0x40, 0xce, 0x8f, 0x06, 0x54, 0x64, 0x28,
-	0x9f, 0xc4, 0x7c, 0xd0, 0xcf, 0x9c, 0x33, 0x72, 0xce, 0x7d, 0x08, 0x26, 0x0b, 0x3a, 0x4c, 0x03,
-	0x3e, 0x01, 0xb3, 0xd4, 0x02, 0xae, 0x28, 0x2a, 0xd4, 0xdb, 0x23, 0x90, 0x74, 0x0c, 0xc6, 0xb4,
-	0x31, 0xe4, 0x00, 0x29, 0x9c, 0x00, 0xc4, 0xf9, 0xc5, 0x80, 0xea, 0x4b, 0xaf, 0xc3, 0x02, 0x69,
-	0xba, 0xcd, 0xc4, 0x45, 0x52, 0x70, 0x0f, 0x8a, 0x1d, 0xe9, 0xac, 0x0e, 0xe9, 0xfa, 0xd9, 0x21,
-	0x51, 0x25, 0x4c, 0x96, 0xc1, 0x12, 0x5c, 0x78, 0x5d, 0x57, 0x06, 0x97, 0x60, 0x3e, 0x8a, 0x8f,
-	0x0b, 0xb6, 0x41, 0x01, 0xd9, 0x52, 0x3e, 0x21, 0x0f, 0x00, 0xfa, 0x5e, 0x87, 0xb9, 0x61, 0x14,
-	0xb0, 0xb7, 0x3a, 0x05, 0xe3, 0xed, 0xcb, 0x20, 0xb6, 0xa4, 0x14, 0xad, 0xf4, 0xd3, 0xa5, 0xb3,
-	0x07, 0x95, 0x8c, 0x4f, 0xae, 0x41, 0x45, 0x1e, 0xe5, 0xe6, 0x32, 0x52, 0x96, 0x8c, 0xe7, 0x32,
-	0x2b, 0xff, 0x01, 0xc0, 0x4d, 0x9f, 0x0f, 0x22, 0xa1, 0x41, 0x42, 0xf1, 0x0d, 0xc9, 0x20, 0x8b,
-	0x50, 0x46, 0x3f, 0x0e, 0xd9, 0x10, 0x3d, 0xad, 0xd0, 0x59, 0x49, 0x3f, 0x67, 0x43, 0xe7, 0x2b,
-	0x03, 0xca, 0xd2, 0x59, 0x79, 0xd0, 0x09, 0x39, 0xe3, 0x84, 0x1c, 0x59, 0x86, 0x39, 0x85, 0x97,
-	0xab, 0xef, 0xa1, 0xaa, 0xd8, 0xaa, 0x62, 0xee, 0xaa, 0xdb, 0xf8, 0x10, 0x2c, 0x84, 0xc3, 0x55,
-	0x80, 0xaa, 0x22, 0x3d, 0x0f, 0x50, 0xf4, 0x3c, 0xc1, 0xb5, 0xb3, 0x0b, 0x73, 0x4f, 0xc2, 0x2e,
-	0xdb, 0x0c, 0x63, 0xe6, 0x0b, 0x1e, 0x0f, 0x89, 0x03, 0xd5, 0x64, 0xb0, 0x17, 0xa4, 0xb4, 0x6d,
-	0x2c, 0xcd, 0xe0, 0xa9, 0x39, 0x1e, 0xb9, 0x01, 0xd6, 0x7e, 0xd8, 0xcd, 0x39, 0x26, 0x45, 0x40,
-	0xb2, 0x94, 0x5b, 0xce, 0x17, 0x06, 0x58, 0x1b, 0x3c, 0xee, 0x0f, 0x12, 0xca, 0xb9, 0x48, 0xc8,
-	0x43, 0x28, 0xf9, 0x48, 0xa2, 0x39, 0x6b, 0xfd, 0xd6, 0x58, 0x0f, 0x73, 0x1a, 0xe9, 0x5a, 0xab,
-	0xd5, 0xef, 0x41, 0x49, 0x71, 0x64, 0x5f, 0xca, 0x4c, 0x61, 0x5f, 0x52, 0x94, 0xbc, 0x3a, 0x31,
-	0xe7, 0xa9, 0x33, 0xb8, 0x76, 0x5a, 0x60, 0xca, 0xe0, 0x26, 0xf6, 0x32, 0x02, 0xa6, 0x60, 0x6f,
-	0x15, 0xb2, 0x55, 0x8a, 0x6b, 0x52, 0x87, 0x32, 0x8b, 0x7c, 0x1e, 0x84, 0x51, 0x47, 0x67, 0x2e,
-	0xa3, 0x9d, 0x1f, 0x0c, 0xa8, 0x50, 0xef, 0x58, 0x75, 0xa6, 0x89, 0x56, 0x6f, 0x42, 0x35, 0x11,
-	0x5e, 0x2c, 0x5c, 0xbe, 0xbf, 0x9f, 0xb0, 0xb4, 0x38, 0x2c, 0xe4, 0xed, 0x20, 0x0b, 0xab, 0x27,
-	0x0a, 0x52, 0x81, 0x19, 0x5d, 0x3d, 0x51, 0xa0, 0xb7, 0x65, 0xea, 0xa3, 0xb0, 0xdf, 0x67, 0xc2,
-	0x45, 0x2d, 0xdb, 0x44, 0x89, 0xaa, 0x66, 0xb6, 0x25, 0x4f, 0x26, 0x21, 0x15, 0x62, 0x51, 0xa0,
-	0x1b, 0x17, 0x68, 0x56, 0x33, 0x0a, 0xe4, 0x55, 0x9d, 0x3f, 0xd9, 0x4c, 0xcf, 0x02, 0xe2, 0x54,
-	0x53, 0x4c, 0xc1, 0x31, 0x15, 0x0f, 0xc1, 0xb9, 0x03, 0x66, 0xd2, 0xf7, 0x22, 0x3c, 0x6c, 0x42,
-	0x6f, 0x6b, 0xf7, 0xbd, 0x88, 0xa2, 0x94, 0xec, 0x22, 0xda, 0x1d, 0xbb, 0xa4, 0x6a, 0x5b, 0x93,
-	0xe4, 0x3e, 0x54, 0xb3, 0x00, 0xa5, 0xbd, 0xd9, 0x73, 0xec, 0xa5, 0x91, 0x4a, 0xe2, 0x99, 0x59,
-	0x9e, 0xa9, 0x99, 0xce, 0x97, 0xb3, 0xb0, 0x80, 0x95, 0xcb, 0x7c, 0x1e, 0xe3, 0xb3, 0x90, 0x90,
-	0x55, 0x30, 0x65, 0x11, 0x9e, 0xd9, 0x89, 0xa4, 0x0e, 0x45, 0x31, 0xb2, 0x03, 0x10, 0x64, 0xda,
-	0xba, 0x19, 0xad, 0x4d, 0x54, 0xca, 0x1d, 0xd4, 0x18, 0xad, 0x69, 0xce, 0x44, 0xae, 0xd3, 0xaa,
-	0xce, 0x33, 0x45, 0xa7, 0xa5, 0x40, 0xd4, 0xca, 0x1d, 0xbd, 0x73, 0xb2, 0xb9, 0xcd, 0x4c, 0xfb,
-	0x3e, 0x5e, 0x52, 0xea, 0x9b, 0x23, 0x6d, 0xf2, 0x0a, 0x16, 0xb4, 0x4d, 0x7e, 0xc4, 0xe2, 0x38,
-	0x0c, 0x98, 0x5d, 0x44, 0x83, 0xab, 0x53, 0x05, 0xb7, 0xa3, 0x95, 0xe8, 0xbc, 0xb2, 0x92, 0xd2,
-	0xe4, 0x63, 0x80, 0x20, 0xf4, 0x3a, 0x11, 0x4f, 0x44, 0xe8, 0xdb, 0xa5, 0x31, 0xcd, 0x55, 0xe7,
-	0x6c, 0x33, 0x93, 0xa2, 0x39, 0x8d, 0xfa, 0xb7, 0x06, 0xc0, 0xe8, 0x20, 0xf2, 0x01, 0x94, 0x3c,
-	0x8c, 0x41, 0xe7, 0x6b, 0x7c, 0xdb, 0xca, 0xee, 0x1b, 0xd5, 0xd2, 0x63, 0x8b, 0xf5, 0x6a, 0x86,
-	0x7c, 0x51, 0x17, 0xb6, 0x82, 0xf7, 0x36, 0x5c, 0x3a, 0x05, 0xaf, 0xae, 0xe8, 0xda, 0xbb, 0xc0,
-	0xd5, 0x7f, 0x2a, 0x40, 0x39, 0x0b, 0xf6, 0x3a, 0x80, 0x46, 0x4f, 0x76, 0x02, 0x75, 0x5d, 0x72,
-	0x9c, 0xdc, 0x7e, 0xc0, 0x22, 0xed, 0x4b, 0x8e, 0x43, 0xee, 0xc2, 0xbf, 0x47, 0x54, 0xfe, 0x74,
-	0xe5, 0xe0, 0x95, 0xd1, 0xe6, 0xc8, 0x03, 0xf2, 0x44, 0x87, 0x26, 0x3b, 0xc2, 0xfc, 0xfa, 0xfa,
-	0x5f, 0x4a, 0x57, 0x43, 0xbe, 0x4b, 0x1a, 0x8e, 0x26, 0xcc, 0xf5, 0xbc, 0xf8, 0x90, 0x05, 0xae,
-	0x7e, 0x9b, 0x4d, 0x44, 0x78, 0x69, 0x5c, 0xb2, 0x5e, 0xa0, 0x60, 0x1b, 0xe5, 0x68, 0xb5, 0x97,
-	0xa3, 0x1c, 0x07, 0x4c, 0x7c, 0xec, 0xe6, 0xa0, 0xb2, 0xf3, 0xaa, 0x49, 0xe9, 0xd6, 0x66, 0xb3,
-	0x5d, 0xfb, 0x17, 0xb1, 0x60, 0xb6, 0xf9, 0x66, 0xb7, 0xd9, 0xda, 0x6c, 0xd7, 0x0c, 0xe7, 0x8f,
-	0x0a, 0x5c, 0xc1, 0x81, 0x60, 0x23, 0xe6, 0x49, 0x42, 0xd9, 0x3e, 0x8b, 0x59, 0xe4, 0xb3, 0x44,
-	0xf6, 0xb8, 0x1e, 0x8b, 0x3b, 0xcc, 0x3d, 0x0e, 0xc5, 0x81, 0x3d, 0x8b, 0x6d, 0xb9, 0x82, 0x9c,
-	0xd7, 0xa1, 0x38, 0x38, 0xfd, 0xbc, 0x19, 0x63, 0x9e, 0xb7, 0x8f, 0xc0, 0xd2, 0x42, 0x11, 0x0f,
-	0x98, 0x5d, 0x3e, 0x6f, 0xc2, 0x00, 0x25, 0x8d, 0x03, 0x6c, 0xf3, 0xe4, 0x94, 0xb1, 0x36, 0x71,
-	0x0a, 0x78, 0xd7, 0xf3, 0xc6, 0x89, 0xb1, 0x83, 0x9e, 0x98, 0x28, 0xd4, 0xc5, 0xbc, 0x3b, 0xbd,
-	0xad, 0x71, 0x63, 0x06, 0x59, 0x85, 0x9a, 0x1a, 0x65, 0xe2, 0x4c, 0x50, 0xb5, 0x78, 0x9c, 0x67,
-	0x16, 0x70, 0x2f, 0x87, 0xe4, 0x75, 0x80, 0x30, 0xf2, 0x79, 0xaf, 0xdf, 0x65, 0x82, 0x61, 0xfd,
-	0x94, 0x69, 0x8e, 0x73, 0x3a, 0xdb, 0xa5, 0x8b, 0x64, 0xbb, 0xfe, 0x0a, 0x2c, 0xca, 0xba, 0x9e,
-	0x60, 0x01, 0xe2, 0xb7, 0x0a, 0x26, 0x82, 0x7e, 0xee, 0x58, 0x87, 0x62, 0x93, 0x47, 0xc6, 0xfa,
-	0x6f, 0x06, 0x94, 0x36, 0xbc, 0x6e, 0x97, 0xc5, 0xe4, 0x3e, 0x94, 0x7c, 0x5c, 0x69, 0xab, 0x53,
-	0x75, 0x38, 0xad, 0x42, 0x6e, 0xc1, 0x42, 0xc2, 0x7a, 0x5e, 0x24, 0x42, 0xdf, 0xd5, 0x56, 0xd4,
-	0xb5, 0x9b, 0x4f, 0xd9, 0xfa, 0x94, 0x53, 0x78, 0xcc, 0x5c, 0x04, 0x0f, 0xf2, 0x10, 0xca, 0xf2,
-	0x98, 0x24, 0x14, 0x4c, 0xf7, 0xf3, 0xa9, 0xdc, 0xcd, 0x94, 0xea, 0xbf, 0x1b, 0x50, 0x9c, 0x3c,
-	0xd7, 0xdf, 0xcf, 0xda, 0x5f, 0x61, 0x7a, 0xe3, 0x69, 0x0f, 0x7c, 0x03, 0xd5, 0x58, 0xe5, 0x4a,
-	0xdd, 0x0c, 0x55, 0x97, 0xef, 0x4f, 0x5f, 0x97, 0xb9, 0x4c, 0x53, 0x2b, 0xce, 0xa5, 0xfd, 0x69,
-	0x96, 0x22, 0x15, 0xf3, 0xff, 0xa7, 0xb7, 0xa9, 0xe0, 0x4f, 0xf3, 0x55, 0xff, 0xda, 0x00, 0xf3,
-	0x1f, 0x19, 0x72, 0xb3, 0x9b, 0xac, 0xf2, 0x78, 0xc1, 0x9b, 0x5c, 0x7f, 0x99, 0x1f, 0xee, 0xc7,
-	0x65, 0xe4, 0x0a, 0x14, 0xf3, 0xe3, 0xbc, 0x22, 0xce, 0x18, 0xe5, 0x9f, 0x99, 0x65, 0xa8, 0x59,
-	0xce, 0xf7, 0x05, 0x28, 0x6f, 0x72, 0x7f, 0xd0, 0x63, 0x91, 0x98, 0x38, 0x61, 0x9d, 0xaa, 0xc9,
-	0xc2, 0x85, 0x6a, 0x72, 0x11, 0xca, 0xb1, 0x77, 0xec, 0xe2, 0x60, 0xa6, 0x9d, 0x89, 0xbd, 0xe3,
-	0x5d, 0x3d, 0x9b, 0x75, 0xc3, 0xe8, 0xf0, 0xac, 0xef, 0xce, 0xed, 0x30, 0x3a, 0xa4, 0x28, 0x25,
-	0x87, 0x54, 0xff, 0x20, 0xec, 0x06, 0x29, 0xee, 0x45, 0xec, 0xcf, 0x16, 0xf2, 0x34, 0xec, 0x69,
-	0x03, 0x28, 0x9d, 0x37, 0xcb, 0xa8, 0x06, 0xb0, 0x0c, 0x73, 0x81, 0x46, 0x81, 0x05, 0xee, 0xde,
-	0x10, 0x87, 0xba, 0x0a, 0xad, 0x8e, 0x98, 0x8f, 0x87, 0xce, 0xaf, 0x06, 0x2c, 0x6c, 0x05, 0x2c,
-	0x12, 0xe1, 0x7e, 0xc8, 0xe2, 0x17, 0x9e, 0xf0, 0x0f, 0xc8, 0x7f, 0x61, 0xfe, 0xf3, 0x81, 0xd7,
-	0x95, 0x9c, 0xc0, 0x8d, 0xbc, 0x1e, 0xd3, 0xd0, 0xcd, 0x65, 0xdc, 0x96, 0xd7, 0x63, 0xf2, 0x73,
-	0x6c, 0xcf, 0x4b, 0x98, 0x92, 0x50, 0x65, 0x52, 0x96, 0x0c, 0xdc, 0x7c, 0xa0, 0x7d, 0x55, 0xf7,
-	0xe0, 0x7f, 0x63, 0x7d, 0x7d, 0xe7, 0xdc, 0x9c, 0xef, 0xf5, 0xcf, 0xce, 0xf9, 0xd3, 0xe3, 0x1a,
-	0x54, 0xa4, 0x9c, 0x9b, 0x9b, 0x3b, 0xca, 0x92, 0x81, 0xaf, 0xe3, 0x4d, 0xa8, 0xe2, 0x66, 0x32,
-	0xd8, 0xcb, 0x1e, 0xef, 0x0a, 0xb5, 0x24, 0xaf, 0xad, 0x58, 0xce, 0x37, 0xf2, 0xc3, 0x41, 0x5e,
-	0xb2, 0xf0, 0x88, 0x25, 0xb2, 0x55, 0x2a, 0xbb, 0x89, 0xfe, 0xba, 0x4a, 0x49, 0xf9, 0xad, 0x2f,
-	0x86, 0x7d, 0x15, 0xde, 0xfc, 0x84, 0x8e, 0x90, 0xd9, 0x69, 0xec, 0x0e, 0xfb, 0x8c, 0xa2, 0x82,
-	0xd3, 0x00, 0x53, 0x52, 0xf2, 0x69, 0xfe, 0xb4, 0xf5, 0xbc, 0xb5, 0xf3, 0xba, 0xa5, 0xde, 0xe9,
-	0x97, 0x8f, 0x68, 0xb3, 0xb5, 0xdb, 0xae, 0x19, 0xa4, 0x0a, 0xe5, 0x8d, 0xa7, 0x5b, 0xdb, 0x9b,
-	0xb4, 0xd9, 0xaa, 0x15, 0xe4, 0x47, 0x68, 0x45, 0x5e, 0xd7, 0x4e, 0xec, 0xf5, 0x0f, 0xfe, 0xa6,
-	0x43, 0x99, 0x9d, 0xbc, 0x43, 0xb7, 0xc7, 0x39, 0x04, 0x50, 0xda, 0x78, 0xb4, 0xbd, 0xdd, 0xa4,
-	0x35, 0x23, 0x5b, 0x37, 0x6b, 0x85, 0xc7, 0x37, 0xe1, 0x86, 0xcf, 0x7b, 0x8d, 0x0e, 0xe7, 0x9d,
-	0x2e, 0x6b, 0x04, 0xec, 0x48, 0x70, 0xde, 0x4d, 0xf2, 0x87, 0xed, 0x95, 0xf0, 0xe7, 0xee, 0x9f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x25, 0x4c, 0xf4, 0xea, 0x12, 0x00, 0x00,
+func init() { proto.RegisterFile("kythe/proto/serving.proto", fileDescriptor_serving_d7da04bce0b5d752) }
+
+var fileDescriptor_serving_d7da04bce0b5d752 = []byte{
+	// 1747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0x1b, 0x4b,
+	0x11, 0x67, 0xa5, 0x95, 0xbc, 0xea, 0x95, 0x6d, 0xbd, 0x79, 0xe1, 0xd5, 0x5a, 0xaf, 0x48, 0x9c,
+	0x4d, 0x51, 0x31, 0x15, 0x2c, 0x83, 0x93, 0x40, 0x41, 0x2a, 0xa4, 0x12, 0x5b, 0x26, 0x8e, 0x1d,
+	0xd9, 0x35, 0x32, 0x49, 0x4e, 0x6c, 0xad, 0x77, 0x47, 0xd2, 0x96, 0xa5, 0x1d, 0x31, 0x3b, 0xb2,
+	0xa3, 0x3b, 0x70, 0xa1, 0xe0, 0x1b, 0x70, 0xe1, 0x46, 0x51, 0x5c, 0x28, 0x3e, 0x04, 0xdf, 0x81,
+	0xaf, 0x41, 0x71, 0xa2, 0x0a, 0x6a, 0xfe, 0xec, 0x6a, 0x15, 0x4b, 0x96, 0xf0, 0xcb, 0x49, 0xd3,
+	0x3d, 0xdd, 0x3d, 0xdd, 0xbf, 0xee, 0xe9, 0xe9, 0x15, 0x6c, 0x5c, 0x8c, 0x79, 0x8f, 0xec, 0x0c,
+	0x19, 0xe5, 0x74, 0x27, 0x21, 0xec, 0x32, 0x8a, 0xbb, 0x0d, 0x49, 0xa1, 0x2f, 0xe5, 0x96, 0x22,
+	0x1a, 0x7a, 0xab, 0xee, 0xe4, 0xe5, 0x03, 0x3a, 0x18, 0xd0, 0x58, 0x49, 0xb8, 0x7f, 0x32, 0xc0,
+	0x6c, 0xd1, 0x90, 0xa0, 0xaf, 0xa0, 0xcc, 0xa3, 0xe0, 0x82, 0x70, 0xc7, 0xd8, 0x34, 0xb6, 0x2a,
+	0x58, 0x53, 0xe8, 0xfb, 0x60, 0x76, 0xfc, 0x80, 0x3b, 0x85, 0xcd, 0xe2, 0x96, 0xbd, 0xeb, 0x34,
+	0xf2, 0xe6, 0xb5, 0xa5, 0x03, 0x3f, 0xe0, 0x58, 0x4a, 0xa1, 0x33, 0xf8, 0x32, 0x24, 0x9d, 0x28,
+	0x8e, 0x78, 0x44, 0x63, 0xaf, 0x4f, 0x03, 0x5f, 0x2c, 0x9c, 0xe2, 0xa6, 0xb1, 0x65, 0xef, 0x3e,
+	0x68, 0xcc, 0xf0, 0xad, 0xd1, 0xfc, 0x38, 0xf4, 0xe3, 0x90, 0x84, 0x2f, 0xe3, 0xa0, 0x47, 0x19,
+	0x46, 0x13, 0xfd, 0x63, 0xad, 0xee, 0xfe, 0xc3, 0x00, 0xb3, 0x19, 0x76, 0x09, 0xfa, 0x21, 0x94,
+	0x13, 0x3a, 0x62, 0x01, 0x91, 0x4e, 0xda, 0xbb, 0x1b, 0x33, 0x2d, 0x8a, 0x78, 0xb0, 0x16, 0x44,
+	0x08, 0xcc, 0x8b, 0x28, 0x0e, 0x9d, 0x82, 0x8c, 0x4a, 0xae, 0x91, 0x03, 0x2b, 0x94, 0x85, 0x51,
+	0xec, 0xf7, 0x9d, 0xd2, 0xa6, 0xb1, 0x55, 0xc2, 0x29, 0x29, 0x0e, 0xe0, 0x3e, 0xeb, 0x12, 0xee,
+	0x14, 0x17, 0x1e, 0xa0, 0x04, 0x33, 0x80, 0xcc, 0x65, 0x00, 0x72, 0xff, 0x6a, 0x40, 0x45, 0x84,
+	0xf2, 0x73, 0x46, 0x47, 0xc3, 0xcc, 0x39, 0x23, 0xe7, 0xdc, 0x8f, 0xc1, 0x24, 0x61, 0x97, 0x68,
+	0xc0, 0xe7, 0x60, 0x96, 0x5a, 0x90, 0x2b, 0x2c, 0x15, 0xea, 0xed, 0x09, 0x48, 0x3a, 0x06, 0x63,
+	0xd9, 0x18, 0x72, 0x80, 0x14, 0xa6, 0x00, 0x71, 0xff, 0x69, 0x40, 0xf5, 0xd4, 0xef, 0x92, 0x50,
+	0x98, 0x6e, 0x13, 0x7e, 0x9b, 0x14, 0x3c, 0x81, 0x52, 0x57, 0x38, 0xab, 0x43, 0xba, 0x7b, 0x73,
+	0x48, 0x58, 0x09, 0xa3, 0x07, 0x60, 0x73, 0xca, 0xfd, 0xbe, 0x27, 0x82, 0x4b, 0x64, 0x3e, 0x4a,
+	0xaf, 0x0a, 0x8e, 0x81, 0x41, 0xb2, 0x85, 0x7c, 0x82, 0x9e, 0x03, 0x0c, 0xfd, 0x2e, 0xf1, 0xa2,
+	0x38, 0x24, 0x1f, 0x1d, 0xf3, 0x06, 0xfb, 0x22, 0x88, 0x43, 0x21, 0x85, 0x2b, 0xc3, 0x74, 0xe9,
+	0x9e, 0x43, 0x25, 0xe3, 0xa3, 0xaf, 0xa1, 0x22, 0x8e, 0xf2, 0x72, 0x19, 0xb1, 0x04, 0xe3, 0x48,
+	0x64, 0xe5, 0x3b, 0x00, 0x72, 0x33, 0xa0, 0xa3, 0x98, 0x6b, 0x90, 0xa4, 0xf8, 0x9e, 0x60, 0xa0,
+	0x0d, 0xb0, 0xa4, 0x1f, 0x17, 0x64, 0x2c, 0x3d, 0xad, 0xe0, 0x15, 0x41, 0x1f, 0x91, 0xb1, 0xfb,
+	0x3b, 0x03, 0x2c, 0xe1, 0xac, 0x38, 0x68, 0x4a, 0xce, 0x98, 0x92, 0x43, 0x0f, 0x60, 0x55, 0xe1,
+	0xe5, 0xe9, 0x7b, 0xa8, 0x2a, 0xb6, 0xaa, 0x98, 0x67, 0x92, 0x87, 0x5e, 0x80, 0x2d, 0xe1, 0xf0,
+	0x14, 0xa0, 0xaa, 0x48, 0x17, 0x01, 0x2a, 0x3d, 0x4f, 0xe4, 0xda, 0x3d, 0x83, 0xd5, 0x83, 0xa8,
+	0x4f, 0xf6, 0x23, 0x46, 0x02, 0x4e, 0xd9, 0x18, 0xb9, 0x50, 0x4d, 0x46, 0xe7, 0x61, 0x4a, 0x3b,
+	0xc6, 0x66, 0x51, 0x9e, 0x9a, 0xe3, 0xa1, 0x7b, 0x60, 0x77, 0xa2, 0x7e, 0xce, 0x31, 0x21, 0x02,
+	0x82, 0xa5, 0xdc, 0x72, 0x7f, 0x6d, 0x80, 0xbd, 0x47, 0xd9, 0x70, 0x94, 0x60, 0x4a, 0x79, 0x82,
+	0x5e, 0x40, 0x39, 0x90, 0xa4, 0x34, 0x67, 0xef, 0x3e, 0x9c, 0xe9, 0x61, 0x4e, 0x23, 0x5d, 0x6b,
+	0xb5, 0xfa, 0x13, 0x28, 0x2b, 0x8e, 0xe8, 0x4b, 0x99, 0x29, 0xd9, 0x97, 0x14, 0x25, 0xae, 0x0e,
+	0xa3, 0x34, 0x75, 0x46, 0xae, 0xdd, 0x16, 0x98, 0x22, 0xb8, 0xb9, 0xbd, 0x0c, 0x81, 0xc9, 0xc9,
+	0x47, 0x85, 0x6c, 0x15, 0xcb, 0x35, 0xaa, 0x83, 0x45, 0xe2, 0x80, 0x86, 0x51, 0xdc, 0xd5, 0x99,
+	0xcb, 0x68, 0xf7, 0x2f, 0x06, 0x54, 0xb0, 0x7f, 0xa5, 0x3a, 0xd3, 0x5c, 0xab, 0xf7, 0xa1, 0x9a,
+	0x70, 0x9f, 0x71, 0x8f, 0x76, 0x3a, 0x09, 0x49, 0x8b, 0xc3, 0x96, 0xbc, 0x13, 0xc9, 0x92, 0xd5,
+	0x13, 0x87, 0xa9, 0x40, 0x51, 0x57, 0x4f, 0x1c, 0xea, 0x6d, 0x91, 0xfa, 0x38, 0x1a, 0x0e, 0x09,
+	0xf7, 0xa4, 0x96, 0x63, 0x4a, 0x89, 0xaa, 0x66, 0xb6, 0x05, 0x4f, 0x24, 0x21, 0x15, 0x22, 0x71,
+	0xa8, 0x1b, 0x17, 0x68, 0x56, 0x33, 0x0e, 0xc5, 0x55, 0x5d, 0x9b, 0x6e, 0xa6, 0x37, 0x01, 0x71,
+	0xad, 0x29, 0xa6, 0xe0, 0x98, 0x8a, 0x27, 0xd6, 0xa2, 0xb7, 0x25, 0x43, 0x3f, 0x96, 0x87, 0xcd,
+	0xe9, 0x6d, 0xed, 0xa1, 0x1f, 0x63, 0x29, 0x25, 0xba, 0x88, 0x76, 0xc7, 0x29, 0xab, 0xda, 0xd6,
+	0x24, 0x7a, 0x06, 0xd5, 0x2c, 0x40, 0x61, 0x6f, 0x65, 0x81, 0xbd, 0x34, 0x52, 0x41, 0xbc, 0x31,
+	0xad, 0x62, 0xcd, 0x74, 0x7f, 0xb3, 0x02, 0xeb, 0xb2, 0x72, 0x49, 0x40, 0x99, 0x7c, 0x16, 0x12,
+	0xb4, 0x0d, 0xa6, 0x28, 0xc2, 0x1b, 0x3b, 0x91, 0xd0, 0xc1, 0x52, 0x0c, 0x9d, 0x00, 0x84, 0x99,
+	0xb6, 0x6e, 0x46, 0x3b, 0x73, 0x95, 0x72, 0x07, 0x35, 0x26, 0x6b, 0x9c, 0x33, 0x91, 0xeb, 0xb4,
+	0xaa, 0xf3, 0x2c, 0xd1, 0x69, 0x31, 0x20, 0xb5, 0xf2, 0x26, 0xef, 0x9c, 0x68, 0x6e, 0xc5, 0x65,
+	0xdf, 0xc7, 0x2f, 0x94, 0xfa, 0xfe, 0x44, 0x1b, 0xbd, 0x83, 0x75, 0x6d, 0x93, 0x5e, 0x12, 0xc6,
+	0xa2, 0x90, 0x38, 0x25, 0x69, 0x70, 0x7b, 0xa9, 0xe0, 0x4e, 0xb4, 0x12, 0x5e, 0x53, 0x56, 0x52,
+	0x1a, 0xfd, 0x0c, 0x20, 0x8c, 0xfc, 0x6e, 0x4c, 0x13, 0x1e, 0x05, 0x4e, 0x79, 0x46, 0x73, 0xd5,
+	0x39, 0xdb, 0xcf, 0xa4, 0x70, 0x4e, 0xa3, 0xfe, 0x47, 0x03, 0x60, 0x72, 0x10, 0xfa, 0x11, 0x94,
+	0x7d, 0x19, 0x83, 0xce, 0xd7, 0xec, 0xb6, 0x95, 0xdd, 0x37, 0xac, 0xa5, 0x67, 0x16, 0xeb, 0x57,
+	0x19, 0xf2, 0x25, 0x5d, 0xd8, 0x92, 0x42, 0x8f, 0xe0, 0x8b, 0x6b, 0xf0, 0xea, 0x8a, 0xae, 0x7d,
+	0x0a, 0x5c, 0xfd, 0x6f, 0x05, 0xb0, 0xb2, 0x60, 0xef, 0x02, 0x68, 0xf4, 0x44, 0x27, 0x50, 0xd7,
+	0x25, 0xc7, 0xc9, 0xed, 0x87, 0x24, 0xd6, 0xbe, 0xe4, 0x38, 0xe8, 0x31, 0x7c, 0x7b, 0x42, 0xe5,
+	0x4f, 0x57, 0x0e, 0xde, 0x99, 0x6c, 0x4e, 0x3c, 0x40, 0x07, 0x3a, 0x34, 0xd1, 0x11, 0xd6, 0x76,
+	0x77, 0xff, 0xaf, 0x74, 0x35, 0xc4, 0xbb, 0xa4, 0xe1, 0x68, 0xc2, 0xea, 0xc0, 0x67, 0x17, 0x24,
+	0xf4, 0xf4, 0xdb, 0x6c, 0x4a, 0x84, 0x37, 0x67, 0x25, 0xeb, 0xad, 0x14, 0x6c, 0x4b, 0x39, 0x5c,
+	0x1d, 0xe4, 0x28, 0xd7, 0x05, 0x53, 0x3e, 0x76, 0xab, 0x50, 0x39, 0x79, 0xd7, 0xc4, 0xf8, 0x70,
+	0xbf, 0xd9, 0xae, 0x7d, 0x0b, 0xd9, 0xb0, 0xd2, 0xfc, 0x70, 0xd6, 0x6c, 0xed, 0xb7, 0x6b, 0x86,
+	0xfb, 0xdf, 0x0a, 0xdc, 0x91, 0x03, 0xc1, 0x1e, 0xa3, 0x49, 0x82, 0x49, 0x87, 0x30, 0x12, 0x07,
+	0x24, 0x11, 0x3d, 0x6e, 0x40, 0x58, 0x97, 0x78, 0x57, 0x11, 0xef, 0x39, 0x2b, 0xb2, 0x2d, 0x57,
+	0x24, 0xe7, 0x7d, 0xc4, 0x7b, 0xd7, 0x9f, 0x37, 0x63, 0xc6, 0xf3, 0xf6, 0x53, 0xb0, 0xb5, 0x50,
+	0x4c, 0x43, 0xe2, 0x58, 0x8b, 0x26, 0x0c, 0x50, 0xd2, 0x62, 0x8d, 0x9a, 0xd3, 0x53, 0xc6, 0xce,
+	0xdc, 0x29, 0xe0, 0x53, 0xcf, 0x1b, 0x53, 0x63, 0x07, 0x9e, 0x9a, 0x28, 0xd4, 0xc5, 0x7c, 0xbc,
+	0xbc, 0xad, 0x59, 0x63, 0x06, 0xda, 0x86, 0x9a, 0x1a, 0x65, 0x58, 0x26, 0xe8, 0x98, 0xd9, 0x3c,
+	0xb3, 0x2e, 0xf7, 0x72, 0x48, 0xde, 0x05, 0x88, 0xe2, 0x80, 0x0e, 0x86, 0x7d, 0xc2, 0x89, 0xac,
+	0x1f, 0x0b, 0xe7, 0x38, 0xd7, 0xb3, 0x5d, 0xbe, 0x4d, 0xb6, 0xeb, 0xef, 0xc0, 0xc6, 0xa4, 0xef,
+	0x73, 0x12, 0x4a, 0xfc, 0xb6, 0xc1, 0x94, 0xa0, 0x2f, 0x1c, 0xeb, 0xa4, 0xd8, 0xfc, 0x91, 0xb1,
+	0xfe, 0x6f, 0x03, 0xca, 0x7b, 0x7e, 0xbf, 0x4f, 0x18, 0x7a, 0x06, 0xe5, 0x40, 0xae, 0xb4, 0xd5,
+	0xa5, 0x3a, 0x9c, 0x56, 0x41, 0x0f, 0x61, 0x3d, 0x21, 0x03, 0x3f, 0xe6, 0x51, 0xe0, 0x69, 0x2b,
+	0xea, 0xda, 0xad, 0xa5, 0x6c, 0x7d, 0xca, 0x35, 0x3c, 0x8a, 0xb7, 0xc1, 0x03, 0xbd, 0x00, 0x4b,
+	0x1c, 0x93, 0x44, 0x9c, 0xe8, 0x7e, 0xbe, 0x94, 0xbb, 0x99, 0x52, 0xfd, 0x3f, 0x06, 0x94, 0xe6,
+	0xcf, 0xf5, 0xcf, 0xb2, 0xf6, 0x57, 0x58, 0xde, 0xb8, 0x56, 0x41, 0x1f, 0xa0, 0xca, 0x54, 0xae,
+	0xd4, 0xcd, 0x50, 0x75, 0xf9, 0x74, 0xf9, 0xba, 0xcc, 0x65, 0x1a, 0xdb, 0x6c, 0x42, 0xa0, 0xd7,
+	0x59, 0x8a, 0x54, 0xcc, 0x3f, 0x58, 0xde, 0xa6, 0x82, 0x3f, 0xcd, 0x57, 0xfd, 0xf7, 0x06, 0x98,
+	0x9f, 0x65, 0xc8, 0xcd, 0x6e, 0xb2, 0xca, 0xe3, 0x2d, 0x6f, 0x72, 0xfd, 0x34, 0x3f, 0xdc, 0xcf,
+	0xca, 0xc8, 0x1d, 0x28, 0xe5, 0xc7, 0x79, 0x45, 0xdc, 0x30, 0xca, 0xbf, 0x31, 0x2d, 0xa8, 0xd9,
+	0xee, 0x9f, 0x0b, 0x60, 0xed, 0xd3, 0x60, 0x34, 0x20, 0x31, 0x9f, 0x3b, 0x61, 0x5d, 0xab, 0xc9,
+	0xc2, 0xad, 0x6a, 0x72, 0x03, 0x2c, 0xe6, 0x5f, 0x79, 0x72, 0x30, 0xd3, 0xce, 0x30, 0xff, 0xea,
+	0x4c, 0xcf, 0x66, 0xfd, 0x28, 0xbe, 0xb8, 0xe9, 0xbb, 0xf3, 0x38, 0x8a, 0x2f, 0xb0, 0x94, 0x12,
+	0x43, 0x6a, 0xd0, 0x8b, 0xfa, 0x61, 0x8a, 0x7b, 0x49, 0xf6, 0x67, 0x5b, 0xf2, 0x34, 0xec, 0x69,
+	0x03, 0x28, 0x2f, 0x9a, 0x65, 0xa4, 0x98, 0x48, 0x65, 0xa8, 0x51, 0x20, 0xa1, 0x77, 0x3e, 0x96,
+	0x43, 0x5d, 0x05, 0x57, 0x27, 0xcc, 0x57, 0x63, 0xf7, 0x5f, 0x06, 0xac, 0x1f, 0x86, 0x24, 0xe6,
+	0x51, 0x27, 0x22, 0xec, 0xad, 0xcf, 0x83, 0x1e, 0xfa, 0x2e, 0xac, 0xfd, 0x6a, 0xe4, 0xf7, 0x05,
+	0x27, 0xf4, 0x62, 0x7f, 0x40, 0x34, 0x74, 0xab, 0x19, 0xb7, 0xe5, 0x0f, 0x88, 0xf8, 0x1c, 0x3b,
+	0xf7, 0x13, 0xa2, 0x24, 0x54, 0x99, 0x58, 0x82, 0x21, 0x37, 0x9f, 0x83, 0x99, 0xbb, 0x07, 0xdf,
+	0x9b, 0xe9, 0xeb, 0x27, 0xe7, 0xe6, 0x7c, 0xaf, 0xff, 0x72, 0xc1, 0x9f, 0x1e, 0x5f, 0x43, 0x45,
+	0xc8, 0x79, 0xb9, 0xb9, 0xc3, 0x12, 0x0c, 0xf9, 0x3a, 0xde, 0x87, 0xaa, 0xdc, 0x4c, 0x46, 0xe7,
+	0xd9, 0xe3, 0x5d, 0xc1, 0xb6, 0xe0, 0xb5, 0x15, 0xcb, 0xfd, 0xfb, 0x54, 0xd8, 0xad, 0x2e, 0xf3,
+	0x07, 0x62, 0xfa, 0x8c, 0x32, 0x96, 0xfe, 0x2e, 0xda, 0x59, 0xe0, 0xb8, 0xd4, 0xcc, 0xd1, 0x38,
+	0x67, 0xa2, 0x7e, 0x0a, 0x30, 0xd9, 0xf9, 0x1c, 0xa8, 0xba, 0x7f, 0x10, 0xdf, 0x3b, 0xa2, 0x37,
+	0x44, 0x97, 0x24, 0x11, 0x1d, 0x5e, 0xc1, 0x91, 0xe8, 0x8f, 0xc2, 0x94, 0x14, 0x7f, 0x51, 0xf0,
+	0xf1, 0x50, 0xe9, 0xaf, 0xcd, 0x69, 0x64, 0x99, 0x9d, 0xc6, 0xd9, 0x78, 0x48, 0xb0, 0x54, 0x70,
+	0x1b, 0x60, 0x0a, 0x4a, 0x4c, 0x14, 0xbf, 0x68, 0x1d, 0xb5, 0x4e, 0xde, 0xb7, 0xd4, 0x78, 0x71,
+	0xfa, 0x12, 0x37, 0x5b, 0x67, 0xed, 0x9a, 0x81, 0xaa, 0x60, 0xed, 0xbd, 0x3e, 0x3c, 0xde, 0xc7,
+	0xcd, 0x56, 0xad, 0x20, 0xbe, 0x9d, 0x2b, 0xa2, 0xcb, 0x74, 0x99, 0x3f, 0xec, 0x7d, 0x43, 0x87,
+	0x32, 0x3b, 0x79, 0x87, 0x1e, 0xcd, 0x72, 0x08, 0xa0, 0xbc, 0xf7, 0xf2, 0xf8, 0xb8, 0x89, 0x6b,
+	0x46, 0xb6, 0x6e, 0xd6, 0x0a, 0xee, 0x11, 0xac, 0x0a, 0xe1, 0xd7, 0x11, 0x61, 0x3e, 0x0b, 0x7a,
+	0x63, 0xf1, 0x50, 0x27, 0xa3, 0x21, 0x61, 0xc2, 0x54, 0xea, 0x53, 0x8e, 0x23, 0xbe, 0x2d, 0x93,
+	0xd1, 0xb9, 0xda, 0x55, 0xdf, 0xa9, 0x19, 0xed, 0xfe, 0xd6, 0x00, 0x74, 0x30, 0x8a, 0x03, 0x31,
+	0xd4, 0x9d, 0xfa, 0xcc, 0x1f, 0x10, 0x4e, 0x58, 0x82, 0x9e, 0x82, 0xd5, 0xd1, 0xdc, 0xc5, 0x2f,
+	0x71, 0x26, 0x8a, 0x7e, 0x22, 0xa6, 0x96, 0xd4, 0x88, 0x7e, 0x60, 0x6e, 0x50, 0xcc, 0x09, 0xbf,
+	0xba, 0x0f, 0xf7, 0x02, 0x3a, 0x68, 0x74, 0x29, 0xed, 0xf6, 0x49, 0x23, 0x24, 0x97, 0x9c, 0xd2,
+	0x7e, 0x92, 0xd7, 0x3d, 0x2f, 0xcb, 0x9f, 0xc7, 0xff, 0x1b, 0x00, 0x0b, 0x0d, 0x73, 0x49, 0x77,
+	0x14, 0x00, 0x00,
 }
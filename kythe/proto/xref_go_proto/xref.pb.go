@@ -39,7 +39,7 @@ func (x SnippetsKind) String() string {
 	return proto.EnumName(SnippetsKind_name, int32(x))
 }
 func (SnippetsKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{0}
 }
 
 type Location_Kind int32
@@ -62,7 +62,7 @@ func (x Location_Kind) String() string {
 	return proto.EnumName(Location_Kind_name, int32(x))
 }
 func (Location_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{0, 0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{0, 0}
 }
 
 type DecorationsRequest_SpanKind int32
@@ -85,7 +85,7 @@ func (x DecorationsRequest_SpanKind) String() string {
 	return proto.EnumName(DecorationsRequest_SpanKind_name, int32(x))
 }
 func (DecorationsRequest_SpanKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{1, 0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{1, 0}
 }
 
 type DecorationsReply_Override_Kind int32
@@ -108,7 +108,7 @@ func (x DecorationsReply_Override_Kind) String() string {
 	return proto.EnumName(DecorationsReply_Override_Kind_name, int32(x))
 }
 func (DecorationsReply_Override_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{2, 1, 0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{2, 1, 0}
 }
 
 type CrossReferencesRequest_DefinitionKind int32
@@ -137,7 +137,7 @@ func (x CrossReferencesRequest_DefinitionKind) String() string {
 	return proto.EnumName(CrossReferencesRequest_DefinitionKind_name, int32(x))
 }
 func (CrossReferencesRequest_DefinitionKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{3, 0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{3, 0}
 }
 
 type CrossReferencesRequest_DeclarationKind int32
@@ -160,7 +160,7 @@ func (x CrossReferencesRequest_DeclarationKind) String() string {
 	return proto.EnumName(CrossReferencesRequest_DeclarationKind_name, int32(x))
 }
 func (CrossReferencesRequest_DeclarationKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{3, 1}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{3, 1}
 }
 
 type CrossReferencesRequest_ReferenceKind int32
@@ -170,6 +170,7 @@ const (
 	CrossReferencesRequest_CALL_REFERENCES     CrossReferencesRequest_ReferenceKind = 1
 	CrossReferencesRequest_NON_CALL_REFERENCES CrossReferencesRequest_ReferenceKind = 2
 	CrossReferencesRequest_ALL_REFERENCES      CrossReferencesRequest_ReferenceKind = 3
+	CrossReferencesRequest_WRITE_REFERENCES    CrossReferencesRequest_ReferenceKind = 4
 )
 
 var CrossReferencesRequest_ReferenceKind_name = map[int32]string{
@@ -177,19 +178,21 @@ var CrossReferencesRequest_ReferenceKind_name = map[int32]string{
 	1: "CALL_REFERENCES",
 	2: "NON_CALL_REFERENCES",
 	3: "ALL_REFERENCES",
+	4: "WRITE_REFERENCES",
 }
 var CrossReferencesRequest_ReferenceKind_value = map[string]int32{
 	"NO_REFERENCES":       0,
 	"CALL_REFERENCES":     1,
 	"NON_CALL_REFERENCES": 2,
 	"ALL_REFERENCES":      3,
+	"WRITE_REFERENCES":    4,
 }
 
 func (x CrossReferencesRequest_ReferenceKind) String() string {
 	return proto.EnumName(CrossReferencesRequest_ReferenceKind_name, int32(x))
 }
 func (CrossReferencesRequest_ReferenceKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{3, 2}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{3, 2}
 }
 
 type CrossReferencesRequest_CallerKind int32
@@ -215,7 +218,7 @@ func (x CrossReferencesRequest_CallerKind) String() string {
 	return proto.EnumName(CrossReferencesRequest_CallerKind_name, int32(x))
 }
 func (CrossReferencesRequest_CallerKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{3, 3}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{3, 3}
 }
 
 type Location struct {
@@ -231,7 +234,7 @@ func (m *Location) Reset()         { *m = Location{} }
 func (m *Location) String() string { return proto.CompactTextString(m) }
 func (*Location) ProtoMessage()    {}
 func (*Location) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{0}
 }
 func (m *Location) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Location.Unmarshal(m, b)
@@ -292,7 +295,7 @@ func (m *DecorationsRequest) Reset()         { *m = DecorationsRequest{} }
 func (m *DecorationsRequest) String() string { return proto.CompactTextString(m) }
 func (*DecorationsRequest) ProtoMessage()    {}
 func (*DecorationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{1}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{1}
 }
 func (m *DecorationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecorationsRequest.Unmarshal(m, b)
@@ -400,7 +403,7 @@ func (m *DecorationsReply) Reset()         { *m = DecorationsReply{} }
 func (m *DecorationsReply) String() string { return proto.CompactTextString(m) }
 func (*DecorationsReply) ProtoMessage()    {}
 func (*DecorationsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{2}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{2}
 }
 func (m *DecorationsReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecorationsReply.Unmarshal(m, b)
@@ -490,7 +493,7 @@ func (m *DecorationsReply_Reference) Reset()         { *m = DecorationsReply_Ref
 func (m *DecorationsReply_Reference) String() string { return proto.CompactTextString(m) }
 func (*DecorationsReply_Reference) ProtoMessage()    {}
 func (*DecorationsReply_Reference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{2, 0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{2, 0}
 }
 func (m *DecorationsReply_Reference) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecorationsReply_Reference.Unmarshal(m, b)
@@ -552,7 +555,7 @@ func (m *DecorationsReply_Override) Reset()         { *m = DecorationsReply_Over
 func (m *DecorationsReply_Override) String() string { return proto.CompactTextString(m) }
 func (*DecorationsReply_Override) ProtoMessage()    {}
 func (*DecorationsReply_Override) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{2, 1}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{2, 1}
 }
 func (m *DecorationsReply_Override) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecorationsReply_Override.Unmarshal(m, b)
@@ -611,7 +614,7 @@ func (m *DecorationsReply_Overrides) Reset()         { *m = DecorationsReply_Ove
 func (m *DecorationsReply_Overrides) String() string { return proto.CompactTextString(m) }
 func (*DecorationsReply_Overrides) ProtoMessage()    {}
 func (*DecorationsReply_Overrides) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{2, 2}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{2, 2}
 }
 func (m *DecorationsReply_Overrides) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecorationsReply_Overrides.Unmarshal(m, b)
@@ -660,7 +663,7 @@ func (m *CrossReferencesRequest) Reset()         { *m = CrossReferencesRequest{}
 func (m *CrossReferencesRequest) String() string { return proto.CompactTextString(m) }
 func (*CrossReferencesRequest) ProtoMessage()    {}
 func (*CrossReferencesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{3}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{3}
 }
 func (m *CrossReferencesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferencesRequest.Unmarshal(m, b)
@@ -781,7 +784,7 @@ func (m *Anchor) Reset()         { *m = Anchor{} }
 func (m *Anchor) String() string { return proto.CompactTextString(m) }
 func (*Anchor) ProtoMessage()    {}
 func (*Anchor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{4}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{4}
 }
 func (m *Anchor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Anchor.Unmarshal(m, b)
@@ -862,7 +865,7 @@ func (m *Printable) Reset()         { *m = Printable{} }
 func (m *Printable) String() string { return proto.CompactTextString(m) }
 func (*Printable) ProtoMessage()    {}
 func (*Printable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{5}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{5}
 }
 func (m *Printable) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Printable.Unmarshal(m, b)
@@ -911,7 +914,7 @@ func (m *CrossReferencesReply) Reset()         { *m = CrossReferencesReply{} }
 func (m *CrossReferencesReply) String() string { return proto.CompactTextString(m) }
 func (*CrossReferencesReply) ProtoMessage()    {}
 func (*CrossReferencesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{6}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{6}
 }
 func (m *CrossReferencesReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferencesReply.Unmarshal(m, b)
@@ -979,7 +982,7 @@ func (m *CrossReferencesReply_RelatedNode) Reset()         { *m = CrossReference
 func (m *CrossReferencesReply_RelatedNode) String() string { return proto.CompactTextString(m) }
 func (*CrossReferencesReply_RelatedNode) ProtoMessage()    {}
 func (*CrossReferencesReply_RelatedNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{6, 0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{6, 0}
 }
 func (m *CrossReferencesReply_RelatedNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferencesReply_RelatedNode.Unmarshal(m, b)
@@ -1034,7 +1037,7 @@ func (m *CrossReferencesReply_RelatedAnchor) Reset()         { *m = CrossReferen
 func (m *CrossReferencesReply_RelatedAnchor) String() string { return proto.CompactTextString(m) }
 func (*CrossReferencesReply_RelatedAnchor) ProtoMessage()    {}
 func (*CrossReferencesReply_RelatedAnchor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{6, 1}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{6, 1}
 }
 func (m *CrossReferencesReply_RelatedAnchor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferencesReply_RelatedAnchor.Unmarshal(m, b)
@@ -1090,6 +1093,7 @@ type CrossReferencesReply_CrossReferenceSet struct {
 	Reference            []*CrossReferencesReply_RelatedAnchor `protobuf:"bytes,3,rep,name=reference" json:"reference,omitempty"`
 	Caller               []*CrossReferencesReply_RelatedAnchor `protobuf:"bytes,6,rep,name=caller" json:"caller,omitempty"`
 	RelatedNode          []*CrossReferencesReply_RelatedNode   `protobuf:"bytes,10,rep,name=related_node,json=relatedNode" json:"related_node,omitempty"`
+	MergedTicket         []string                              `protobuf:"bytes,11,rep,name=merged_ticket,json=mergedTicket" json:"merged_ticket,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
 	XXX_unrecognized     []byte                                `json:"-"`
 	XXX_sizecache        int32                                 `json:"-"`
@@ -1101,7 +1105,7 @@ func (m *CrossReferencesReply_CrossReferenceSet) Reset() {
 func (m *CrossReferencesReply_CrossReferenceSet) String() string { return proto.CompactTextString(m) }
 func (*CrossReferencesReply_CrossReferenceSet) ProtoMessage()    {}
 func (*CrossReferencesReply_CrossReferenceSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{6, 2}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{6, 2}
 }
 func (m *CrossReferencesReply_CrossReferenceSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferencesReply_CrossReferenceSet.Unmarshal(m, b)
@@ -1170,6 +1174,13 @@ func (m *CrossReferencesReply_CrossReferenceSet) GetRelatedNode() []*CrossRefere
 	return nil
 }
 
+func (m *CrossReferencesReply_CrossReferenceSet) GetMergedTicket() []string {
+	if m != nil {
+		return m.MergedTicket
+	}
+	return nil
+}
+
 type CrossReferencesReply_Total struct {
 	Definitions            int64            `protobuf:"varint,1,opt,name=definitions" json:"definitions,omitempty"`
 	Declarations           int64            `protobuf:"varint,2,opt,name=declarations" json:"declarations,omitempty"`
@@ -1186,7 +1197,7 @@ func (m *CrossReferencesReply_Total) Reset()         { *m = CrossReferencesReply
 func (m *CrossReferencesReply_Total) String() string { return proto.CompactTextString(m) }
 func (*CrossReferencesReply_Total) ProtoMessage()    {}
 func (*CrossReferencesReply_Total) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{6, 3}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{6, 3}
 }
 func (m *CrossReferencesReply_Total) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferencesReply_Total.Unmarshal(m, b)
@@ -1261,7 +1272,7 @@ func (m *DocumentationRequest) Reset()         { *m = DocumentationRequest{} }
 func (m *DocumentationRequest) String() string { return proto.CompactTextString(m) }
 func (*DocumentationRequest) ProtoMessage()    {}
 func (*DocumentationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{7}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{7}
 }
 func (m *DocumentationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DocumentationRequest.Unmarshal(m, b)
@@ -1315,7 +1326,7 @@ func (m *DocumentationReply) Reset()         { *m = DocumentationReply{} }
 func (m *DocumentationReply) String() string { return proto.CompactTextString(m) }
 func (*DocumentationReply) ProtoMessage()    {}
 func (*DocumentationReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{8}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{8}
 }
 func (m *DocumentationReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DocumentationReply.Unmarshal(m, b)
@@ -1370,7 +1381,7 @@ func (m *DocumentationReply_Document) Reset()         { *m = DocumentationReply_
 func (m *DocumentationReply_Document) String() string { return proto.CompactTextString(m) }
 func (*DocumentationReply_Document) ProtoMessage()    {}
 func (*DocumentationReply_Document) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_22631e27e6c95add, []int{8, 0}
+	return fileDescriptor_xref_daa69fd26ac1949f, []int{8, 0}
 }
 func (m *DocumentationReply_Document) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DocumentationReply_Document.Unmarshal(m, b)
@@ -1455,133 +1466,134 @@ func init() {
 	proto.RegisterEnum("kythe.proto.CrossReferencesRequest_CallerKind", CrossReferencesRequest_CallerKind_name, CrossReferencesRequest_CallerKind_value)
 }
 
-func init() { proto.RegisterFile("kythe/proto/xref.proto", fileDescriptor_xref_22631e27e6c95add) }
-
-var fileDescriptor_xref_22631e27e6c95add = []byte{
-	// 1985 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x73, 0xdb, 0xc6,
-	0x15, 0x17, 0x48, 0x90, 0x02, 0x1f, 0xf8, 0x01, 0xad, 0x15, 0x15, 0x66, 0x9a, 0x58, 0x86, 0xdb,
-	0x58, 0xfe, 0x88, 0x3c, 0xa1, 0xa7, 0x9d, 0x4c, 0xda, 0xa4, 0x95, 0x44, 0x2a, 0xa5, 0xaa, 0x90,
-	0xea, 0x92, 0x4e, 0x3d, 0xe3, 0x99, 0xa2, 0x30, 0xb1, 0x92, 0x31, 0x82, 0x00, 0x05, 0x80, 0x6c,
-	0x29, 0x87, 0xce, 0xf4, 0xde, 0x53, 0xff, 0x8a, 0x5e, 0x7b, 0xed, 0xb1, 0xc7, 0xfe, 0x2f, 0xbd,
-	0xb7, 0x87, 0x74, 0x3a, 0x78, 0x0b, 0x80, 0x0b, 0x7e, 0x88, 0x94, 0x26, 0x97, 0xdc, 0xb0, 0x6f,
-	0xdf, 0xfb, 0xbd, 0xdd, 0xf7, 0xbd, 0x80, 0x8d, 0xd3, 0xab, 0xe8, 0x0d, 0x7b, 0x76, 0x1e, 0xf8,
-	0x91, 0xff, 0xec, 0x32, 0x60, 0xc7, 0xdb, 0xf8, 0x49, 0x54, 0xa4, 0xf3, 0x45, 0x53, 0x17, 0x99,
-	0x46, 0xfe, 0xd9, 0x99, 0xef, 0xf1, 0x1d, 0xe3, 0x6f, 0x12, 0x28, 0x87, 0xfe, 0xc8, 0x8a, 0x1c,
-	0xdf, 0x23, 0x1b, 0x50, 0x8e, 0x9c, 0xd1, 0x29, 0x8b, 0x74, 0x69, 0x53, 0xda, 0xaa, 0xd0, 0x64,
-	0x45, 0xb6, 0x41, 0x3e, 0x75, 0x3c, 0x5b, 0x2f, 0x6c, 0x4a, 0x5b, 0xf5, 0x56, 0x73, 0x5b, 0x80,
-	0xde, 0x4e, 0x85, 0xb7, 0x7f, 0xeb, 0x78, 0x36, 0x45, 0x3e, 0xf2, 0x14, 0xe4, 0xf0, 0xdc, 0xf2,
-	0xf4, 0xd2, 0xa6, 0xb4, 0xa5, 0xb6, 0xf4, 0x1c, 0x7f, 0xa2, 0x7d, 0x70, 0x6e, 0x79, 0x14, 0xb9,
-	0x8c, 0x26, 0xc8, 0xb1, 0x2c, 0x51, 0x40, 0xde, 0xef, 0x1e, 0x76, 0xb4, 0x95, 0xf8, 0x6b, 0x70,
-	0xb4, 0xd3, 0xd3, 0xa4, 0x03, 0x59, 0x29, 0x6a, 0xf2, 0x81, 0xac, 0xc8, 0x5a, 0xc9, 0xf8, 0x77,
-	0x11, 0x48, 0x9b, 0x8d, 0xfc, 0x00, 0xf5, 0x85, 0x94, 0x7d, 0x73, 0xc1, 0xc2, 0x88, 0x7c, 0x02,
-	0x8a, 0x9b, 0x9c, 0x01, 0x8f, 0xad, 0xb6, 0xde, 0x9b, 0x79, 0x40, 0x9a, 0xb1, 0x91, 0x0e, 0x54,
-	0x62, 0xcd, 0x26, 0x5e, 0x0a, 0xf0, 0x52, 0x5b, 0x39, 0x99, 0x69, 0x35, 0x78, 0x60, 0xbc, 0xa2,
-	0x12, 0x26, 0x5f, 0xe4, 0x3e, 0x54, 0x6d, 0x27, 0x88, 0xae, 0xcc, 0xd7, 0x17, 0xc7, 0xc7, 0x2c,
-	0x40, 0xf3, 0x54, 0xa9, 0x8a, 0xb4, 0x5d, 0x24, 0x91, 0x7b, 0xa0, 0x86, 0xfe, 0x45, 0x30, 0x62,
-	0x66, 0xc4, 0x2e, 0x23, 0xbd, 0xb8, 0x29, 0x6d, 0x29, 0x14, 0x38, 0x69, 0xc8, 0x2e, 0x23, 0xf2,
-	0x21, 0x40, 0xc0, 0x8e, 0x59, 0xc0, 0xbc, 0x11, 0x0b, 0x75, 0x99, 0xef, 0x8f, 0x29, 0xe4, 0x63,
-	0x20, 0x91, 0x15, 0x9c, 0xb0, 0xc8, 0xb4, 0xd9, 0xb1, 0xe3, 0x39, 0x78, 0x26, 0xbd, 0x8c, 0x7c,
-	0x6b, 0x7c, 0xa7, 0x3d, 0xde, 0x88, 0x3d, 0x78, 0xec, 0xb8, 0x11, 0x0b, 0xf4, 0xd2, 0x66, 0x31,
-	0xf6, 0x20, 0x5f, 0x91, 0x27, 0xb0, 0xc6, 0x2e, 0x23, 0xe6, 0xd9, 0xa1, 0xe9, 0xbf, 0x65, 0x41,
-	0xe0, 0xd8, 0x2c, 0xd4, 0x57, 0x11, 0x45, 0x4b, 0x36, 0xfa, 0x29, 0x9d, 0x6c, 0x82, 0x6a, 0x3b,
-	0xd6, 0x89, 0xe7, 0x87, 0x91, 0x33, 0x0a, 0x75, 0x05, 0xd9, 0x44, 0x12, 0xf9, 0x19, 0x28, 0xa1,
-	0xe7, 0x9c, 0x9f, 0xb3, 0x28, 0xd4, 0x2b, 0x68, 0xbf, 0xbb, 0x39, 0xfb, 0x0d, 0x92, 0xcd, 0xc4,
-	0x60, 0xc9, 0xca, 0x78, 0x0a, 0x4a, 0x6a, 0x46, 0xd2, 0x00, 0xf5, 0xf7, 0xdd, 0xe1, 0x6f, 0xba,
-	0x3d, 0x13, 0x5d, 0xbd, 0x12, 0x13, 0x76, 0x68, 0xff, 0x45, 0xaf, 0xcd, 0x09, 0x92, 0xf1, 0x4f,
-	0x00, 0x2d, 0xe7, 0x88, 0x73, 0xf7, 0xea, 0x36, 0xde, 0x9e, 0xf0, 0x01, 0xf7, 0x92, 0xe8, 0x83,
-	0x26, 0x28, 0xcc, 0x1b, 0xf9, 0xb6, 0xe3, 0x9d, 0xa0, 0x87, 0x2a, 0x34, 0x5b, 0xc7, 0xa1, 0x92,
-	0x79, 0x43, 0x97, 0x37, 0x8b, 0x5b, 0x6a, 0xeb, 0xe1, 0xfc, 0x50, 0x39, 0x77, 0xaf, 0xb6, 0x69,
-	0xca, 0x4e, 0xc7, 0x92, 0xe4, 0x0b, 0x80, 0xb1, 0xfd, 0xd0, 0x37, 0x6a, 0xeb, 0xc3, 0x59, 0x79,
-	0xd1, 0xce, 0xb8, 0xa8, 0x20, 0x41, 0xbe, 0x80, 0x92, 0xe7, 0xc7, 0x3e, 0x6b, 0xa0, 0xe8, 0xd6,
-	0xf5, 0x47, 0xe8, 0xc5, 0xac, 0x1d, 0x2f, 0x0a, 0xae, 0x28, 0x17, 0x23, 0x0e, 0xac, 0x8f, 0xe3,
-	0xc7, 0x4c, 0x4d, 0x13, 0xea, 0x1a, 0xc2, 0xfd, 0xfc, 0x7a, 0xb8, 0x71, 0x80, 0xa5, 0xd6, 0x4d,
-	0xc0, 0xef, 0xd8, 0xd3, 0x3b, 0xe4, 0x8f, 0xb3, 0x42, 0x6d, 0x0d, 0xf5, 0x3c, 0xbf, 0x5e, 0x4f,
-	0x67, 0x22, 0x10, 0xb9, 0x92, 0xa9, 0xf8, 0x6c, 0xfe, 0x5d, 0x82, 0x4a, 0x66, 0x65, 0xf2, 0x00,
-	0x6a, 0x49, 0x86, 0x24, 0xb5, 0xab, 0x80, 0x2e, 0xac, 0x72, 0xe2, 0x90, 0x57, 0x30, 0x92, 0x54,
-	0x30, 0xee, 0x5e, 0x5e, 0xa5, 0x9e, 0xc0, 0xda, 0x54, 0x6a, 0x61, 0x06, 0x56, 0xa8, 0x36, 0x99,
-	0x59, 0x37, 0x2b, 0x69, 0x07, 0xb2, 0x22, 0x69, 0x85, 0x03, 0x59, 0x01, 0x4d, 0x3d, 0x90, 0x15,
-	0x55, 0xab, 0x36, 0xff, 0x5c, 0x00, 0x25, 0xbd, 0x01, 0xd6, 0x59, 0x54, 0x90, 0xd5, 0x59, 0x5c,
-	0xcd, 0x3e, 0x51, 0x69, 0xce, 0x89, 0x7e, 0x95, 0x2b, 0xca, 0x4f, 0xae, 0x37, 0x6d, 0xaa, 0x5a,
-	0xac, 0xd2, 0x1d, 0xa8, 0x9d, 0x59, 0xc1, 0x29, 0xb3, 0x4d, 0x9e, 0x0b, 0x78, 0x77, 0xb5, 0xb5,
-	0x39, 0xeb, 0x6e, 0x5f, 0x21, 0xe3, 0x00, 0xf9, 0x68, 0xf5, 0x4c, 0x58, 0x19, 0x46, 0x52, 0xbe,
-	0x6b, 0x50, 0xe9, 0x7f, 0xdd, 0xa1, 0xb4, 0xdb, 0xee, 0x0c, 0xb4, 0x15, 0xa2, 0xc2, 0x6a, 0xe7,
-	0xe5, 0xb0, 0xd3, 0x6b, 0x0f, 0xd2, 0x32, 0xde, 0xec, 0x43, 0x65, 0x5c, 0x64, 0x76, 0x41, 0x49,
-	0xc3, 0x43, 0x97, 0x30, 0x3a, 0x3e, 0x5a, 0xee, 0x0a, 0x34, 0x93, 0x6b, 0x7e, 0x0d, 0x30, 0x0e,
-	0x75, 0xa2, 0x41, 0xf1, 0x94, 0x5d, 0x25, 0x26, 0x8d, 0x3f, 0x49, 0x0b, 0x4a, 0x6f, 0x2d, 0xf7,
-	0x82, 0xa1, 0x8d, 0xd4, 0xd6, 0x8f, 0x67, 0xdd, 0x2c, 0x06, 0xe8, 0x7a, 0xc7, 0x3e, 0xe5, 0xac,
-	0x9f, 0x15, 0x3e, 0x95, 0x9a, 0xaf, 0x40, 0x9f, 0x17, 0xf3, 0x33, 0xb4, 0x3c, 0xca, 0x6b, 0xb9,
-	0x93, 0xd3, 0xb2, 0xe3, 0x8d, 0xde, 0xf8, 0x81, 0x08, 0xee, 0xc2, 0x7b, 0x33, 0x03, 0x7d, 0x06,
-	0xf2, 0xe7, 0x79, 0xe4, 0x87, 0xcb, 0x19, 0x28, 0x14, 0xb4, 0x19, 0xdf, 0xad, 0xc2, 0xc6, 0x5e,
-	0xe0, 0x87, 0x61, 0x96, 0x30, 0x59, 0xe3, 0x14, 0xbb, 0x7d, 0x51, 0xe8, 0xf6, 0xaf, 0xa0, 0x21,
-	0xd4, 0x0a, 0x21, 0xc6, 0x5a, 0x39, 0xfd, 0xb3, 0x51, 0x85, 0x62, 0x81, 0xa1, 0x56, 0xb7, 0x73,
-	0x6b, 0xf2, 0x07, 0xd0, 0x6c, 0x36, 0x72, 0x2d, 0x7e, 0x72, 0x8e, 0xbe, 0x8a, 0xe8, 0xcf, 0x97,
-	0x43, 0xcf, 0x64, 0x11, 0xbe, 0x61, 0xe7, 0x09, 0xe4, 0x25, 0xd4, 0xb3, 0xaa, 0x6b, 0x66, 0x29,
-	0x5f, 0x6f, 0x7d, 0xb2, 0x0c, 0x7a, 0x46, 0x41, 0xec, 0x5a, 0x20, 0x2e, 0x49, 0x1f, 0xd4, 0x91,
-	0xe5, 0xba, 0x2c, 0xe0, 0xb0, 0x55, 0x84, 0xdd, 0x5e, 0x06, 0x76, 0x0f, 0xc5, 0x10, 0x13, 0x46,
-	0xd9, 0xf7, 0xdc, 0x5e, 0xfd, 0x18, 0xd6, 0x02, 0xe6, 0x5a, 0x11, 0xb3, 0xcd, 0xb8, 0x78, 0x73,
-	0x75, 0x75, 0x64, 0x69, 0x24, 0x1b, 0x71, 0xd0, 0x22, 0xc6, 0x3d, 0x50, 0x2d, 0x8c, 0x30, 0xde,
-	0xdb, 0xf8, 0x5c, 0x00, 0x9c, 0x84, 0xbd, 0xed, 0x11, 0x68, 0x08, 0x22, 0x4e, 0x0f, 0xbc, 0xa1,
-	0x37, 0x62, 0xba, 0x38, 0x3b, 0xbc, 0x0f, 0x95, 0x73, 0xeb, 0x84, 0x99, 0xa1, 0xf3, 0x2d, 0xc3,
-	0xa9, 0xa8, 0x44, 0x95, 0x98, 0x30, 0x70, 0xbe, 0x65, 0xe4, 0x03, 0x00, 0xdc, 0x8c, 0xfc, 0x53,
-	0xe6, 0xe9, 0x2a, 0xc6, 0x28, 0xb2, 0x0f, 0x63, 0x42, 0x6e, 0x20, 0xa8, 0x2d, 0x3f, 0x10, 0xbc,
-	0x81, 0x7a, 0x3e, 0x5e, 0x08, 0x81, 0x7a, 0xaf, 0x6f, 0xb6, 0x3b, 0xfb, 0xdd, 0x5e, 0x77, 0xd8,
-	0xed, 0xf7, 0xe2, 0x52, 0x72, 0x07, 0x1a, 0x3b, 0x87, 0x87, 0x39, 0xa2, 0x44, 0xd6, 0x41, 0xdb,
-	0x7f, 0x31, 0x41, 0x2d, 0x90, 0x1f, 0xc1, 0x9d, 0xdd, 0x6e, 0xaf, 0xdd, 0xed, 0x7d, 0x99, 0xdb,
-	0x28, 0x1a, 0xbf, 0x84, 0xc6, 0x44, 0xec, 0xc4, 0xb0, 0xa8, 0x6a, 0xef, 0x70, 0x87, 0xee, 0xa4,
-	0xba, 0xd6, 0x41, 0xe3, 0xba, 0x04, 0xaa, 0x64, 0xd8, 0x50, 0xcb, 0xc5, 0x06, 0x59, 0x83, 0x5a,
-	0xaf, 0x6f, 0xd2, 0xce, 0x7e, 0x87, 0x76, 0x7a, 0x7b, 0x9d, 0xe4, 0x94, 0x7b, 0xb1, 0xa8, 0x40,
-	0x94, 0xe2, 0xf3, 0xf4, 0xfa, 0x3d, 0x73, 0x72, 0xa3, 0x10, 0xdf, 0x73, 0x82, 0x56, 0x34, 0xf6,
-	0x01, 0xc6, 0xa1, 0x42, 0xea, 0x00, 0xbd, 0x3e, 0x4a, 0x76, 0x68, 0x8c, 0x4f, 0xa0, 0xde, 0xee,
-	0xd2, 0xce, 0xde, 0x30, 0xa3, 0xa1, 0x11, 0xd2, 0x9a, 0x9b, 0x51, 0x0b, 0x7c, 0x5c, 0x3e, 0x90,
-	0x15, 0x5b, 0x63, 0xc6, 0x77, 0x12, 0x94, 0x79, 0x0d, 0x9a, 0x3b, 0xdd, 0x13, 0xa1, 0x91, 0xa4,
-	0xbd, 0x71, 0x03, 0xca, 0xe7, 0x56, 0xc0, 0xbc, 0x28, 0xe9, 0x98, 0xc9, 0x2a, 0x6b, 0x83, 0xb0,
-	0x4c, 0x1b, 0x8c, 0x91, 0xb3, 0xb0, 0xac, 0x50, 0xfc, 0x26, 0x3a, 0xac, 0x26, 0xee, 0xc7, 0xbc,
-	0xaf, 0xd0, 0x74, 0x49, 0x7e, 0x01, 0xd5, 0xe4, 0xd3, 0x44, 0x1d, 0xea, 0x02, 0x1d, 0x6a, 0xc2,
-	0x3d, 0xe0, 0x1d, 0x97, 0xdf, 0xb9, 0xa4, 0x95, 0x0f, 0x64, 0x45, 0xd1, 0x2a, 0x07, 0xb2, 0x52,
-	0xd1, 0xc0, 0x18, 0x42, 0xe5, 0x28, 0x70, 0xbc, 0xc8, 0x7a, 0xed, 0x32, 0x72, 0x17, 0x94, 0xc0,
-	0x7a, 0xc7, 0x53, 0x85, 0xdb, 0x60, 0x35, 0xb0, 0xde, 0x61, 0x9e, 0x3c, 0x05, 0xd9, 0x75, 0xbc,
-	0x53, 0xbd, 0x80, 0xad, 0x68, 0xa6, 0xd2, 0x43, 0xc7, 0x3b, 0xa5, 0xc8, 0x65, 0xfc, 0xaf, 0x0e,
-	0xeb, 0x53, 0xc9, 0x1e, 0x8f, 0xa7, 0x9f, 0x43, 0x29, 0xf2, 0x23, 0xcb, 0x4d, 0xe6, 0x84, 0x87,
-	0xd7, 0x97, 0x87, 0xb8, 0x6a, 0x0f, 0x63, 0x76, 0xca, 0xa5, 0x88, 0x05, 0xda, 0x28, 0x66, 0x32,
-	0x85, 0x37, 0x81, 0x34, 0x63, 0x44, 0x9b, 0x89, 0x34, 0x41, 0xe4, 0xd3, 0x53, 0x63, 0x94, 0xa7,
-	0x92, 0xdd, 0x74, 0x92, 0xe4, 0x37, 0x7d, 0xba, 0x18, 0x77, 0x7a, 0x9a, 0x3c, 0x9b, 0x33, 0x4d,
-	0x16, 0x11, 0xf2, 0xb3, 0xc5, 0x90, 0x37, 0x9b, 0x28, 0x3f, 0x82, 0x86, 0xc7, 0x2e, 0x23, 0x53,
-	0x28, 0x40, 0x80, 0xde, 0xab, 0xc5, 0xe4, 0xa3, 0xb4, 0x08, 0x35, 0x6d, 0x50, 0xe9, 0xb8, 0x3e,
-	0xce, 0x8d, 0xf7, 0x07, 0x50, 0xc3, 0x32, 0x9a, 0xeb, 0x6e, 0x15, 0x5a, 0x4d, 0x89, 0x98, 0x7d,
-	0x3a, 0xac, 0xfa, 0x81, 0xed, 0x78, 0x96, 0x8b, 0x19, 0x50, 0xa2, 0xe9, 0xb2, 0xf9, 0x2f, 0x29,
-	0x2e, 0x06, 0xa8, 0x26, 0x49, 0xac, 0x27, 0x50, 0xe6, 0x15, 0x37, 0x79, 0x91, 0xcc, 0x9c, 0x00,
-	0x12, 0x96, 0xe9, 0xa9, 0xab, 0x74, 0x9b, 0xa9, 0x8b, 0x3c, 0x04, 0x39, 0x74, 0x22, 0x96, 0x98,
-	0x7c, 0xa6, 0x46, 0x64, 0x10, 0xac, 0x20, 0x8b, 0x56, 0x38, 0x90, 0x95, 0x82, 0x56, 0x6c, 0xfe,
-	0x45, 0x86, 0xb5, 0xbc, 0x87, 0x06, 0x2c, 0x9a, 0x6b, 0xb9, 0xa9, 0xb3, 0x2b, 0xb7, 0x3a, 0x7b,
-	0x1f, 0x40, 0x98, 0x6f, 0x79, 0x1c, 0x3e, 0x5b, 0x1c, 0x34, 0x39, 0xa3, 0x53, 0x01, 0x82, 0xfc,
-	0x0e, 0x54, 0x61, 0x0e, 0x48, 0x9e, 0x57, 0x37, 0x46, 0x14, 0x31, 0xc8, 0x57, 0xe2, 0xbb, 0xaf,
-	0x78, 0x3b, 0x40, 0xe1, 0xfd, 0xf7, 0x25, 0x94, 0x79, 0xe7, 0xd7, 0xcb, 0xb7, 0xc3, 0x4a, 0xc4,
-	0xc9, 0x11, 0x54, 0xc5, 0xe1, 0x40, 0x07, 0x84, 0xfb, 0x78, 0x69, 0xb8, 0x38, 0x33, 0xa8, 0x2a,
-	0x8c, 0x11, 0x59, 0xe5, 0x5c, 0xd5, 0x94, 0xe6, 0x7f, 0x0a, 0x50, 0xc2, 0x82, 0x84, 0xff, 0x00,
-	0x84, 0x91, 0x21, 0x8e, 0x83, 0x22, 0x15, 0x49, 0xc4, 0x80, 0xaa, 0x60, 0xb0, 0x10, 0xb3, 0xa8,
-	0x48, 0x73, 0xb4, 0x89, 0xbf, 0x1b, 0x45, 0xe4, 0x10, 0xff, 0x6e, 0xfc, 0x04, 0x6a, 0xb6, 0x3f,
-	0xba, 0x38, 0x63, 0x5e, 0x64, 0x65, 0xcf, 0xaf, 0x22, 0xcd, 0x13, 0xe3, 0x5c, 0xe4, 0xb7, 0x0f,
-	0x31, 0x59, 0x8a, 0x34, 0x5d, 0x92, 0x3f, 0xc1, 0x5d, 0xd1, 0x1a, 0xa1, 0xf9, 0xfa, 0xca, 0x4c,
-	0xd3, 0x38, 0xb1, 0xf4, 0xde, 0x92, 0x25, 0x58, 0x34, 0x50, 0xb8, 0x7b, 0x45, 0x13, 0x14, 0x5e,
-	0x96, 0x36, 0x82, 0x99, 0x9b, 0xcd, 0x2e, 0xbc, 0x7f, 0x8d, 0xd8, 0x8c, 0x89, 0x7e, 0x5d, 0x9c,
-	0xe8, 0x8b, 0xe2, 0xb3, 0xe0, 0xdd, 0x54, 0x47, 0x99, 0x87, 0xd1, 0xcd, 0xbf, 0x0a, 0x9e, 0xdf,
-	0xb4, 0x33, 0x0c, 0x58, 0x24, 0x2a, 0xfe, 0x21, 0x3e, 0xa2, 0x8c, 0x6f, 0x60, 0xbd, 0x2d, 0xc6,
-	0xc8, 0xa2, 0x37, 0xcd, 0x78, 0xd6, 0x2e, 0xe4, 0x66, 0xed, 0x47, 0xa0, 0x39, 0xde, 0xc8, 0xbd,
-	0xb0, 0x99, 0x39, 0x7a, 0xe3, 0xb8, 0x76, 0xc0, 0xbc, 0xe4, 0x27, 0x5d, 0x23, 0xa1, 0xef, 0x25,
-	0x64, 0xe3, 0x1f, 0x25, 0x20, 0x13, 0x3a, 0xe3, 0x8e, 0xdf, 0x06, 0x25, 0x8d, 0xd6, 0xa4, 0x55,
-	0x4f, 0xfc, 0x9c, 0x99, 0x12, 0xc9, 0x48, 0x34, 0x93, 0x24, 0xbf, 0xce, 0x77, 0xe5, 0xc7, 0x8b,
-	0x20, 0xa6, 0x7b, 0xf2, 0xe9, 0xb5, 0x3d, 0xf9, 0xd3, 0x85, 0x67, 0xba, 0x49, 0x47, 0x6e, 0xfe,
-	0x57, 0x02, 0x25, 0x05, 0x99, 0xdb, 0x2d, 0x1e, 0x27, 0xd3, 0x1f, 0xf7, 0xe8, 0x46, 0xee, 0x04,
-	0xd9, 0x4c, 0x96, 0x4c, 0x85, 0xdf, 0x53, 0x67, 0x69, 0x83, 0x92, 0xb9, 0xb1, 0x72, 0x53, 0x67,
-	0xa4, 0x92, 0xe2, 0x4f, 0xe7, 0x6c, 0xa2, 0x2c, 0x6b, 0xab, 0x49, 0x8d, 0xfc, 0x21, 0xe6, 0xcb,
-	0xe3, 0x9f, 0x42, 0x55, 0x7c, 0x82, 0x11, 0x05, 0xe4, 0x5e, 0xbf, 0xd7, 0xe1, 0xff, 0x69, 0xda,
-	0x9d, 0xfd, 0x9d, 0x17, 0x87, 0x43, 0x4d, 0x6a, 0xfd, 0xb5, 0x00, 0xea, 0x4b, 0xca, 0x8e, 0x07,
-	0x2c, 0x78, 0xeb, 0x60, 0xa7, 0x56, 0x85, 0xdf, 0x0c, 0xe4, 0xde, 0x82, 0x9f, 0xe4, 0xcd, 0x0f,
-	0xae, 0xfd, 0x43, 0x61, 0xac, 0x90, 0x57, 0xd0, 0x98, 0xa8, 0x50, 0xe4, 0xc1, 0x12, 0x4f, 0xe8,
-	0xe6, 0xfd, 0x85, 0x45, 0xce, 0x58, 0x21, 0x2f, 0xa0, 0x96, 0x73, 0x30, 0xb9, 0x7f, 0x9d, 0xf3,
-	0x39, 0xf0, 0xbd, 0x05, 0xf1, 0x61, 0xac, 0xec, 0x3e, 0x80, 0x7b, 0x23, 0xff, 0x6c, 0xfb, 0xc4,
-	0xf7, 0x4f, 0x5c, 0xb6, 0x6d, 0xb3, 0xb7, 0x91, 0xef, 0xbb, 0xa1, 0x28, 0x77, 0x24, 0xbd, 0x2e,
-	0xe3, 0xc7, 0xf3, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x11, 0xf1, 0xc7, 0x41, 0x8f, 0x19, 0x00,
-	0x00,
+func init() { proto.RegisterFile("kythe/proto/xref.proto", fileDescriptor_xref_daa69fd26ac1949f) }
+
+var fileDescriptor_xref_daa69fd26ac1949f = []byte{
+	// 2004 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
+	0x15, 0x17, 0x48, 0x90, 0x02, 0x1f, 0x48, 0x11, 0x5a, 0x2b, 0x2a, 0xcc, 0x34, 0xb1, 0x0c, 0xb7,
+	0xb1, 0xfc, 0x27, 0xf4, 0x84, 0x9e, 0x76, 0x32, 0x69, 0x93, 0x56, 0x12, 0xa1, 0x94, 0xaa, 0x42,
+	0xaa, 0x4b, 0x3a, 0xf1, 0x4c, 0x66, 0x8a, 0xc2, 0xc4, 0x4a, 0xc6, 0x88, 0x02, 0x14, 0x00, 0xb2,
+	0x25, 0x1f, 0x3a, 0xd3, 0xaf, 0xd0, 0x7e, 0x84, 0x5e, 0x7a, 0xcd, 0xb5, 0xc7, 0x1e, 0xfb, 0x5d,
+	0x7a, 0x6f, 0x0f, 0x9d, 0xe9, 0xe0, 0x2d, 0x00, 0x2e, 0xf8, 0x47, 0xa4, 0x34, 0xbd, 0xe4, 0xb6,
+	0xfb, 0xf6, 0xed, 0xef, 0xed, 0xbe, 0xff, 0xbb, 0xb0, 0x79, 0x7a, 0x15, 0xbd, 0x66, 0xcf, 0xce,
+	0x03, 0x3f, 0xf2, 0x9f, 0x5d, 0x06, 0xec, 0xb8, 0x89, 0x43, 0xa2, 0x22, 0x9d, 0x4f, 0x1a, 0xba,
+	0xc8, 0x34, 0xf4, 0xcf, 0xce, 0x7c, 0x8f, 0xaf, 0x18, 0x7f, 0x93, 0x40, 0x39, 0xf4, 0x87, 0x76,
+	0xe4, 0xfa, 0x1e, 0xd9, 0x84, 0x72, 0xe4, 0x0e, 0x4f, 0x59, 0xa4, 0x4b, 0x5b, 0xd2, 0x76, 0x85,
+	0x26, 0x33, 0xd2, 0x04, 0xf9, 0xd4, 0xf5, 0x1c, 0xbd, 0xb0, 0x25, 0x6d, 0xaf, 0xb5, 0x1a, 0x4d,
+	0x01, 0xba, 0x99, 0x6e, 0x6e, 0xfe, 0xd6, 0xf5, 0x1c, 0x8a, 0x7c, 0xe4, 0x29, 0xc8, 0xe1, 0xb9,
+	0xed, 0xe9, 0xa5, 0x2d, 0x69, 0x5b, 0x6d, 0xe9, 0x39, 0xfe, 0x44, 0x7a, 0xff, 0xdc, 0xf6, 0x28,
+	0x72, 0x19, 0x0d, 0x90, 0xe3, 0xbd, 0x44, 0x01, 0x79, 0xbf, 0x73, 0x68, 0x6a, 0x2b, 0xf1, 0xa8,
+	0x7f, 0xb4, 0xd3, 0xd5, 0xa4, 0x03, 0x59, 0x29, 0x6a, 0xf2, 0x81, 0xac, 0xc8, 0x5a, 0xc9, 0xf8,
+	0x57, 0x11, 0x48, 0x9b, 0x0d, 0xfd, 0x00, 0xe5, 0x85, 0x94, 0x7d, 0x77, 0xc1, 0xc2, 0x88, 0x7c,
+	0x02, 0xca, 0x28, 0x39, 0x03, 0x1e, 0x5b, 0x6d, 0xbd, 0x37, 0xf3, 0x80, 0x34, 0x63, 0x23, 0x26,
+	0x54, 0x62, 0xc9, 0x16, 0x5e, 0x0a, 0xf0, 0x52, 0xdb, 0xb9, 0x3d, 0xd3, 0x62, 0xf0, 0xc0, 0x78,
+	0x45, 0x25, 0x4c, 0x46, 0xe4, 0x3e, 0x54, 0x1d, 0x37, 0x88, 0xae, 0xac, 0x57, 0x17, 0xc7, 0xc7,
+	0x2c, 0x40, 0xf5, 0x54, 0xa9, 0x8a, 0xb4, 0x5d, 0x24, 0x91, 0x7b, 0xa0, 0x86, 0xfe, 0x45, 0x30,
+	0x64, 0x56, 0xc4, 0x2e, 0x23, 0xbd, 0xb8, 0x25, 0x6d, 0x2b, 0x14, 0x38, 0x69, 0xc0, 0x2e, 0x23,
+	0xf2, 0x21, 0x40, 0xc0, 0x8e, 0x59, 0xc0, 0xbc, 0x21, 0x0b, 0x75, 0x99, 0xaf, 0x8f, 0x29, 0xe4,
+	0x63, 0x20, 0x91, 0x1d, 0x9c, 0xb0, 0xc8, 0x72, 0xd8, 0xb1, 0xeb, 0xb9, 0x78, 0x26, 0xbd, 0x8c,
+	0x7c, 0xeb, 0x7c, 0xa5, 0x3d, 0x5e, 0x88, 0x2d, 0x78, 0xec, 0x8e, 0x22, 0x16, 0xe8, 0xa5, 0xad,
+	0x62, 0x6c, 0x41, 0x3e, 0x23, 0x4f, 0x60, 0x9d, 0x5d, 0x46, 0xcc, 0x73, 0x42, 0xcb, 0x7f, 0xc3,
+	0x82, 0xc0, 0x75, 0x58, 0xa8, 0xaf, 0x22, 0x8a, 0x96, 0x2c, 0xf4, 0x52, 0x3a, 0xd9, 0x02, 0xd5,
+	0x71, 0xed, 0x13, 0xcf, 0x0f, 0x23, 0x77, 0x18, 0xea, 0x0a, 0xb2, 0x89, 0x24, 0xf2, 0x33, 0x50,
+	0x42, 0xcf, 0x3d, 0x3f, 0x67, 0x51, 0xa8, 0x57, 0x50, 0x7f, 0x77, 0x73, 0xfa, 0xeb, 0x27, 0x8b,
+	0x89, 0xc2, 0x92, 0x99, 0xf1, 0x14, 0x94, 0x54, 0x8d, 0xa4, 0x0e, 0xea, 0x37, 0x9d, 0xc1, 0x6f,
+	0x3a, 0x5d, 0x0b, 0x4d, 0xbd, 0x12, 0x13, 0x76, 0x68, 0xef, 0x45, 0xb7, 0xcd, 0x09, 0x92, 0xf1,
+	0x0f, 0x00, 0x2d, 0x67, 0x88, 0xf3, 0xd1, 0xd5, 0x6d, 0xac, 0x3d, 0x61, 0x03, 0x6e, 0x25, 0xd1,
+	0x06, 0x0d, 0x50, 0x98, 0x37, 0xf4, 0x1d, 0xd7, 0x3b, 0x41, 0x0b, 0x55, 0x68, 0x36, 0x8f, 0x5d,
+	0x25, 0xb3, 0x86, 0x2e, 0x6f, 0x15, 0xb7, 0xd5, 0xd6, 0xc3, 0xf9, 0xae, 0x72, 0x3e, 0xba, 0x6a,
+	0xd2, 0x94, 0x9d, 0x8e, 0x77, 0x92, 0x2f, 0x00, 0xc6, 0xfa, 0x43, 0xdb, 0xa8, 0xad, 0x0f, 0x67,
+	0xc5, 0x45, 0x3b, 0xe3, 0xa2, 0xc2, 0x0e, 0xf2, 0x05, 0x94, 0x3c, 0x3f, 0xb6, 0x59, 0x1d, 0xb7,
+	0x6e, 0x5f, 0x7f, 0x84, 0x6e, 0xcc, 0x6a, 0x7a, 0x51, 0x70, 0x45, 0xf9, 0x36, 0xe2, 0xc2, 0xc6,
+	0xd8, 0x7f, 0xac, 0x54, 0x35, 0xa1, 0xae, 0x21, 0xdc, 0xcf, 0xaf, 0x87, 0x1b, 0x3b, 0x58, 0xaa,
+	0xdd, 0x04, 0xfc, 0x8e, 0x33, 0xbd, 0x42, 0xfe, 0x30, 0xcb, 0xd5, 0xd6, 0x51, 0xce, 0xf3, 0xeb,
+	0xe5, 0x98, 0x13, 0x8e, 0xc8, 0x85, 0x4c, 0xf9, 0x67, 0xe3, 0x7b, 0x09, 0x2a, 0x99, 0x96, 0xc9,
+	0x03, 0xa8, 0x25, 0x11, 0x92, 0xe4, 0xae, 0x02, 0x9a, 0xb0, 0xca, 0x89, 0x03, 0xa4, 0x11, 0x92,
+	0x64, 0x30, 0x6e, 0x5e, 0x1c, 0xc7, 0x31, 0x31, 0x15, 0x5a, 0x18, 0x81, 0x15, 0xaa, 0x4d, 0x46,
+	0xd6, 0xcd, 0x52, 0xda, 0x81, 0xac, 0x48, 0x5a, 0xe1, 0x40, 0x56, 0x40, 0x53, 0x0f, 0x64, 0x45,
+	0xd5, 0xaa, 0x8d, 0x3f, 0x15, 0x40, 0x49, 0x6f, 0x80, 0x79, 0x16, 0x05, 0x64, 0x79, 0x16, 0x67,
+	0xb3, 0x4f, 0x54, 0x9a, 0x73, 0xa2, 0x5f, 0xe5, 0x92, 0xf2, 0x93, 0xeb, 0x55, 0x9b, 0x8a, 0x16,
+	0xb3, 0xb4, 0x09, 0xb5, 0x33, 0x3b, 0x38, 0x65, 0x8e, 0xc5, 0x63, 0x01, 0xef, 0xae, 0xb6, 0xb6,
+	0x66, 0xdd, 0xed, 0x2b, 0x64, 0xec, 0x23, 0x1f, 0xad, 0x9e, 0x09, 0x33, 0xc3, 0x48, 0xd2, 0x77,
+	0x0d, 0x2a, 0xbd, 0xaf, 0x4d, 0x4a, 0x3b, 0x6d, 0xb3, 0xaf, 0xad, 0x10, 0x15, 0x56, 0xcd, 0x97,
+	0x03, 0xb3, 0xdb, 0xee, 0xa7, 0x69, 0xbc, 0xd1, 0x83, 0xca, 0x38, 0xc9, 0xec, 0x82, 0x92, 0xba,
+	0x87, 0x2e, 0xa1, 0x77, 0x7c, 0xb4, 0xdc, 0x15, 0x68, 0xb6, 0xaf, 0xf1, 0x35, 0xc0, 0xd8, 0xd5,
+	0x89, 0x06, 0xc5, 0x53, 0x76, 0x95, 0xa8, 0x34, 0x1e, 0x92, 0x16, 0x94, 0xde, 0xd8, 0xa3, 0x0b,
+	0x86, 0x3a, 0x52, 0x5b, 0x3f, 0x9e, 0x75, 0xb3, 0x18, 0xa0, 0xe3, 0x1d, 0xfb, 0x94, 0xb3, 0x7e,
+	0x56, 0xf8, 0x54, 0x6a, 0x7c, 0x0b, 0xfa, 0x3c, 0x9f, 0x9f, 0x21, 0xe5, 0x51, 0x5e, 0xca, 0x9d,
+	0x9c, 0x94, 0x1d, 0x6f, 0xf8, 0xda, 0x0f, 0x44, 0xf0, 0x11, 0xbc, 0x37, 0xd3, 0xd1, 0x67, 0x20,
+	0x7f, 0x9e, 0x47, 0x7e, 0xb8, 0x9c, 0x82, 0x42, 0x41, 0x9a, 0xf1, 0x17, 0x05, 0x36, 0xf7, 0x02,
+	0x3f, 0x0c, 0xb3, 0x80, 0xc9, 0x0a, 0xa7, 0x58, 0xed, 0x8b, 0x42, 0xb5, 0xff, 0x16, 0xea, 0x42,
+	0xae, 0x10, 0x7c, 0xac, 0x95, 0x93, 0x3f, 0x1b, 0x55, 0x48, 0x16, 0xe8, 0x6a, 0x6b, 0x4e, 0x6e,
+	0x4e, 0x7e, 0x0f, 0x9a, 0xc3, 0x86, 0x23, 0x3b, 0xb0, 0xc7, 0xe8, 0xab, 0x88, 0xfe, 0x7c, 0x39,
+	0xf4, 0x6c, 0x2f, 0xc2, 0xd7, 0x9d, 0x3c, 0x81, 0xbc, 0x84, 0xb5, 0x2c, 0xeb, 0x5a, 0x59, 0xc8,
+	0xaf, 0xb5, 0x3e, 0x59, 0x06, 0x3d, 0xa3, 0x20, 0x76, 0x2d, 0x10, 0xa7, 0xa4, 0x07, 0xea, 0xd0,
+	0x1e, 0x8d, 0x58, 0xc0, 0x61, 0xab, 0x08, 0xdb, 0x5c, 0x06, 0x76, 0x0f, 0xb7, 0x21, 0x26, 0x0c,
+	0xb3, 0xf1, 0xdc, 0x5a, 0xfd, 0x18, 0xd6, 0x03, 0x36, 0xb2, 0x23, 0xe6, 0x58, 0x9e, 0xef, 0x24,
+	0xb7, 0x58, 0x43, 0x96, 0x7a, 0xb2, 0x10, 0x3b, 0x2d, 0x62, 0xdc, 0x03, 0xd5, 0x46, 0x0f, 0xe3,
+	0xb5, 0x8d, 0xf7, 0x05, 0xc0, 0x49, 0x58, 0xdb, 0x1e, 0x81, 0x86, 0x20, 0x62, 0xf7, 0xc0, 0x0b,
+	0x7a, 0x3d, 0xa6, 0x8b, 0xbd, 0xc3, 0xfb, 0x50, 0x39, 0xb7, 0x4f, 0x98, 0x15, 0xba, 0xef, 0x18,
+	0x76, 0x45, 0x25, 0xaa, 0xc4, 0x84, 0xbe, 0xfb, 0x8e, 0x91, 0x0f, 0x00, 0x70, 0x31, 0xf2, 0x4f,
+	0x99, 0xa7, 0xab, 0xe8, 0xa3, 0xc8, 0x3e, 0x88, 0x09, 0xb9, 0x86, 0xa0, 0xb6, 0x7c, 0x43, 0xf0,
+	0x1a, 0xd6, 0xf2, 0xfe, 0x42, 0x08, 0xac, 0x75, 0x7b, 0x56, 0xdb, 0xdc, 0xef, 0x74, 0x3b, 0x83,
+	0x4e, 0xaf, 0x1b, 0xa7, 0x92, 0x3b, 0x50, 0xdf, 0x39, 0x3c, 0xcc, 0x11, 0x25, 0xb2, 0x01, 0xda,
+	0xfe, 0x8b, 0x09, 0x6a, 0x81, 0xfc, 0x08, 0xee, 0xec, 0x76, 0xba, 0xed, 0x4e, 0xf7, 0xcb, 0xdc,
+	0x42, 0xd1, 0xf8, 0x25, 0xd4, 0x27, 0x7c, 0x27, 0x86, 0x45, 0x51, 0x7b, 0x87, 0x3b, 0x74, 0x27,
+	0x95, 0xb5, 0x01, 0x1a, 0x97, 0x25, 0x50, 0x25, 0xe3, 0x1d, 0xd4, 0x72, 0xbe, 0x41, 0xd6, 0xa1,
+	0xd6, 0xed, 0x59, 0xd4, 0xdc, 0x37, 0xa9, 0xd9, 0xdd, 0x33, 0x93, 0x53, 0xee, 0xc5, 0x5b, 0x05,
+	0xa2, 0x14, 0x9f, 0xa7, 0xdb, 0xeb, 0x5a, 0x93, 0x0b, 0x85, 0xf8, 0x9e, 0x13, 0xb4, 0x62, 0x2c,
+	0xfb, 0x1b, 0xda, 0x19, 0x98, 0x22, 0x55, 0x36, 0xf6, 0x01, 0xc6, 0x0e, 0x44, 0xd6, 0x00, 0xba,
+	0x3d, 0xc4, 0x33, 0x69, 0x2c, 0x95, 0xc0, 0x5a, 0xbb, 0x43, 0xcd, 0xbd, 0x41, 0x46, 0x43, 0xd5,
+	0xa4, 0x99, 0x38, 0xa3, 0x16, 0x78, 0x13, 0x7d, 0x20, 0x2b, 0x8e, 0xc6, 0x8c, 0xff, 0x4a, 0x50,
+	0xe6, 0x99, 0x69, 0x6e, 0xcf, 0x4f, 0x84, 0xf2, 0x92, 0x56, 0xcc, 0x4d, 0x28, 0x9f, 0xdb, 0x01,
+	0xf3, 0xa2, 0xa4, 0x8e, 0x26, 0xb3, 0xac, 0x38, 0xc2, 0x32, 0xc5, 0x31, 0x46, 0xce, 0x9c, 0xb5,
+	0x42, 0x71, 0x4c, 0x74, 0x58, 0x4d, 0x9c, 0x02, 0xb3, 0x41, 0x85, 0xa6, 0x53, 0xf2, 0x0b, 0xa8,
+	0x26, 0x43, 0x0b, 0x65, 0xa8, 0x0b, 0x64, 0xa8, 0x09, 0x77, 0x9f, 0xd7, 0x61, 0x7e, 0xe7, 0x92,
+	0x56, 0x3e, 0x90, 0x15, 0x45, 0xab, 0x1c, 0xc8, 0x4a, 0x45, 0x03, 0x63, 0x00, 0x95, 0xa3, 0xc0,
+	0xf5, 0x22, 0xfb, 0xd5, 0x88, 0x91, 0xbb, 0xa0, 0x04, 0xf6, 0x5b, 0x1e, 0x40, 0x5c, 0x07, 0xab,
+	0x81, 0xfd, 0x16, 0xa3, 0xe7, 0x29, 0xc8, 0x23, 0xd7, 0x3b, 0xd5, 0x0b, 0x5b, 0xc5, 0x79, 0x42,
+	0x0f, 0x5d, 0xef, 0x94, 0x22, 0x97, 0xf1, 0xd7, 0x3a, 0x6c, 0x4c, 0xa5, 0x80, 0xb8, 0x69, 0xfd,
+	0x1c, 0x4a, 0x91, 0x1f, 0xd9, 0x23, 0xbd, 0x34, 0x23, 0x8f, 0xcf, 0xda, 0xd1, 0x1c, 0xc4, 0xec,
+	0x94, 0xef, 0x22, 0x36, 0x68, 0xc3, 0x98, 0xc9, 0x12, 0x5e, 0x0a, 0xd2, 0x8c, 0xc6, 0x6d, 0x26,
+	0xd2, 0x04, 0x91, 0xf7, 0x54, 0xf5, 0x61, 0x9e, 0x4a, 0x76, 0xd3, 0xfe, 0x92, 0xdf, 0xf4, 0xe9,
+	0x62, 0xdc, 0xe9, 0x1e, 0xf3, 0x6c, 0x4e, 0x8f, 0x59, 0x44, 0xc8, 0xcf, 0x16, 0x43, 0xde, 0xac,
+	0xcf, 0xfc, 0x08, 0xea, 0x1e, 0xbb, 0x8c, 0x2c, 0x21, 0x2d, 0x01, 0x5a, 0xaf, 0x16, 0x93, 0x8f,
+	0xd2, 0xd4, 0xd4, 0x70, 0x40, 0xa5, 0xe3, 0xac, 0x39, 0xd7, 0xdf, 0x1f, 0x40, 0x0d, 0x93, 0x6b,
+	0xae, 0xe6, 0x55, 0x68, 0x35, 0x25, 0x62, 0xf4, 0xe9, 0xb0, 0xea, 0x07, 0x8e, 0xeb, 0xd9, 0x23,
+	0x8c, 0x80, 0x12, 0x4d, 0xa7, 0x8d, 0x7f, 0x4a, 0x50, 0x4b, 0xc4, 0x24, 0x81, 0xf5, 0x04, 0xca,
+	0x3c, 0x0f, 0xeb, 0xd2, 0xfc, 0xbe, 0x20, 0x61, 0x99, 0xee, 0xc5, 0x4a, 0xb7, 0xe9, 0xc5, 0xc8,
+	0x43, 0x90, 0x43, 0x37, 0x62, 0x89, 0xca, 0x67, 0x4a, 0x44, 0x06, 0x41, 0x0b, 0xb2, 0xa8, 0x85,
+	0x03, 0x59, 0x29, 0x68, 0xc5, 0xc6, 0xf7, 0x32, 0xac, 0xe7, 0x2d, 0xd4, 0x67, 0xd1, 0x5c, 0xcd,
+	0x4d, 0x9d, 0x5d, 0xb9, 0xd5, 0xd9, 0x7b, 0x00, 0x42, 0xd7, 0xcb, 0xfd, 0xf0, 0xd9, 0x62, 0xa7,
+	0xc9, 0x29, 0x9d, 0x0a, 0x10, 0xe4, 0x77, 0xa0, 0x0a, 0xdd, 0x81, 0x5e, 0xba, 0x1d, 0xa2, 0x88,
+	0x41, 0xbe, 0x12, 0x5f, 0x83, 0xc5, 0xdb, 0x01, 0x8e, 0x11, 0xc8, 0x97, 0x50, 0xe6, 0xfd, 0x80,
+	0x5e, 0xbe, 0x1d, 0x56, 0xb2, 0x9d, 0x1c, 0x41, 0x55, 0x6c, 0x19, 0x74, 0x40, 0xb8, 0x8f, 0x97,
+	0x86, 0x8b, 0x23, 0x83, 0xaa, 0x42, 0x73, 0x11, 0x87, 0xc3, 0x19, 0x0b, 0x4e, 0x98, 0x93, 0xbe,
+	0xaa, 0x54, 0x6c, 0x40, 0xaa, 0x9c, 0x38, 0x48, 0xbd, 0x85, 0xa7, 0xd7, 0x55, 0x4d, 0x69, 0xfc,
+	0xbb, 0x00, 0x25, 0xcc, 0x5a, 0xf8, 0x7d, 0x20, 0x74, 0x1b, 0xb1, 0xb3, 0x14, 0xa9, 0x48, 0x22,
+	0x06, 0x54, 0x05, 0xad, 0x86, 0x18, 0x6a, 0x45, 0x9a, 0xa3, 0x4d, 0x7c, 0x8c, 0x14, 0x91, 0x43,
+	0xa0, 0x90, 0x9f, 0x40, 0xcd, 0xf1, 0x87, 0x17, 0x67, 0xcc, 0x8b, 0xec, 0xec, 0xe5, 0x56, 0xa4,
+	0x79, 0x62, 0x1c, 0xb0, 0x5c, 0x45, 0x21, 0x46, 0x54, 0x91, 0xa6, 0x53, 0xf2, 0x47, 0xb8, 0x2b,
+	0xaa, 0x2c, 0xb4, 0x5e, 0x5d, 0x59, 0x69, 0xac, 0x27, 0xe6, 0xd8, 0x5b, 0x32, 0x4f, 0x8b, 0x5a,
+	0x0c, 0x77, 0xaf, 0x68, 0x82, 0xc2, 0x73, 0xd7, 0x66, 0x30, 0x73, 0xb1, 0xd1, 0x81, 0xf7, 0xaf,
+	0xd9, 0x36, 0xe3, 0x31, 0xb0, 0x21, 0x3e, 0x06, 0x8a, 0xe2, 0x8b, 0xe2, 0xed, 0x54, 0xd9, 0x99,
+	0x87, 0xd1, 0xc9, 0x3f, 0x28, 0x9e, 0xdf, 0xb4, 0x7c, 0xf4, 0x59, 0x24, 0x0a, 0xfe, 0x21, 0xbe,
+	0xbf, 0x8c, 0xef, 0x60, 0xa3, 0x2d, 0xfa, 0xc8, 0xa2, 0xe7, 0xd0, 0xb8, 0x4d, 0x2f, 0xe4, 0xda,
+	0xf4, 0x47, 0xa0, 0xb9, 0xde, 0x70, 0x74, 0xe1, 0x30, 0x6b, 0xf8, 0xda, 0x1d, 0x39, 0x01, 0xf3,
+	0x92, 0xff, 0xbd, 0x7a, 0x42, 0xdf, 0x4b, 0xc8, 0xc6, 0xdf, 0x4b, 0x40, 0x26, 0x64, 0xc6, 0x6d,
+	0x41, 0x1b, 0x94, 0xd4, 0x5b, 0x75, 0x69, 0xd6, 0xbf, 0xce, 0xd4, 0x96, 0x8c, 0x44, 0xb3, 0x9d,
+	0xe4, 0xd7, 0xf9, 0xd2, 0xfd, 0x78, 0x11, 0xc4, 0x74, 0xe1, 0x3e, 0xbd, 0xb6, 0x70, 0x7f, 0xba,
+	0xf0, 0x4c, 0x37, 0x29, 0xdb, 0x8d, 0xff, 0x48, 0xa0, 0xa4, 0x20, 0x73, 0x4b, 0xca, 0xe3, 0xa4,
+	0x45, 0xe4, 0x16, 0xdd, 0xcc, 0x9d, 0x20, 0x6b, 0xdc, 0x92, 0xd6, 0xf1, 0xff, 0x54, 0x7e, 0xda,
+	0xa0, 0x64, 0x66, 0xac, 0xdc, 0xd4, 0x18, 0xe9, 0x4e, 0xf1, 0xbf, 0x3a, 0x6b, 0x3b, 0xcb, 0xda,
+	0x6a, 0x92, 0x23, 0x7f, 0x88, 0xf1, 0xf2, 0xf8, 0xa7, 0x50, 0x15, 0x5f, 0x6f, 0xf1, 0xe7, 0x7c,
+	0xb7, 0xd7, 0x35, 0xf9, 0x17, 0x4f, 0xdb, 0xdc, 0xdf, 0x79, 0x71, 0x38, 0xd0, 0xa4, 0xd6, 0x9f,
+	0x0b, 0xa0, 0xbe, 0xa4, 0xec, 0xb8, 0xcf, 0x82, 0x37, 0x2e, 0x96, 0x73, 0x55, 0xf8, 0xa1, 0x20,
+	0xf7, 0x16, 0xfc, 0xaf, 0x37, 0x3e, 0xb8, 0xf6, 0x73, 0xc3, 0x58, 0x89, 0xbf, 0x25, 0x26, 0x32,
+	0x14, 0x79, 0xb0, 0xc4, 0xeb, 0xbb, 0x71, 0x7f, 0x61, 0x92, 0x33, 0x56, 0xc8, 0x0b, 0xa8, 0xe5,
+	0x0c, 0x4c, 0xee, 0x5f, 0x67, 0x7c, 0x0e, 0x7c, 0x6f, 0x81, 0x7f, 0x18, 0x2b, 0xbb, 0x0f, 0xe0,
+	0xde, 0xd0, 0x3f, 0x6b, 0x9e, 0xf8, 0xfe, 0xc9, 0x88, 0x35, 0x1d, 0xf6, 0x26, 0xf2, 0xfd, 0x51,
+	0x28, 0xee, 0x3b, 0x92, 0x5e, 0x95, 0x71, 0xf0, 0xfc, 0x7f, 0x03, 0x00, 0xdc, 0x3a, 0x13, 0x99,
+	0xca, 0x19, 0x00, 0x00,
 }
@@ -45,7 +45,7 @@ func (x FileDecorations_TargetOverride_Kind) String() string {
 	return proto.EnumName(FileDecorations_TargetOverride_Kind_name, int32(x))
 }
 func (FileDecorations_TargetOverride_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 3, 0}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 3, 0}
 }
 
 type CrossReferences_Callsite_Kind int32
@@ -71,7 +71,7 @@ func (x CrossReferences_Callsite_Kind) String() string {
 	return proto.EnumName(CrossReferences_Callsite_Kind_name, int32(x))
 }
 func (CrossReferences_Callsite_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1, 4, 0}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1, 4, 0}
 }
 
 type FileDecorations struct {
@@ -96,7 +96,7 @@ func (m *FileDecorations) Reset()         { *m = FileDecorations{} }
 func (m *FileDecorations) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations) ProtoMessage()    {}
 func (*FileDecorations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0}
 }
 func (m *FileDecorations) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations.Unmarshal(m, b)
@@ -453,7 +453,7 @@ func (m *FileDecorations_Index) Reset()         { *m = FileDecorations_Index{} }
 func (m *FileDecorations_Index) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_Index) ProtoMessage()    {}
 func (*FileDecorations_Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 0}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 0}
 }
 func (m *FileDecorations_Index) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_Index.Unmarshal(m, b)
@@ -493,7 +493,7 @@ func (m *FileDecorations_Text) Reset()         { *m = FileDecorations_Text{} }
 func (m *FileDecorations_Text) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_Text) ProtoMessage()    {}
 func (*FileDecorations_Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 1}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 1}
 }
 func (m *FileDecorations_Text) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_Text.Unmarshal(m, b)
@@ -551,7 +551,7 @@ func (m *FileDecorations_Target) Reset()         { *m = FileDecorations_Target{}
 func (m *FileDecorations_Target) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_Target) ProtoMessage()    {}
 func (*FileDecorations_Target) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 2}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 2}
 }
 func (m *FileDecorations_Target) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_Target.Unmarshal(m, b)
@@ -705,7 +705,7 @@ func (m *FileDecorations_TargetOverride) Reset()         { *m = FileDecorations_
 func (m *FileDecorations_TargetOverride) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_TargetOverride) ProtoMessage()    {}
 func (*FileDecorations_TargetOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 3}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 3}
 }
 func (m *FileDecorations_TargetOverride) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_TargetOverride.Unmarshal(m, b)
@@ -757,7 +757,7 @@ func (m *FileDecorations_TargetNode) Reset()         { *m = FileDecorations_Targ
 func (m *FileDecorations_TargetNode) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_TargetNode) ProtoMessage()    {}
 func (*FileDecorations_TargetNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 4}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 4}
 }
 func (m *FileDecorations_TargetNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_TargetNode.Unmarshal(m, b)
@@ -796,7 +796,7 @@ func (m *FileDecorations_TargetDefinition) Reset()         { *m = FileDecoration
 func (m *FileDecorations_TargetDefinition) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_TargetDefinition) ProtoMessage()    {}
 func (*FileDecorations_TargetDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 5}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 5}
 }
 func (m *FileDecorations_TargetDefinition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_TargetDefinition.Unmarshal(m, b)
@@ -841,7 +841,7 @@ func (m *FileDecorations_DefinitionLocation) Reset()         { *m = FileDecorati
 func (m *FileDecorations_DefinitionLocation) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_DefinitionLocation) ProtoMessage()    {}
 func (*FileDecorations_DefinitionLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 6}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 6}
 }
 func (m *FileDecorations_DefinitionLocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_DefinitionLocation.Unmarshal(m, b)
@@ -880,7 +880,7 @@ func (m *FileDecorations_Override) Reset()         { *m = FileDecorations_Overri
 func (m *FileDecorations_Override) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_Override) ProtoMessage()    {}
 func (*FileDecorations_Override) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 7}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 7}
 }
 func (m *FileDecorations_Override) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_Override.Unmarshal(m, b)
@@ -925,7 +925,7 @@ func (m *FileDecorations_Diagnostic) Reset()         { *m = FileDecorations_Diag
 func (m *FileDecorations_Diagnostic) String() string { return proto.CompactTextString(m) }
 func (*FileDecorations_Diagnostic) ProtoMessage()    {}
 func (*FileDecorations_Diagnostic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{0, 8}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{0, 8}
 }
 func (m *FileDecorations_Diagnostic) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileDecorations_Diagnostic.Unmarshal(m, b)
@@ -971,7 +971,7 @@ func (m *CrossReferences) Reset()         { *m = CrossReferences{} }
 func (m *CrossReferences) String() string { return proto.CompactTextString(m) }
 func (*CrossReferences) ProtoMessage()    {}
 func (*CrossReferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1}
 }
 func (m *CrossReferences) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferences.Unmarshal(m, b)
@@ -1240,7 +1240,7 @@ func (m *CrossReferences_Index) Reset()         { *m = CrossReferences_Index{} }
 func (m *CrossReferences_Index) String() string { return proto.CompactTextString(m) }
 func (*CrossReferences_Index) ProtoMessage()    {}
 func (*CrossReferences_Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1, 0}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1, 0}
 }
 func (m *CrossReferences_Index) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferences_Index.Unmarshal(m, b)
@@ -1296,7 +1296,7 @@ func (m *CrossReferences_Reference) Reset()         { *m = CrossReferences_Refer
 func (m *CrossReferences_Reference) String() string { return proto.CompactTextString(m) }
 func (*CrossReferences_Reference) ProtoMessage()    {}
 func (*CrossReferences_Reference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1, 1}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1, 1}
 }
 func (m *CrossReferences_Reference) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferences_Reference.Unmarshal(m, b)
@@ -1440,7 +1440,7 @@ func (m *CrossReferences_Relation) Reset()         { *m = CrossReferences_Relati
 func (m *CrossReferences_Relation) String() string { return proto.CompactTextString(m) }
 func (*CrossReferences_Relation) ProtoMessage()    {}
 func (*CrossReferences_Relation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1, 2}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1, 2}
 }
 func (m *CrossReferences_Relation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferences_Relation.Unmarshal(m, b)
@@ -1594,7 +1594,7 @@ func (m *CrossReferences_Caller) Reset()         { *m = CrossReferences_Caller{}
 func (m *CrossReferences_Caller) String() string { return proto.CompactTextString(m) }
 func (*CrossReferences_Caller) ProtoMessage()    {}
 func (*CrossReferences_Caller) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1, 3}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1, 3}
 }
 func (m *CrossReferences_Caller) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferences_Caller.Unmarshal(m, b)
@@ -1648,7 +1648,7 @@ func (m *CrossReferences_Callsite) Reset()         { *m = CrossReferences_Callsi
 func (m *CrossReferences_Callsite) String() string { return proto.CompactTextString(m) }
 func (*CrossReferences_Callsite) ProtoMessage()    {}
 func (*CrossReferences_Callsite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1, 4}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1, 4}
 }
 func (m *CrossReferences_Callsite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferences_Callsite.Unmarshal(m, b)
@@ -1690,17 +1690,18 @@ func (m *CrossReferences_Callsite) GetKind() CrossReferences_Callsite_Kind {
 }
 
 type CrossReferences_RelatedNode struct {
-	Node                 *schema_go_proto.Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Node                 *schema_go_proto.Node            `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	DefinitionLocation   *serving_go_proto.ExpandedAnchor `protobuf:"bytes,2,opt,name=definition_location,json=definitionLocation" json:"definition_location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *CrossReferences_RelatedNode) Reset()         { *m = CrossReferences_RelatedNode{} }
 func (m *CrossReferences_RelatedNode) String() string { return proto.CompactTextString(m) }
 func (*CrossReferences_RelatedNode) ProtoMessage()    {}
 func (*CrossReferences_RelatedNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_xref_serving_f95f76bb44dbecdd, []int{1, 5}
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{1, 5}
 }
 func (m *CrossReferences_RelatedNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CrossReferences_RelatedNode.Unmarshal(m, b)
@@ -1727,6 +1728,976 @@ func (m *CrossReferences_RelatedNode) GetNode() *schema_go_proto.Node {
 	return nil
 }
 
+func (m *CrossReferences_RelatedNode) GetDefinitionLocation() *serving_go_proto.ExpandedAnchor {
+	if m != nil {
+		return m.DefinitionLocation
+	}
+	return nil
+}
+
+type Documentation struct {
+	Source *storage_go_proto.VName `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	// Types that are valid to be assigned to Entry:
+	//	*Documentation_Index_
+	//	*Documentation_Child_
+	//	*Documentation_LinkNode_
+	Entry                isDocumentation_Entry `protobuf_oneof:"entry"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Documentation) Reset()         { *m = Documentation{} }
+func (m *Documentation) String() string { return proto.CompactTextString(m) }
+func (*Documentation) ProtoMessage()    {}
+func (*Documentation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{2}
+}
+func (m *Documentation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Documentation.Unmarshal(m, b)
+}
+func (m *Documentation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Documentation.Marshal(b, m, deterministic)
+}
+func (dst *Documentation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Documentation.Merge(dst, src)
+}
+func (m *Documentation) XXX_Size() int {
+	return xxx_messageInfo_Documentation.Size(m)
+}
+func (m *Documentation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Documentation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Documentation proto.InternalMessageInfo
+
+type isDocumentation_Entry interface {
+	isDocumentation_Entry()
+}
+
+type Documentation_Index_ struct {
+	Index *Documentation_Index `protobuf:"bytes,2,opt,name=index,oneof"`
+}
+type Documentation_Child_ struct {
+	Child *Documentation_Child `protobuf:"bytes,3,opt,name=child,oneof"`
+}
+type Documentation_LinkNode_ struct {
+	LinkNode *Documentation_LinkNode `protobuf:"bytes,4,opt,name=link_node,json=linkNode,oneof"`
+}
+
+func (*Documentation_Index_) isDocumentation_Entry()    {}
+func (*Documentation_Child_) isDocumentation_Entry()    {}
+func (*Documentation_LinkNode_) isDocumentation_Entry() {}
+
+func (m *Documentation) GetEntry() isDocumentation_Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *Documentation) GetSource() *storage_go_proto.VName {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *Documentation) GetIndex() *Documentation_Index {
+	if x, ok := m.GetEntry().(*Documentation_Index_); ok {
+		return x.Index
+	}
+	return nil
+}
+
+func (m *Documentation) GetChild() *Documentation_Child {
+	if x, ok := m.GetEntry().(*Documentation_Child_); ok {
+		return x.Child
+	}
+	return nil
+}
+
+func (m *Documentation) GetLinkNode() *Documentation_LinkNode {
+	if x, ok := m.GetEntry().(*Documentation_LinkNode_); ok {
+		return x.LinkNode
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Documentation) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Documentation_OneofMarshaler, _Documentation_OneofUnmarshaler, _Documentation_OneofSizer, []interface{}{
+		(*Documentation_Index_)(nil),
+		(*Documentation_Child_)(nil),
+		(*Documentation_LinkNode_)(nil),
+	}
+}
+
+func _Documentation_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Documentation)
+	// entry
+	switch x := m.Entry.(type) {
+	case *Documentation_Index_:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Index); err != nil {
+			return err
+		}
+	case *Documentation_Child_:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Child); err != nil {
+			return err
+		}
+	case *Documentation_LinkNode_:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LinkNode); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Documentation.Entry has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Documentation_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Documentation)
+	switch tag {
+	case 2: // entry.index
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Documentation_Index)
+		err := b.DecodeMessage(msg)
+		m.Entry = &Documentation_Index_{msg}
+		return true, err
+	case 3: // entry.child
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Documentation_Child)
+		err := b.DecodeMessage(msg)
+		m.Entry = &Documentation_Child_{msg}
+		return true, err
+	case 4: // entry.link_node
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Documentation_LinkNode)
+		err := b.DecodeMessage(msg)
+		m.Entry = &Documentation_LinkNode_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Documentation_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Documentation)
+	// entry
+	switch x := m.Entry.(type) {
+	case *Documentation_Index_:
+		s := proto.Size(x.Index)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Documentation_Child_:
+		s := proto.Size(x.Child)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Documentation_LinkNode_:
+		s := proto.Size(x.LinkNode)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Documentation_Index struct {
+	MarkedSource         *common_go_proto.MarkedSource `protobuf:"bytes,1,opt,name=marked_source,json=markedSource" json:"marked_source,omitempty"`
+	RawText              string                        `protobuf:"bytes,2,opt,name=raw_text,json=rawText" json:"raw_text,omitempty"`
+	Link                 []*common_go_proto.Link       `protobuf:"bytes,3,rep,name=link" json:"link,omitempty"`
+	DocumentedBy         *storage_go_proto.VName       `protobuf:"bytes,4,opt,name=documented_by,json=documentedBy" json:"documented_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *Documentation_Index) Reset()         { *m = Documentation_Index{} }
+func (m *Documentation_Index) String() string { return proto.CompactTextString(m) }
+func (*Documentation_Index) ProtoMessage()    {}
+func (*Documentation_Index) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{2, 0}
+}
+func (m *Documentation_Index) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Documentation_Index.Unmarshal(m, b)
+}
+func (m *Documentation_Index) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Documentation_Index.Marshal(b, m, deterministic)
+}
+func (dst *Documentation_Index) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Documentation_Index.Merge(dst, src)
+}
+func (m *Documentation_Index) XXX_Size() int {
+	return xxx_messageInfo_Documentation_Index.Size(m)
+}
+func (m *Documentation_Index) XXX_DiscardUnknown() {
+	xxx_messageInfo_Documentation_Index.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Documentation_Index proto.InternalMessageInfo
+
+func (m *Documentation_Index) GetMarkedSource() *common_go_proto.MarkedSource {
+	if m != nil {
+		return m.MarkedSource
+	}
+	return nil
+}
+
+func (m *Documentation_Index) GetRawText() string {
+	if m != nil {
+		return m.RawText
+	}
+	return ""
+}
+
+func (m *Documentation_Index) GetLink() []*common_go_proto.Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+func (m *Documentation_Index) GetDocumentedBy() *storage_go_proto.VName {
+	if m != nil {
+		return m.DocumentedBy
+	}
+	return nil
+}
+
+type Documentation_Child struct {
+	Child                *storage_go_proto.VName `protobuf:"bytes,1,opt,name=child" json:"child,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *Documentation_Child) Reset()         { *m = Documentation_Child{} }
+func (m *Documentation_Child) String() string { return proto.CompactTextString(m) }
+func (*Documentation_Child) ProtoMessage()    {}
+func (*Documentation_Child) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{2, 1}
+}
+func (m *Documentation_Child) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Documentation_Child.Unmarshal(m, b)
+}
+func (m *Documentation_Child) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Documentation_Child.Marshal(b, m, deterministic)
+}
+func (dst *Documentation_Child) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Documentation_Child.Merge(dst, src)
+}
+func (m *Documentation_Child) XXX_Size() int {
+	return xxx_messageInfo_Documentation_Child.Size(m)
+}
+func (m *Documentation_Child) XXX_DiscardUnknown() {
+	xxx_messageInfo_Documentation_Child.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Documentation_Child proto.InternalMessageInfo
+
+func (m *Documentation_Child) GetChild() *storage_go_proto.VName {
+	if m != nil {
+		return m.Child
+	}
+	return nil
+}
+
+type Documentation_LinkNode struct {
+	Node                 *serving_go_proto.Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Documentation_LinkNode) Reset()         { *m = Documentation_LinkNode{} }
+func (m *Documentation_LinkNode) String() string { return proto.CompactTextString(m) }
+func (*Documentation_LinkNode) ProtoMessage()    {}
+func (*Documentation_LinkNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{2, 2}
+}
+func (m *Documentation_LinkNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Documentation_LinkNode.Unmarshal(m, b)
+}
+func (m *Documentation_LinkNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Documentation_LinkNode.Marshal(b, m, deterministic)
+}
+func (dst *Documentation_LinkNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Documentation_LinkNode.Merge(dst, src)
+}
+func (m *Documentation_LinkNode) XXX_Size() int {
+	return xxx_messageInfo_Documentation_LinkNode.Size(m)
+}
+func (m *Documentation_LinkNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_Documentation_LinkNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Documentation_LinkNode proto.InternalMessageInfo
+
+func (m *Documentation_LinkNode) GetNode() *serving_go_proto.Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type Edges struct {
+	Source *storage_go_proto.VName `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	// Types that are valid to be assigned to Entry:
+	//	*Edges_Index_
+	//	*Edges_Edge_
+	//	*Edges_Target_
+	Entry                isEdges_Entry `protobuf_oneof:"entry"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Edges) Reset()         { *m = Edges{} }
+func (m *Edges) String() string { return proto.CompactTextString(m) }
+func (*Edges) ProtoMessage()    {}
+func (*Edges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{3}
+}
+func (m *Edges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Edges.Unmarshal(m, b)
+}
+func (m *Edges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Edges.Marshal(b, m, deterministic)
+}
+func (dst *Edges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Edges.Merge(dst, src)
+}
+func (m *Edges) XXX_Size() int {
+	return xxx_messageInfo_Edges.Size(m)
+}
+func (m *Edges) XXX_DiscardUnknown() {
+	xxx_messageInfo_Edges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Edges proto.InternalMessageInfo
+
+type isEdges_Entry interface {
+	isEdges_Entry()
+}
+
+type Edges_Index_ struct {
+	Index *Edges_Index `protobuf:"bytes,2,opt,name=index,oneof"`
+}
+type Edges_Edge_ struct {
+	Edge *Edges_Edge `protobuf:"bytes,3,opt,name=edge,oneof"`
+}
+type Edges_Target_ struct {
+	Target *Edges_Target `protobuf:"bytes,4,opt,name=target,oneof"`
+}
+
+func (*Edges_Index_) isEdges_Entry()  {}
+func (*Edges_Edge_) isEdges_Entry()   {}
+func (*Edges_Target_) isEdges_Entry() {}
+
+func (m *Edges) GetEntry() isEdges_Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *Edges) GetSource() *storage_go_proto.VName {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *Edges) GetIndex() *Edges_Index {
+	if x, ok := m.GetEntry().(*Edges_Index_); ok {
+		return x.Index
+	}
+	return nil
+}
+
+func (m *Edges) GetEdge() *Edges_Edge {
+	if x, ok := m.GetEntry().(*Edges_Edge_); ok {
+		return x.Edge
+	}
+	return nil
+}
+
+func (m *Edges) GetTarget() *Edges_Target {
+	if x, ok := m.GetEntry().(*Edges_Target_); ok {
+		return x.Target
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Edges) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Edges_OneofMarshaler, _Edges_OneofUnmarshaler, _Edges_OneofSizer, []interface{}{
+		(*Edges_Index_)(nil),
+		(*Edges_Edge_)(nil),
+		(*Edges_Target_)(nil),
+	}
+}
+
+func _Edges_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Edges)
+	// entry
+	switch x := m.Entry.(type) {
+	case *Edges_Index_:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Index); err != nil {
+			return err
+		}
+	case *Edges_Edge_:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Edge); err != nil {
+			return err
+		}
+	case *Edges_Target_:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Target); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Edges.Entry has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Edges_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Edges)
+	switch tag {
+	case 2: // entry.index
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Edges_Index)
+		err := b.DecodeMessage(msg)
+		m.Entry = &Edges_Index_{msg}
+		return true, err
+	case 3: // entry.edge
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Edges_Edge)
+		err := b.DecodeMessage(msg)
+		m.Entry = &Edges_Edge_{msg}
+		return true, err
+	case 4: // entry.target
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Edges_Target)
+		err := b.DecodeMessage(msg)
+		m.Entry = &Edges_Target_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Edges_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Edges)
+	// entry
+	switch x := m.Entry.(type) {
+	case *Edges_Index_:
+		s := proto.Size(x.Index)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Edges_Edge_:
+		s := proto.Size(x.Edge)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Edges_Target_:
+		s := proto.Size(x.Target)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Edges_Index struct {
+	Node                 *schema_go_proto.Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Edges_Index) Reset()         { *m = Edges_Index{} }
+func (m *Edges_Index) String() string { return proto.CompactTextString(m) }
+func (*Edges_Index) ProtoMessage()    {}
+func (*Edges_Index) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{3, 0}
+}
+func (m *Edges_Index) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Edges_Index.Unmarshal(m, b)
+}
+func (m *Edges_Index) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Edges_Index.Marshal(b, m, deterministic)
+}
+func (dst *Edges_Index) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Edges_Index.Merge(dst, src)
+}
+func (m *Edges_Index) XXX_Size() int {
+	return xxx_messageInfo_Edges_Index.Size(m)
+}
+func (m *Edges_Index) XXX_DiscardUnknown() {
+	xxx_messageInfo_Edges_Index.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Edges_Index proto.InternalMessageInfo
+
+func (m *Edges_Index) GetNode() *schema_go_proto.Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type Edges_Edge struct {
+	Target *storage_go_proto.VName `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
+	// Types that are valid to be assigned to Kind:
+	//	*Edges_Edge_KytheKind
+	//	*Edges_Edge_GenericKind
+	Kind                 isEdges_Edge_Kind `protobuf_oneof:"kind"`
+	Ordinal              int32             `protobuf:"varint,4,opt,name=ordinal" json:"ordinal,omitempty"`
+	Reverse              bool              `protobuf:"varint,5,opt,name=reverse" json:"reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Edges_Edge) Reset()         { *m = Edges_Edge{} }
+func (m *Edges_Edge) String() string { return proto.CompactTextString(m) }
+func (*Edges_Edge) ProtoMessage()    {}
+func (*Edges_Edge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{3, 1}
+}
+func (m *Edges_Edge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Edges_Edge.Unmarshal(m, b)
+}
+func (m *Edges_Edge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Edges_Edge.Marshal(b, m, deterministic)
+}
+func (dst *Edges_Edge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Edges_Edge.Merge(dst, src)
+}
+func (m *Edges_Edge) XXX_Size() int {
+	return xxx_messageInfo_Edges_Edge.Size(m)
+}
+func (m *Edges_Edge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Edges_Edge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Edges_Edge proto.InternalMessageInfo
+
+type isEdges_Edge_Kind interface {
+	isEdges_Edge_Kind()
+}
+
+type Edges_Edge_KytheKind struct {
+	KytheKind schema_go_proto.EdgeKind `protobuf:"varint,2,opt,name=kythe_kind,json=kytheKind,enum=kythe.proto.schema.EdgeKind,oneof"`
+}
+type Edges_Edge_GenericKind struct {
+	GenericKind string `protobuf:"bytes,3,opt,name=generic_kind,json=genericKind,oneof"`
+}
+
+func (*Edges_Edge_KytheKind) isEdges_Edge_Kind()   {}
+func (*Edges_Edge_GenericKind) isEdges_Edge_Kind() {}
+
+func (m *Edges_Edge) GetKind() isEdges_Edge_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (m *Edges_Edge) GetTarget() *storage_go_proto.VName {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *Edges_Edge) GetKytheKind() schema_go_proto.EdgeKind {
+	if x, ok := m.GetKind().(*Edges_Edge_KytheKind); ok {
+		return x.KytheKind
+	}
+	return schema_go_proto.EdgeKind_UNKNOWN_EDGE_KIND
+}
+
+func (m *Edges_Edge) GetGenericKind() string {
+	if x, ok := m.GetKind().(*Edges_Edge_GenericKind); ok {
+		return x.GenericKind
+	}
+	return ""
+}
+
+func (m *Edges_Edge) GetOrdinal() int32 {
+	if m != nil {
+		return m.Ordinal
+	}
+	return 0
+}
+
+func (m *Edges_Edge) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Edges_Edge) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Edges_Edge_OneofMarshaler, _Edges_Edge_OneofUnmarshaler, _Edges_Edge_OneofSizer, []interface{}{
+		(*Edges_Edge_KytheKind)(nil),
+		(*Edges_Edge_GenericKind)(nil),
+	}
+}
+
+func _Edges_Edge_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Edges_Edge)
+	// kind
+	switch x := m.Kind.(type) {
+	case *Edges_Edge_KytheKind:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.KytheKind))
+	case *Edges_Edge_GenericKind:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.GenericKind)
+	case nil:
+	default:
+		return fmt.Errorf("Edges_Edge.Kind has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Edges_Edge_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Edges_Edge)
+	switch tag {
+	case 2: // kind.kythe_kind
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Kind = &Edges_Edge_KytheKind{schema_go_proto.EdgeKind(x)}
+		return true, err
+	case 3: // kind.generic_kind
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Kind = &Edges_Edge_GenericKind{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Edges_Edge_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Edges_Edge)
+	// kind
+	switch x := m.Kind.(type) {
+	case *Edges_Edge_KytheKind:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.KytheKind))
+	case *Edges_Edge_GenericKind:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.GenericKind)))
+		n += len(x.GenericKind)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Edges_Target struct {
+	Node                 *schema_go_proto.Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Edges_Target) Reset()         { *m = Edges_Target{} }
+func (m *Edges_Target) String() string { return proto.CompactTextString(m) }
+func (*Edges_Target) ProtoMessage()    {}
+func (*Edges_Target) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{3, 2}
+}
+func (m *Edges_Target) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Edges_Target.Unmarshal(m, b)
+}
+func (m *Edges_Target) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Edges_Target.Marshal(b, m, deterministic)
+}
+func (dst *Edges_Target) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Edges_Target.Merge(dst, src)
+}
+func (m *Edges_Target) XXX_Size() int {
+	return xxx_messageInfo_Edges_Target.Size(m)
+}
+func (m *Edges_Target) XXX_DiscardUnknown() {
+	xxx_messageInfo_Edges_Target.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Edges_Target proto.InternalMessageInfo
+
+func (m *Edges_Target) GetNode() *schema_go_proto.Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type FileDirectory struct {
+	Directory *storage_go_proto.VName `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	// Types that are valid to be assigned to Entry:
+	//	*FileDirectory_Subdirectory_
+	//	*FileDirectory_File_
+	Entry                isFileDirectory_Entry `protobuf_oneof:"entry"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FileDirectory) Reset()         { *m = FileDirectory{} }
+func (m *FileDirectory) String() string { return proto.CompactTextString(m) }
+func (*FileDirectory) ProtoMessage()    {}
+func (*FileDirectory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{4}
+}
+func (m *FileDirectory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileDirectory.Unmarshal(m, b)
+}
+func (m *FileDirectory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileDirectory.Marshal(b, m, deterministic)
+}
+func (dst *FileDirectory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileDirectory.Merge(dst, src)
+}
+func (m *FileDirectory) XXX_Size() int {
+	return xxx_messageInfo_FileDirectory.Size(m)
+}
+func (m *FileDirectory) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileDirectory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileDirectory proto.InternalMessageInfo
+
+type isFileDirectory_Entry interface {
+	isFileDirectory_Entry()
+}
+
+type FileDirectory_Subdirectory_ struct {
+	Subdirectory *FileDirectory_Subdirectory `protobuf:"bytes,2,opt,name=subdirectory,oneof"`
+}
+type FileDirectory_File_ struct {
+	File *FileDirectory_File `protobuf:"bytes,3,opt,name=file,oneof"`
+}
+
+func (*FileDirectory_Subdirectory_) isFileDirectory_Entry() {}
+func (*FileDirectory_File_) isFileDirectory_Entry()         {}
+
+func (m *FileDirectory) GetEntry() isFileDirectory_Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *FileDirectory) GetDirectory() *storage_go_proto.VName {
+	if m != nil {
+		return m.Directory
+	}
+	return nil
+}
+
+func (m *FileDirectory) GetSubdirectory() *FileDirectory_Subdirectory {
+	if x, ok := m.GetEntry().(*FileDirectory_Subdirectory_); ok {
+		return x.Subdirectory
+	}
+	return nil
+}
+
+func (m *FileDirectory) GetFile() *FileDirectory_File {
+	if x, ok := m.GetEntry().(*FileDirectory_File_); ok {
+		return x.File
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*FileDirectory) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _FileDirectory_OneofMarshaler, _FileDirectory_OneofUnmarshaler, _FileDirectory_OneofSizer, []interface{}{
+		(*FileDirectory_Subdirectory_)(nil),
+		(*FileDirectory_File_)(nil),
+	}
+}
+
+func _FileDirectory_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*FileDirectory)
+	// entry
+	switch x := m.Entry.(type) {
+	case *FileDirectory_Subdirectory_:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Subdirectory); err != nil {
+			return err
+		}
+	case *FileDirectory_File_:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.File); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("FileDirectory.Entry has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _FileDirectory_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*FileDirectory)
+	switch tag {
+	case 2: // entry.subdirectory
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FileDirectory_Subdirectory)
+		err := b.DecodeMessage(msg)
+		m.Entry = &FileDirectory_Subdirectory_{msg}
+		return true, err
+	case 3: // entry.file
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FileDirectory_File)
+		err := b.DecodeMessage(msg)
+		m.Entry = &FileDirectory_File_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _FileDirectory_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*FileDirectory)
+	// entry
+	switch x := m.Entry.(type) {
+	case *FileDirectory_Subdirectory_:
+		s := proto.Size(x.Subdirectory)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FileDirectory_File_:
+		s := proto.Size(x.File)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type FileDirectory_Subdirectory struct {
+	Subdirectory         *storage_go_proto.VName `protobuf:"bytes,1,opt,name=subdirectory" json:"subdirectory,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *FileDirectory_Subdirectory) Reset()         { *m = FileDirectory_Subdirectory{} }
+func (m *FileDirectory_Subdirectory) String() string { return proto.CompactTextString(m) }
+func (*FileDirectory_Subdirectory) ProtoMessage()    {}
+func (*FileDirectory_Subdirectory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{4, 0}
+}
+func (m *FileDirectory_Subdirectory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileDirectory_Subdirectory.Unmarshal(m, b)
+}
+func (m *FileDirectory_Subdirectory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileDirectory_Subdirectory.Marshal(b, m, deterministic)
+}
+func (dst *FileDirectory_Subdirectory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileDirectory_Subdirectory.Merge(dst, src)
+}
+func (m *FileDirectory_Subdirectory) XXX_Size() int {
+	return xxx_messageInfo_FileDirectory_Subdirectory.Size(m)
+}
+func (m *FileDirectory_Subdirectory) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileDirectory_Subdirectory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileDirectory_Subdirectory proto.InternalMessageInfo
+
+func (m *FileDirectory_Subdirectory) GetSubdirectory() *storage_go_proto.VName {
+	if m != nil {
+		return m.Subdirectory
+	}
+	return nil
+}
+
+type FileDirectory_File struct {
+	File                 *storage_go_proto.VName `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *FileDirectory_File) Reset()         { *m = FileDirectory_File{} }
+func (m *FileDirectory_File) String() string { return proto.CompactTextString(m) }
+func (*FileDirectory_File) ProtoMessage()    {}
+func (*FileDirectory_File) Descriptor() ([]byte, []int) {
+	return fileDescriptor_xref_serving_e26c3881f14b57e8, []int{4, 1}
+}
+func (m *FileDirectory_File) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileDirectory_File.Unmarshal(m, b)
+}
+func (m *FileDirectory_File) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileDirectory_File.Marshal(b, m, deterministic)
+}
+func (dst *FileDirectory_File) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileDirectory_File.Merge(dst, src)
+}
+func (m *FileDirectory_File) XXX_Size() int {
+	return xxx_messageInfo_FileDirectory_File.Size(m)
+}
+func (m *FileDirectory_File) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileDirectory_File.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileDirectory_File proto.InternalMessageInfo
+
+func (m *FileDirectory_File) GetFile() *storage_go_proto.VName {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FileDecorations)(nil), "kythe.proto.serving.xrefs.FileDecorations")
 	proto.RegisterType((*FileDecorations_Index)(nil), "kythe.proto.serving.xrefs.FileDecorations.Index")
@@ -1745,84 +2716,116 @@ func init() {
 	proto.RegisterType((*CrossReferences_Caller)(nil), "kythe.proto.serving.xrefs.CrossReferences.Caller")
 	proto.RegisterType((*CrossReferences_Callsite)(nil), "kythe.proto.serving.xrefs.CrossReferences.Callsite")
 	proto.RegisterType((*CrossReferences_RelatedNode)(nil), "kythe.proto.serving.xrefs.CrossReferences.RelatedNode")
+	proto.RegisterType((*Documentation)(nil), "kythe.proto.serving.xrefs.Documentation")
+	proto.RegisterType((*Documentation_Index)(nil), "kythe.proto.serving.xrefs.Documentation.Index")
+	proto.RegisterType((*Documentation_Child)(nil), "kythe.proto.serving.xrefs.Documentation.Child")
+	proto.RegisterType((*Documentation_LinkNode)(nil), "kythe.proto.serving.xrefs.Documentation.LinkNode")
+	proto.RegisterType((*Edges)(nil), "kythe.proto.serving.xrefs.Edges")
+	proto.RegisterType((*Edges_Index)(nil), "kythe.proto.serving.xrefs.Edges.Index")
+	proto.RegisterType((*Edges_Edge)(nil), "kythe.proto.serving.xrefs.Edges.Edge")
+	proto.RegisterType((*Edges_Target)(nil), "kythe.proto.serving.xrefs.Edges.Target")
+	proto.RegisterType((*FileDirectory)(nil), "kythe.proto.serving.xrefs.FileDirectory")
+	proto.RegisterType((*FileDirectory_Subdirectory)(nil), "kythe.proto.serving.xrefs.FileDirectory.Subdirectory")
+	proto.RegisterType((*FileDirectory_File)(nil), "kythe.proto.serving.xrefs.FileDirectory.File")
 	proto.RegisterEnum("kythe.proto.serving.xrefs.FileDecorations_TargetOverride_Kind", FileDecorations_TargetOverride_Kind_name, FileDecorations_TargetOverride_Kind_value)
 	proto.RegisterEnum("kythe.proto.serving.xrefs.CrossReferences_Callsite_Kind", CrossReferences_Callsite_Kind_name, CrossReferences_Callsite_Kind_value)
 }
 
 func init() {
-	proto.RegisterFile("kythe/proto/xref_serving.proto", fileDescriptor_xref_serving_f95f76bb44dbecdd)
-}
-
-var fileDescriptor_xref_serving_f95f76bb44dbecdd = []byte{
-	// 1107 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6e, 0x23, 0x45,
-	0x13, 0xf7, 0xf8, 0x5f, 0xec, 0xb2, 0x93, 0xf8, 0xeb, 0xef, 0x32, 0x3b, 0x82, 0x25, 0x9b, 0x48,
-	0x08, 0xa1, 0xc5, 0x21, 0x09, 0x20, 0x60, 0xb5, 0x8b, 0x48, 0x6c, 0xe4, 0x28, 0xc1, 0x11, 0x9d,
-	0xec, 0x66, 0x25, 0x90, 0x2c, 0x33, 0x5d, 0x9e, 0x0c, 0xb1, 0xbb, 0xa3, 0x9e, 0x26, 0x64, 0x8f,
-	0x3c, 0x11, 0x17, 0x0e, 0x1c, 0x78, 0x0a, 0x1e, 0x81, 0x2b, 0x57, 0xae, 0x48, 0xa8, 0x7b, 0x7a,
-	0xc6, 0x63, 0xc7, 0x49, 0x34, 0xd9, 0x15, 0xa7, 0x99, 0xee, 0xaa, 0xfa, 0x55, 0x57, 0x57, 0xd7,
-	0xaf, 0x0a, 0x1e, 0x9e, 0xbf, 0x52, 0x67, 0xb8, 0x79, 0x21, 0x85, 0x12, 0x9b, 0x57, 0x12, 0x47,
-	0x83, 0x08, 0xe5, 0x65, 0xc8, 0x83, 0xb6, 0xd9, 0x22, 0x0f, 0x8c, 0x3c, 0x5e, 0xb4, 0x13, 0x91,
-	0xd6, 0x8b, 0x3c, 0x37, 0x6b, 0xea, 0x8b, 0xc9, 0x44, 0xf0, 0x58, 0x6f, 0x56, 0x12, 0xf9, 0x67,
-	0x38, 0x19, 0x5a, 0xc9, 0x83, 0x19, 0x49, 0xd6, 0xd3, 0x9c, 0x48, 0x09, 0x39, 0x0c, 0xac, 0xdf,
-	0xf5, 0x5f, 0x57, 0x61, 0xf5, 0xab, 0x70, 0x8c, 0x1d, 0xf4, 0x85, 0x1c, 0xaa, 0x50, 0xf0, 0x88,
-	0xbc, 0x0b, 0xe5, 0x51, 0x38, 0x46, 0xd7, 0x59, 0x73, 0xde, 0x6b, 0x6c, 0x93, 0x76, 0xf6, 0x9c,
-	0x2f, 0xfa, 0xc3, 0x09, 0x52, 0x23, 0x27, 0x3d, 0xa8, 0x84, 0x9c, 0xe1, 0x95, 0x5b, 0x34, 0x8a,
-	0x1f, 0xb6, 0x6f, 0x0c, 0xa8, 0x3d, 0xe7, 0xa2, 0xbd, 0xaf, 0xed, 0x7a, 0x05, 0x1a, 0x03, 0x90,
-	0x2e, 0x94, 0x15, 0x5e, 0x29, 0xb7, 0x64, 0x80, 0x36, 0x73, 0x00, 0x9d, 0xe0, 0x95, 0xea, 0x15,
-	0xa8, 0x31, 0x27, 0x07, 0x50, 0x55, 0x43, 0x19, 0xa0, 0x72, 0xcb, 0x06, 0x68, 0x2b, 0x0f, 0x90,
-	0x31, 0xec, 0x15, 0xa8, 0x85, 0x20, 0x0c, 0x56, 0xe3, 0xbf, 0x81, 0xb8, 0x44, 0x29, 0x43, 0x86,
-	0x6e, 0xc5, 0xa0, 0x7e, 0x96, 0x1b, 0xf5, 0xc8, 0x02, 0xf4, 0x0a, 0x74, 0x45, 0xcd, 0xec, 0x90,
-	0x97, 0xd0, 0xb0, 0x5e, 0xb8, 0x60, 0xe8, 0x56, 0x8d, 0x87, 0x8f, 0x73, 0x7b, 0xe8, 0x0b, 0x83,
-	0x0e, 0x2a, 0x5d, 0x91, 0x1f, 0xe0, 0x7f, 0x16, 0x99, 0xe1, 0x28, 0xe4, 0xa1, 0x56, 0x77, 0x97,
-	0x0c, 0xfe, 0x93, 0xdc, 0xf8, 0x9d, 0x14, 0xa2, 0x57, 0xa0, 0x2d, 0x35, 0xb7, 0x47, 0x2e, 0xe0,
-	0xff, 0x53, 0x27, 0x83, 0xb1, 0xf0, 0x8d, 0xb1, 0x5b, 0x33, 0xde, 0x9e, 0xe6, 0xf0, 0x36, 0xc5,
-	0x3c, 0xb4, 0x20, 0xbd, 0x02, 0x25, 0xec, 0xda, 0x2e, 0xf9, 0x06, 0x6a, 0x69, 0x5a, 0xea, 0xc6,
-	0xcd, 0x4e, 0x0e, 0x37, 0x99, 0x84, 0xa4, 0x30, 0xe4, 0x14, 0x80, 0x85, 0xc3, 0x80, 0x8b, 0x48,
-	0x85, 0xbe, 0x0b, 0xb9, 0x33, 0xd1, 0x49, 0x8d, 0x75, 0x26, 0xa6, 0x50, 0xde, 0x63, 0xa8, 0x98,
-	0xf7, 0x4e, 0x36, 0x60, 0x59, 0xbf, 0xd3, 0x01, 0x72, 0x5f, 0xb0, 0x90, 0x07, 0xa6, 0xc2, 0xea,
-	0xb4, 0xa9, 0x37, 0xbb, 0x76, 0xcf, 0xfb, 0x0e, 0xca, 0xfa, 0x51, 0x93, 0x47, 0xd0, 0x8c, 0xd4,
-	0x50, 0xaa, 0x81, 0x18, 0x8d, 0x22, 0x54, 0x46, 0xb7, 0x42, 0x1b, 0x66, 0xef, 0xc8, 0x6c, 0x91,
-	0xb7, 0x01, 0x90, 0xb3, 0x44, 0xa1, 0x68, 0x14, 0xea, 0xc8, 0x99, 0x15, 0x93, 0x4c, 0x55, 0x35,
-	0xe3, 0x12, 0xf1, 0xfe, 0x74, 0xa0, 0x1a, 0xa7, 0xf4, 0x0d, 0x38, 0x78, 0x0a, 0x60, 0xae, 0x67,
-	0x70, 0x1e, 0x72, 0x66, 0xdc, 0xac, 0x6c, 0xbf, 0x35, 0x7b, 0x63, 0x31, 0x43, 0x75, 0x59, 0x80,
-	0x07, 0x21, 0x67, 0xbd, 0x02, 0xad, 0x1b, 0xb1, 0x5e, 0x90, 0x0d, 0x68, 0x06, 0xc8, 0x51, 0x86,
-	0x7e, 0x0c, 0xa0, 0x8b, 0xb6, 0xde, 0x2b, 0xd0, 0x86, 0xdd, 0x35, 0x4a, 0xef, 0xa7, 0x35, 0x5d,
-	0xb9, 0x91, 0x8e, 0xac, 0xc6, 0x6e, 0x15, 0xca, 0x1a, 0xc8, 0xfb, 0xc7, 0x81, 0x95, 0xd9, 0xca,
-	0x23, 0xdb, 0x00, 0x36, 0xd1, 0x0c, 0xf9, 0x2d, 0xcc, 0x96, 0xd1, 0x22, 0x34, 0x86, 0x33, 0x71,
-	0xaf, 0x6c, 0x3f, 0xbb, 0x77, 0xd9, 0xb7, 0x75, 0x20, 0xd4, 0x60, 0x65, 0xce, 0xa1, 0xf3, 0x5f,
-	0xba, 0xf3, 0x1c, 0x21, 0x0f, 0xd6, 0x37, 0xa1, 0x6c, 0xae, 0xa2, 0x01, 0x4b, 0xcf, 0xfb, 0x07,
-	0xfd, 0xa3, 0xd3, 0x7e, 0xab, 0x40, 0x96, 0xa1, 0x7e, 0xf4, 0xa2, 0x4b, 0xe9, 0x7e, 0xa7, 0x7b,
-	0xdc, 0x72, 0xb4, 0xac, 0xfb, 0xf2, 0xa4, 0xdb, 0xef, 0x1c, 0xb7, 0x8a, 0xde, 0xe7, 0x00, 0x53,
-	0x5a, 0x20, 0x8f, 0xa1, 0x6c, 0xb8, 0x25, 0x0e, 0xda, 0x5d, 0x94, 0x1f, 0xad, 0x47, 0x8d, 0x96,
-	0x27, 0xa1, 0x35, 0x5f, 0xf2, 0x99, 0x1c, 0x38, 0x77, 0xe5, 0x40, 0x07, 0x98, 0xe1, 0x9b, 0xe2,
-	0xcd, 0x01, 0x4e, 0xb5, 0xbc, 0xe7, 0x40, 0xae, 0x17, 0x3e, 0xf9, 0x02, 0x6a, 0x29, 0x93, 0xc4,
-	0x7e, 0x37, 0x16, 0xa6, 0xa0, 0x7b, 0x75, 0x31, 0xe4, 0x0c, 0xd9, 0x97, 0xdc, 0x3f, 0x13, 0x92,
-	0xa6, 0x46, 0xde, 0xcf, 0x0e, 0xd4, 0xd2, 0x07, 0xd0, 0xce, 0x10, 0xc6, 0xcd, 0x51, 0x4c, 0xd9,
-	0xa0, 0x0b, 0xcb, 0x93, 0xa1, 0x3c, 0x47, 0x36, 0x88, 0xc4, 0x8f, 0xd2, 0x47, 0x1b, 0xca, 0xda,
-	0x8c, 0x91, 0x6d, 0xcd, 0x5f, 0x1b, 0xc5, 0x63, 0xa3, 0x47, 0x9b, 0x93, 0xcc, 0xca, 0x3b, 0x04,
-	0x98, 0xf2, 0x02, 0x79, 0x36, 0x43, 0x31, 0xf1, 0x31, 0x1e, 0x2e, 0x42, 0x9c, 0xda, 0x64, 0x99,
-	0x64, 0x77, 0x09, 0x2a, 0xc8, 0x95, 0x7c, 0xb5, 0xfe, 0x57, 0x13, 0x56, 0xf7, 0xa4, 0x88, 0x22,
-	0x8a, 0x23, 0x94, 0xc8, 0x7d, 0x8c, 0x74, 0x96, 0xec, 0x51, 0x6f, 0xc9, 0x52, 0xac, 0x91, 0xa7,
-	0x75, 0xcf, 0xb9, 0x99, 0x6f, 0xdd, 0x27, 0x50, 0x97, 0x89, 0xd0, 0xbe, 0xe7, 0x8f, 0x72, 0xa0,
-	0xa5, 0xbf, 0x9a, 0x1a, 0x52, 0x20, 0x4d, 0xef, 0x12, 0xc7, 0x71, 0xee, 0xcb, 0x77, 0xd2, 0xfb,
-	0x75, 0xd0, 0x71, 0xd2, 0x3b, 0x52, 0x18, 0x3d, 0x1c, 0xf8, 0xc3, 0xf1, 0x18, 0xa5, 0x25, 0x92,
-	0xad, 0x1c, 0x80, 0x7b, 0xc6, 0x50, 0x0f, 0x07, 0x31, 0x84, 0x3e, 0x9f, 0xfe, 0x8b, 0x42, 0x95,
-	0xf4, 0xec, 0x9d, 0x9c, 0x70, 0xda, 0x54, 0x9f, 0x2f, 0x81, 0x21, 0xdf, 0x42, 0xd3, 0x9c, 0x15,
-	0x59, 0x3c, 0x0a, 0xc4, 0xad, 0xfa, 0x93, 0xbc, 0x61, 0x23, 0xb3, 0xb3, 0x40, 0x43, 0x4e, 0x97,
-	0xde, 0x2f, 0x4e, 0xd2, 0x83, 0x72, 0xb1, 0xc1, 0x1b, 0xaa, 0x02, 0xb2, 0x05, 0x30, 0x41, 0x19,
-	0xe0, 0xe0, 0xa7, 0x50, 0x9d, 0xb9, 0xa5, 0xb5, 0xd2, 0x0d, 0xcf, 0xb3, 0x6e, 0xb4, 0x4e, 0x43,
-	0x75, 0xe6, 0xfd, 0xe6, 0x40, 0x3d, 0x8d, 0x6d, 0xae, 0xd3, 0x38, 0xaf, 0xdb, 0x69, 0x8a, 0x8b,
-	0x3a, 0x4d, 0x96, 0x6f, 0x4a, 0xf7, 0xe0, 0x9b, 0xb4, 0xfd, 0xfc, 0xe1, 0x40, 0x2d, 0x79, 0x82,
-	0x7a, 0x98, 0xce, 0xdc, 0xf7, 0xc2, 0x61, 0xda, 0xdc, 0xf4, 0x6c, 0x84, 0xc5, 0xd7, 0x8d, 0xb0,
-	0xb4, 0x28, 0x42, 0x17, 0x96, 0x84, 0x64, 0x21, 0x1f, 0x8e, 0x4d, 0x51, 0x55, 0x68, 0xb2, 0xd4,
-	0x12, 0x89, 0x97, 0x28, 0xa3, 0x78, 0xc8, 0xad, 0xd1, 0x64, 0x99, 0x06, 0xf5, 0xbb, 0x03, 0xd5,
-	0xb8, 0x0c, 0x34, 0xd1, 0xd8, 0x4a, 0xba, 0x85, 0x68, 0x6c, 0xa1, 0x64, 0x2f, 0xb5, 0x78, 0x8f,
-	0x4b, 0xbd, 0xfe, 0x02, 0x4b, 0xf7, 0xe2, 0xe1, 0xbf, 0x1d, 0xa8, 0x25, 0x65, 0xf7, 0xdf, 0x06,
-	0x70, 0x68, 0xa7, 0x88, 0x78, 0x3c, 0xfa, 0xf4, 0x1e, 0x34, 0x91, 0x99, 0x1f, 0xd6, 0x3f, 0x58,
-	0x34, 0x0b, 0x00, 0x54, 0x3b, 0xfb, 0xb4, 0xbb, 0x77, 0xd2, 0x72, 0x48, 0x13, 0x6a, 0xc9, 0x5c,
-	0xd0, 0x2a, 0x7a, 0x4f, 0xa0, 0x91, 0x61, 0x85, 0x7c, 0xc5, 0x9f, 0x76, 0x9b, 0xdd, 0x47, 0xf0,
-	0x8e, 0x2f, 0x26, 0xed, 0x40, 0x88, 0x60, 0x8c, 0x6d, 0x86, 0x97, 0x4a, 0x88, 0x71, 0x94, 0xb5,
-	0xfe, 0xbe, 0x6a, 0x3e, 0x3b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xfa, 0x25, 0x1b, 0xf5,
-	0x0e, 0x00, 0x00,
+	proto.RegisterFile("kythe/proto/xref_serving.proto", fileDescriptor_xref_serving_e26c3881f14b57e8)
+}
+
+var fileDescriptor_xref_serving_e26c3881f14b57e8 = []byte{
+	// 1441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x6b, 0xc7, 0x3e, 0xb6, 0x13, 0xff, 0xe7, 0x7f, 0xb3, 0x59, 0x41, 0x69, 0x53,
+	0x51, 0x2a, 0xd4, 0x3a, 0x6d, 0x4a, 0x0b, 0xa5, 0x6a, 0x51, 0x13, 0x3b, 0x72, 0xd5, 0x90, 0xc0,
+	0x24, 0xfd, 0x90, 0x40, 0xb2, 0xb6, 0x3b, 0x13, 0x67, 0xc9, 0x7a, 0xa7, 0x9a, 0xdd, 0xa6, 0xc9,
+	0x25, 0x8f, 0xc0, 0x93, 0x70, 0xc3, 0x05, 0x17, 0x5c, 0x20, 0x9e, 0x00, 0xf1, 0x08, 0x08, 0x89,
+	0x17, 0xe0, 0x16, 0x09, 0xcd, 0xec, 0xec, 0x67, 0xec, 0x38, 0xeb, 0x56, 0x88, 0x9b, 0xc4, 0x3b,
+	0x73, 0xce, 0xef, 0xcc, 0x9c, 0x8f, 0xdf, 0x39, 0xbb, 0x70, 0xe1, 0xf0, 0x24, 0x38, 0xa0, 0xab,
+	0x2f, 0x39, 0x0b, 0xd8, 0xea, 0x31, 0xa7, 0xfb, 0x43, 0x9f, 0xf2, 0x23, 0xc7, 0x1b, 0x75, 0xe5,
+	0x12, 0x5a, 0x96, 0xfb, 0xe1, 0x43, 0x37, 0xda, 0x12, 0x72, 0xbe, 0x69, 0xa4, 0x55, 0x6d, 0x36,
+	0x1e, 0x33, 0x2f, 0x94, 0xcb, 0xee, 0xf8, 0xf6, 0x01, 0x1d, 0x5b, 0x6a, 0x67, 0x39, 0xb3, 0x93,
+	0xb6, 0x94, 0xdb, 0x0a, 0x18, 0xb7, 0x46, 0xca, 0xee, 0xca, 0x0f, 0x4b, 0xb0, 0xb4, 0xe9, 0xb8,
+	0xb4, 0x47, 0x6d, 0xc6, 0xad, 0xc0, 0x61, 0x9e, 0x8f, 0xae, 0x80, 0xbe, 0xef, 0xb8, 0xd4, 0xd0,
+	0x2e, 0x6a, 0x57, 0x9b, 0x6b, 0xa8, 0x9b, 0x3e, 0xe7, 0xd3, 0x6d, 0x6b, 0x4c, 0xb1, 0xdc, 0x47,
+	0x03, 0xa8, 0x3a, 0x1e, 0xa1, 0xc7, 0x46, 0x59, 0x0a, 0xde, 0xe8, 0x4e, 0xbd, 0x50, 0x37, 0x67,
+	0xa2, 0xfb, 0x48, 0xe8, 0x0d, 0x4a, 0x38, 0x04, 0x40, 0x7d, 0xd0, 0x03, 0x7a, 0x1c, 0x18, 0x15,
+	0x09, 0xb4, 0x5a, 0x00, 0x68, 0x8f, 0x1e, 0x07, 0x83, 0x12, 0x96, 0xea, 0xe8, 0x31, 0xd4, 0x02,
+	0x8b, 0x8f, 0x68, 0x60, 0xe8, 0x12, 0xe8, 0x66, 0x11, 0x20, 0xa9, 0x38, 0x28, 0x61, 0x05, 0x81,
+	0x08, 0x2c, 0x85, 0xbf, 0x86, 0xec, 0x88, 0x72, 0xee, 0x10, 0x6a, 0x54, 0x25, 0xea, 0xdd, 0xc2,
+	0xa8, 0x3b, 0x0a, 0x60, 0x50, 0xc2, 0x8b, 0x41, 0x66, 0x05, 0x3d, 0x87, 0xa6, 0xb2, 0xe2, 0x31,
+	0x42, 0x8d, 0x9a, 0xb4, 0x70, 0xbb, 0xb0, 0x85, 0x6d, 0x26, 0xd1, 0x21, 0x88, 0x9f, 0xd0, 0x37,
+	0xf0, 0x3f, 0x85, 0x4c, 0xe8, 0xbe, 0xe3, 0x39, 0x42, 0xdc, 0x58, 0x90, 0xf8, 0xf7, 0x0a, 0xe3,
+	0xf7, 0x62, 0x88, 0x41, 0x09, 0x77, 0x82, 0xdc, 0x1a, 0x7a, 0x09, 0xff, 0x4f, 0x8c, 0x0c, 0x5d,
+	0x66, 0x4b, 0x65, 0xa3, 0x2e, 0xad, 0xdd, 0x2f, 0x60, 0x2d, 0xc1, 0xdc, 0x52, 0x20, 0x83, 0x12,
+	0x46, 0xe4, 0xd4, 0x2a, 0xfa, 0x12, 0xea, 0x71, 0x58, 0x1a, 0xd2, 0xcc, 0xad, 0x02, 0x66, 0x52,
+	0x01, 0x89, 0x61, 0xd0, 0x33, 0x00, 0xe2, 0x58, 0x23, 0x8f, 0xf9, 0x81, 0x63, 0x1b, 0x50, 0x38,
+	0x12, 0xbd, 0x58, 0x59, 0x44, 0x22, 0x81, 0x32, 0xaf, 0x41, 0x55, 0xe6, 0x3b, 0xba, 0x0c, 0x6d,
+	0x91, 0xa7, 0x43, 0xea, 0xd9, 0x8c, 0x38, 0xde, 0x48, 0x56, 0x58, 0x03, 0xb7, 0xc4, 0x62, 0x5f,
+	0xad, 0x99, 0x5f, 0x83, 0x2e, 0x92, 0x1a, 0x5d, 0x82, 0x96, 0x1f, 0x58, 0x3c, 0x18, 0xb2, 0xfd,
+	0x7d, 0x9f, 0x06, 0x52, 0xb6, 0x8a, 0x9b, 0x72, 0x6d, 0x47, 0x2e, 0xa1, 0x77, 0x01, 0xa8, 0x47,
+	0x22, 0x81, 0xb2, 0x14, 0x68, 0x50, 0x8f, 0xa8, 0x6d, 0x94, 0xaa, 0xaa, 0x56, 0x58, 0x22, 0xe6,
+	0xef, 0x1a, 0xd4, 0xc2, 0x90, 0xbe, 0x05, 0x03, 0xf7, 0x01, 0xa4, 0x7b, 0x86, 0x87, 0x8e, 0x47,
+	0xa4, 0x99, 0xc5, 0xb5, 0x77, 0xb2, 0x1e, 0x0b, 0x19, 0xaa, 0x4f, 0x46, 0xf4, 0xb1, 0xe3, 0x91,
+	0x41, 0x09, 0x37, 0xe4, 0xb6, 0x78, 0x40, 0x97, 0xa1, 0x35, 0xa2, 0x1e, 0xe5, 0x8e, 0x1d, 0x02,
+	0x88, 0xa2, 0x6d, 0x0c, 0x4a, 0xb8, 0xa9, 0x56, 0xa5, 0xd0, 0x87, 0x71, 0x4d, 0x57, 0xa7, 0xd2,
+	0x91, 0x92, 0x58, 0xaf, 0x81, 0x2e, 0x80, 0xcc, 0xbf, 0x35, 0x58, 0xcc, 0x56, 0x1e, 0x5a, 0x03,
+	0x50, 0x81, 0x26, 0xd4, 0x3b, 0x83, 0xd9, 0x52, 0x52, 0x08, 0x87, 0x70, 0xf2, 0xde, 0x8b, 0x6b,
+	0x0f, 0xe6, 0x2e, 0xfb, 0xae, 0xb8, 0x08, 0x96, 0x58, 0xa9, 0x73, 0x88, 0xf8, 0x57, 0x66, 0x9e,
+	0xc3, 0xf1, 0x46, 0x2b, 0xab, 0xa0, 0x4b, 0x57, 0x34, 0x61, 0xe1, 0xc9, 0xf6, 0xe3, 0xed, 0x9d,
+	0x67, 0xdb, 0x9d, 0x12, 0x6a, 0x43, 0x63, 0xe7, 0x69, 0x1f, 0xe3, 0x47, 0xbd, 0xfe, 0x6e, 0x47,
+	0x13, 0x7b, 0xfd, 0xe7, 0x7b, 0xfd, 0xed, 0xde, 0x6e, 0xa7, 0x6c, 0x7e, 0x0a, 0x90, 0xd0, 0x02,
+	0xba, 0x06, 0xba, 0xe4, 0x96, 0xf0, 0xd2, 0xc6, 0xa4, 0xf8, 0x08, 0x39, 0x2c, 0xa5, 0x4c, 0x0e,
+	0x9d, 0x7c, 0xc9, 0xa7, 0x62, 0xa0, 0xcd, 0x8a, 0x81, 0xb8, 0x60, 0x8a, 0x6f, 0xca, 0xd3, 0x2f,
+	0x98, 0x48, 0x99, 0x4f, 0x00, 0x9d, 0x2e, 0x7c, 0xf4, 0x19, 0xd4, 0x63, 0x26, 0x09, 0xed, 0x5e,
+	0x9e, 0x18, 0x82, 0xfe, 0xf1, 0x4b, 0xcb, 0x23, 0x94, 0x3c, 0xf4, 0xec, 0x03, 0xc6, 0x71, 0xac,
+	0x64, 0x7e, 0xab, 0x41, 0x3d, 0x4e, 0x80, 0x6e, 0x8a, 0x30, 0xa6, 0xdf, 0x22, 0x61, 0x83, 0x3e,
+	0xb4, 0xc7, 0x16, 0x3f, 0xa4, 0x64, 0xe8, 0xb3, 0x57, 0xdc, 0xa6, 0xea, 0x2a, 0x17, 0x33, 0x4a,
+	0xaa, 0x35, 0x7f, 0x2e, 0x05, 0x77, 0xa5, 0x1c, 0x6e, 0x8d, 0x53, 0x4f, 0xe6, 0x16, 0x40, 0xc2,
+	0x0b, 0xe8, 0x41, 0x86, 0x62, 0xc2, 0x63, 0x5c, 0x98, 0x84, 0x98, 0xe8, 0xa4, 0x99, 0x64, 0x7d,
+	0x01, 0xaa, 0xd4, 0x0b, 0xf8, 0xc9, 0xca, 0x2f, 0x6d, 0x58, 0xda, 0xe0, 0xcc, 0xf7, 0x31, 0xdd,
+	0xa7, 0x9c, 0x7a, 0x36, 0xf5, 0x45, 0x94, 0xd4, 0x51, 0xcf, 0x88, 0x52, 0x28, 0x51, 0xa4, 0x75,
+	0xe7, 0xcc, 0xe4, 0x5b, 0xf7, 0x1e, 0x34, 0x78, 0xb4, 0xa9, 0xf2, 0xf9, 0xa3, 0x02, 0x68, 0xf1,
+	0x4f, 0x41, 0x0d, 0x31, 0x90, 0xa0, 0x77, 0x4e, 0xdd, 0x30, 0xf6, 0xfa, 0x4c, 0x7a, 0x3f, 0x0d,
+	0xea, 0x46, 0xbd, 0x23, 0x86, 0x11, 0xc3, 0x81, 0x6d, 0xb9, 0x2e, 0xe5, 0x46, 0x75, 0xe6, 0x70,
+	0x90, 0x07, 0xdc, 0x90, 0x8a, 0x62, 0x38, 0x08, 0x21, 0xc4, 0xf9, 0xc4, 0x2f, 0xdf, 0x09, 0xa2,
+	0x9e, 0x7d, 0xab, 0x20, 0x9c, 0x50, 0x15, 0xe7, 0x8b, 0x60, 0xd0, 0x57, 0xd0, 0x92, 0x67, 0xa5,
+	0x24, 0x1c, 0x05, 0xc2, 0x56, 0x7d, 0xa7, 0xe8, 0xb5, 0x29, 0x51, 0xb3, 0x40, 0x93, 0x27, 0x8f,
+	0xe6, 0xf7, 0x5a, 0xd4, 0x83, 0x0a, 0xb1, 0xc1, 0x5b, 0xaa, 0x02, 0x74, 0x13, 0x60, 0x4c, 0xf9,
+	0x88, 0x0e, 0x5f, 0x3b, 0xc1, 0x81, 0x51, 0xb9, 0x58, 0x99, 0x92, 0x9e, 0x0d, 0x29, 0xf5, 0xcc,
+	0x09, 0x0e, 0xcc, 0x1f, 0x35, 0x68, 0xc4, 0x77, 0xcb, 0x75, 0x1a, 0xed, 0x4d, 0x3b, 0x4d, 0x79,
+	0x52, 0xa7, 0x49, 0xf3, 0x4d, 0x65, 0x0e, 0xbe, 0x89, 0xdb, 0xcf, 0x6f, 0x1a, 0xd4, 0xa3, 0x14,
+	0x14, 0xc3, 0x74, 0xca, 0xdf, 0x13, 0x87, 0x69, 0xe9, 0xe9, 0xec, 0x0d, 0xcb, 0x6f, 0x7a, 0xc3,
+	0xca, 0xa4, 0x1b, 0x1a, 0xb0, 0xc0, 0x38, 0x71, 0x3c, 0xcb, 0x95, 0x45, 0x55, 0xc5, 0xd1, 0xa3,
+	0xd8, 0xe1, 0xf4, 0x88, 0x72, 0x3f, 0x1c, 0x72, 0xeb, 0x38, 0x7a, 0x8c, 0x2f, 0xf5, 0x93, 0x06,
+	0xb5, 0xb0, 0x0c, 0x04, 0xd1, 0xa8, 0x4a, 0x3a, 0x83, 0x68, 0x54, 0xa1, 0xa4, 0x9d, 0x5a, 0x9e,
+	0xc3, 0xa9, 0xa7, 0x33, 0xb0, 0x32, 0x17, 0x0f, 0xff, 0xa5, 0x41, 0x3d, 0x2a, 0xbb, 0x7f, 0xf7,
+	0x02, 0x5b, 0x6a, 0x8a, 0x08, 0xc7, 0xa3, 0x4f, 0xe6, 0xa0, 0x89, 0xd4, 0xfc, 0xb0, 0x72, 0x7d,
+	0xd2, 0x2c, 0x00, 0x50, 0xeb, 0x3d, 0xc2, 0xfd, 0x8d, 0xbd, 0x8e, 0x86, 0x5a, 0x50, 0x8f, 0xe6,
+	0x82, 0x4e, 0xd9, 0xfc, 0x4e, 0x83, 0x66, 0x8a, 0x16, 0x0a, 0x56, 0xff, 0xde, 0xe4, 0xb1, 0xbe,
+	0x80, 0x1b, 0x26, 0x8c, 0xee, 0x49, 0x13, 0xfb, 0x53, 0x87, 0x76, 0x8f, 0xd9, 0xaf, 0xc6, 0xd4,
+	0x0b, 0xac, 0x68, 0xd0, 0x38, 0x77, 0x0b, 0xdb, 0xcc, 0xb6, 0xb0, 0xee, 0x19, 0x8e, 0xcd, 0x18,
+	0xc9, 0x37, 0xb0, 0x4d, 0xa8, 0xda, 0x07, 0x8e, 0x4b, 0x8c, 0x4a, 0x41, 0x9c, 0x0d, 0xa1, 0x25,
+	0x70, 0xa4, 0x3a, 0xfa, 0x02, 0x1a, 0xae, 0xe3, 0x1d, 0x86, 0xe4, 0x3d, 0xfb, 0xfd, 0x33, 0x8b,
+	0xb5, 0xe5, 0x78, 0x87, 0x8a, 0xb7, 0xeb, 0xae, 0xfa, 0x6d, 0xfe, 0x1a, 0x93, 0xf6, 0xa9, 0x22,
+	0xd0, 0xe6, 0xa2, 0xe1, 0x65, 0xa8, 0x73, 0xeb, 0xf5, 0x50, 0xbe, 0x14, 0x48, 0x0a, 0xc4, 0x0b,
+	0xdc, 0x7a, 0x2d, 0xdf, 0x36, 0xae, 0x81, 0x2e, 0xec, 0x2a, 0x6e, 0x36, 0x26, 0x01, 0x8b, 0x33,
+	0x62, 0x29, 0x85, 0x3e, 0x86, 0x36, 0x51, 0xe7, 0xa7, 0x64, 0xf8, 0xe2, 0xc4, 0xd0, 0xa7, 0x86,
+	0xab, 0x95, 0x08, 0xae, 0x9f, 0x98, 0x37, 0xa1, 0x2a, 0xdd, 0x86, 0xae, 0x46, 0x5e, 0x9f, 0x1e,
+	0xe8, 0x50, 0xc0, 0xbc, 0x0b, 0xf5, 0xc8, 0x3b, 0xe8, 0x7a, 0x26, 0x7d, 0x97, 0x27, 0xba, 0x37,
+	0xc9, 0xdf, 0x24, 0xd3, 0x7e, 0xd6, 0xa1, 0x2a, 0x78, 0xb3, 0xd8, 0x90, 0xf4, 0x20, 0x9b, 0x61,
+	0x57, 0xce, 0x88, 0xa6, 0x04, 0xcf, 0x67, 0xd6, 0x3d, 0xd0, 0x29, 0x19, 0x45, 0x8c, 0xf5, 0xfe,
+	0x4c, 0x75, 0xf1, 0x57, 0x7c, 0xcb, 0x10, 0x4a, 0xe8, 0x61, 0xee, 0x5b, 0xc6, 0x07, 0x33, 0xd5,
+	0xf3, 0x5f, 0x30, 0xcc, 0xdb, 0x73, 0xf5, 0x7c, 0xd1, 0xbe, 0x74, 0x81, 0x58, 0x68, 0xec, 0xff,
+	0xaf, 0xb7, 0xaf, 0x3b, 0xf1, 0x6b, 0x6f, 0x21, 0x67, 0x24, 0x29, 0xf4, 0x47, 0x19, 0xda, 0xf2,
+	0x35, 0xcf, 0xe1, 0xd4, 0x0e, 0x18, 0x3f, 0x41, 0x37, 0xa0, 0x41, 0xa2, 0x87, 0x33, 0x3c, 0x94,
+	0x08, 0x89, 0x11, 0xcf, 0x7f, 0xf5, 0x22, 0x51, 0x2a, 0x9f, 0xef, 0x1b, 0x43, 0x24, 0xdf, 0xdd,
+	0x4d, 0x29, 0x0f, 0x4a, 0x38, 0x03, 0x86, 0x36, 0xd4, 0x57, 0xbb, 0x30, 0xdb, 0xae, 0x9f, 0x1b,
+	0x54, 0x3c, 0x89, 0xac, 0x13, 0xca, 0xe6, 0x26, 0xb4, 0xd2, 0x46, 0xd0, 0x9d, 0xdc, 0x89, 0xa7,
+	0x5f, 0x33, 0x23, 0x67, 0x76, 0x41, 0x17, 0xb8, 0xe7, 0xfd, 0x94, 0x18, 0xbb, 0x79, 0xfd, 0x12,
+	0xbc, 0x67, 0xb3, 0x71, 0x77, 0xc4, 0xd8, 0xc8, 0xa5, 0x5d, 0x42, 0x8f, 0x02, 0xc6, 0x5c, 0x3f,
+	0xad, 0xf7, 0xa2, 0x26, 0xff, 0xdd, 0xfa, 0x67, 0x00, 0x32, 0xdc, 0xd4, 0x25, 0x60, 0x15, 0x00,
+	0x00,
 }